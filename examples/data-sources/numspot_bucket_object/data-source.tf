@@ -0,0 +1,8 @@
+data "numspot_bucket_object" "config" {
+  bucket = "bucket-name"
+  key    = "config/app.json"
+}
+
+locals {
+  config = jsondecode(data.numspot_bucket_object.config.body)
+}
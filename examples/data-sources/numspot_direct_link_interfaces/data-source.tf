@@ -0,0 +1,11 @@
+resource "numspot_direct_link_interface" "direct-link-interface" {
+  name               = "direct-link-interface"
+  direct_link_id     = "dxcon-12345678"
+  virtual_gateway_id = "vgw-12345678"
+  bgp_asn            = 65000
+  vlan               = 42
+}
+
+data "numspot_direct_link_interfaces" "datasource-direct-link-interfaces" {
+  depends_on = [numspot_direct_link_interface.direct-link-interface]
+}
@@ -0,0 +1,9 @@
+resource "numspot_direct_link" "direct-link" {
+  name      = "direct-link"
+  bandwidth = "1Gbps"
+  location  = "PAR1"
+}
+
+data "numspot_direct_links" "datasource-direct-links" {
+  depends_on = [numspot_direct_link.direct-link]
+}
@@ -0,0 +1,12 @@
+data "numspot_kubernetes_kubeconfig" "kubeconfig" {
+  cluster_id = numspot_kubernetes_cluster.cluster.id
+}
+
+provider "helm" {
+  kubernetes {
+    host                   = data.numspot_kubernetes_kubeconfig.kubeconfig.host
+    cluster_ca_certificate = data.numspot_kubernetes_kubeconfig.kubeconfig.cluster_ca_certificate
+    client_certificate     = data.numspot_kubernetes_kubeconfig.kubeconfig.client_certificate
+    client_key             = data.numspot_kubernetes_kubeconfig.kubeconfig.client_key
+  }
+}
@@ -0,0 +1,11 @@
+data "numspot_kubernetes_versions" "supported" {
+  version_constraint = ">= 1.32, < 1.34"
+}
+
+resource "numspot_kubernetes_cluster" "kubernetes-cluster" {
+  cidr       = "10.20.0.0/16"
+  name       = "test-tf-kube"
+  profile    = "small"
+  version    = data.numspot_kubernetes_versions.supported.latest_version
+  visibility = "EXTERNAL"
+}
@@ -0,0 +1,3 @@
+data "numspot_load_balancer_backend_health" "datasource-backend-health" {
+  load_balancer_name = numspot_load_balancer.load-balancer.name
+}
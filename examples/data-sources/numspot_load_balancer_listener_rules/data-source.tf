@@ -0,0 +1,12 @@
+resource "numspot_load_balancer_listener_rule" "listener-rule" {
+  name               = "api"
+  load_balancer_name = "load-balancer"
+  load_balancer_port = 80
+  priority           = 10
+  path_pattern       = "/api/*"
+  vm_ids             = ["i-12345678"]
+}
+
+data "numspot_load_balancer_listener_rules" "datasource-listener-rules" {
+  names = [numspot_load_balancer_listener_rule.listener-rule.name]
+}
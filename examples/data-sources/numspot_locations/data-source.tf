@@ -0,0 +1,7 @@
+data "numspot_locations" "locations" {}
+
+resource "numspot_direct_link" "direct-link" {
+  name      = "direct-link"
+  bandwidth = "1Gbps"
+  location  = data.numspot_locations.locations.items.0.location_code
+}
@@ -0,0 +1,4 @@
+data "numspot_permissions" "datasource-permissions" {
+  service  = "compute"
+  resource = "vms"
+}
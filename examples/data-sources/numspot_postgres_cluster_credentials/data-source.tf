@@ -0,0 +1,8 @@
+data "numspot_postgres_cluster_credentials" "credentials" {
+  cluster_id = numspot_postgres_cluster.cluster.id
+}
+
+output "postgres_password" {
+  value     = data.numspot_postgres_cluster_credentials.credentials.password
+  sensitive = true
+}
@@ -0,0 +1,3 @@
+data "numspot_roles" "datasource-roles" {
+  name = "viewer"
+}
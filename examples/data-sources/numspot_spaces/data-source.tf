@@ -0,0 +1,4 @@
+data "numspot_spaces" "datasource-space" {
+  organisation_id = "67d97ad4-3005-48dc-a392-60a97ea5a7a5"
+  name            = "space"
+}
@@ -0,0 +1,10 @@
+ephemeral "numspot_kubernetes_kubeconfig" "kubeconfig" {
+  cluster_id = numspot_kubernetes_cluster.cluster.id
+}
+
+provider "kubernetes" {
+  host                   = ephemeral.numspot_kubernetes_kubeconfig.kubeconfig.host
+  cluster_ca_certificate = ephemeral.numspot_kubernetes_kubeconfig.kubeconfig.cluster_ca_certificate
+  client_certificate     = ephemeral.numspot_kubernetes_kubeconfig.kubeconfig.client_certificate
+  client_key             = ephemeral.numspot_kubernetes_kubeconfig.kubeconfig.client_key
+}
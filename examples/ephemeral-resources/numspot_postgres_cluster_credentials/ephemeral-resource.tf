@@ -0,0 +1,26 @@
+resource "numspot_postgres_cluster" "cluster" {
+  name       = "postgres-cluster"
+  user       = "admin"
+  visibility = "INTERNAL"
+
+  node_configuration = {
+    vcpu_count       = 2
+    memory_size_gi_b = 4
+  }
+
+  volume = {
+    type      = "GP2"
+    size_gi_b = 50
+  }
+}
+
+ephemeral "numspot_postgres_cluster_credentials" "credentials" {
+  cluster_id = numspot_postgres_cluster.cluster.id
+}
+
+provider "postgresql" {
+  host     = numspot_postgres_cluster.cluster.host
+  port     = numspot_postgres_cluster.cluster.port
+  username = ephemeral.numspot_postgres_cluster_credentials.credentials.username
+  password = ephemeral.numspot_postgres_cluster_credentials.credentials.password
+}
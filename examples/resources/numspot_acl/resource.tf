@@ -0,0 +1,14 @@
+data "numspot_permissions" "vm_read" {
+  service  = "compute"
+  resource = "vms"
+  action   = "get"
+}
+
+resource "numspot_acl" "ci_vm_read" {
+  subject_type  = "serviceAccounts"
+  subject_id    = "0f3b5d0c-0cc0-4c07-a6e3-d2a6b6b2e3f1"
+  permission_id = data.numspot_permissions.vm_read.items.0.id
+  service       = "compute"
+  resource      = "vms"
+  resource_id   = "i-12345678"
+}
@@ -1,3 +1,10 @@
 resource "numspot_bucket" "bucket" {
   name = "bucket-name"
-}
\ No newline at end of file
+
+  tags = [
+    {
+      key   = "name"
+      value = "My Bucket"
+    }
+  ]
+}
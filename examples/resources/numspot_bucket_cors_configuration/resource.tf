@@ -0,0 +1,18 @@
+resource "numspot_bucket" "bucket" {
+  name = "bucket-name"
+}
+
+resource "numspot_bucket_cors_configuration" "cors" {
+  bucket = numspot_bucket.bucket.name
+
+  cors_rules = [
+    {
+      id              = "website"
+      allowed_methods = ["GET", "HEAD"]
+      allowed_origins = ["https://www.example.com"]
+      allowed_headers = ["*"]
+      expose_headers  = ["ETag"]
+      max_age_seconds = 3000
+    }
+  ]
+}
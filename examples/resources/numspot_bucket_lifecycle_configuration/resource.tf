@@ -0,0 +1,22 @@
+resource "numspot_bucket" "bucket" {
+  name = "bucket-name"
+}
+
+resource "numspot_bucket_lifecycle_configuration" "lifecycle" {
+  bucket = numspot_bucket.bucket.name
+
+  rules = [
+    {
+      id                                     = "expire-logs"
+      status                                 = "Enabled"
+      prefix                                 = "logs/"
+      expiration_days                        = 30
+      abort_incomplete_multipart_upload_days = 7
+    },
+    {
+      id                                 = "expire-noncurrent-versions"
+      status                             = "Enabled"
+      noncurrent_version_expiration_days = 90
+    }
+  ]
+}
@@ -0,0 +1,21 @@
+resource "numspot_bucket" "bucket" {
+  name = "bucket-name"
+}
+
+resource "numspot_bucket_object" "config" {
+  bucket       = numspot_bucket.bucket.name
+  key          = "config/app.json"
+  content      = jsonencode({ debug = false })
+  content_type = "application/json"
+
+  metadata = {
+    owner = "platform"
+  }
+}
+
+resource "numspot_bucket_object" "bootstrap" {
+  bucket = numspot_bucket.bucket.name
+  key    = "bootstrap/bootstrap.tar.gz"
+  source = "${path.module}/bootstrap.tar.gz"
+  etag   = filemd5("${path.module}/bootstrap.tar.gz")
+}
@@ -0,0 +1,20 @@
+resource "numspot_bucket" "bucket" {
+  name = "bucket-name"
+}
+
+resource "numspot_bucket_policy" "policy" {
+  bucket = numspot_bucket.bucket.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid       = "PublicRead"
+        Effect    = "Allow"
+        Principal = "*"
+        Action    = ["s3:GetObject"]
+        Resource  = ["arn:aws:s3:::bucket-name/*"]
+      }
+    ]
+  })
+}
@@ -0,0 +1,8 @@
+resource "numspot_bucket" "bucket" {
+  name = "bucket-name"
+}
+
+resource "numspot_bucket_versioning" "versioning" {
+  bucket = numspot_bucket.bucket.name
+  status = "Enabled"
+}
@@ -0,0 +1,16 @@
+resource "numspot_vpc" "vpc" {
+  ip_range = "10.101.0.0/16"
+}
+
+resource "numspot_virtual_gateway" "virtual-gateway" {
+  connection_type = "ipsec.1"
+  vpc_id          = numspot_vpc.vpc.id
+}
+
+resource "numspot_direct_link_interface" "direct-link-interface" {
+  name               = "direct-link-interface"
+  direct_link_id     = "dxcon-12345678"
+  virtual_gateway_id = numspot_virtual_gateway.virtual-gateway.id
+  bgp_asn            = 65000
+  vlan               = 42
+}
@@ -0,0 +1,13 @@
+data "numspot_roles" "viewer" {
+  name = "viewer"
+}
+
+resource "numspot_service_account" "ci" {
+  name = "ci"
+}
+
+resource "numspot_iam_policy" "ci" {
+  subject_type = "serviceAccounts"
+  subject_id   = numspot_service_account.ci.id
+  roles        = [data.numspot_roles.viewer.items.0.id]
+}
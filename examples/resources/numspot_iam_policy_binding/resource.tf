@@ -0,0 +1,11 @@
+data "numspot_permissions" "vm_read" {
+  service  = "compute"
+  resource = "vms"
+  action   = "get"
+}
+
+resource "numspot_iam_policy_binding" "ci" {
+  subject_type = "serviceAccounts"
+  subject_id   = "0f3b5d0c-0cc0-4c07-a6e3-d2a6b6b2e3f1"
+  permissions  = data.numspot_permissions.vm_read.items[*].id
+}
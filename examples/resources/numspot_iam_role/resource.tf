@@ -0,0 +1,9 @@
+resource "numspot_iam_role" "auditor" {
+  name        = "auditor"
+  description = "Read only access to the space"
+}
+
+resource "numspot_iam_role" "billing" {
+  organisation_id = "67d97ad4-3005-48dc-a392-60a97ea5a7a5"
+  name            = "billing"
+}
@@ -4,4 +4,9 @@ resource "numspot_kubernetes_cluster" "kubernetes-cluster" {
   profile    = "small"
   version    = "1.32"
   visibility = "EXTERNAL"
+
+  timeouts {
+    create = "45m"
+    delete = "30m"
+  }
 }
@@ -54,6 +54,8 @@ resource "numspot_load_balancer" "load-balancer" {
   backend_vm_ids  = [numspot_vm.vm.id]
   backend_ips     = ["192.0.2.0"]
 
+  wait_for_healthy     = true
+  min_healthy_backends = 1
 
   health_check = {
     check_interval      = 30
@@ -0,0 +1,22 @@
+resource "numspot_load_balancer" "load-balancer" {
+  name    = "load-balancer"
+  type    = "internal"
+  subnets = [numspot_subnet.subnet.id]
+
+  listeners = [
+    {
+      backend_port           = 80
+      load_balancer_port     = 80
+      backend_protocol       = "HTTP"
+      load_balancer_protocol = "HTTP"
+    }
+  ]
+}
+
+resource "numspot_load_balancer_listener" "listener" {
+  load_balancer_name     = numspot_load_balancer.load-balancer.name
+  load_balancer_port     = 8080
+  load_balancer_protocol = "HTTP"
+  backend_port           = 8080
+  backend_protocol       = "HTTP"
+}
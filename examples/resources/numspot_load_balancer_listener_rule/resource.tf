@@ -0,0 +1,24 @@
+resource "numspot_load_balancer" "load-balancer" {
+  name    = "load-balancer"
+  type    = "internal"
+  subnets = [numspot_subnet.subnet.id]
+
+  listeners = [
+    {
+      backend_port           = 80
+      load_balancer_port     = 80
+      backend_protocol       = "HTTP"
+      load_balancer_protocol = "HTTP"
+    }
+  ]
+}
+
+resource "numspot_load_balancer_listener_rule" "api" {
+  name               = "api"
+  load_balancer_name = numspot_load_balancer.load-balancer.name
+  load_balancer_port = 80
+  priority           = 10
+  host_name_pattern  = "api.example.com"
+  path_pattern       = "/v1/*"
+  vm_ids             = [numspot_vm.api.id]
+}
@@ -0,0 +1,13 @@
+resource "numspot_load_balancer_policy" "app-policy" {
+  load_balancer_name = numspot_load_balancer.load-balancer.name
+  name               = "app-policy"
+  policy_type        = "app"
+  cookie_name        = "SESSIONID"
+}
+
+resource "numspot_load_balancer_policy" "load-balancer-policy" {
+  load_balancer_name       = numspot_load_balancer.load-balancer.name
+  name                     = "load-balancer-policy"
+  policy_type              = "load_balancer"
+  cookie_expiration_period = 3600
+}
@@ -16,4 +16,9 @@ resource "numspot_postgres_cluster" "postgres-cluster" {
     version = "string"
   }]
   replica_count = 1
+
+  timeouts {
+    create = "45m"
+    delete = "30m"
+  }
 }
@@ -0,0 +1,15 @@
+resource "numspot_service_account" "ci" {
+  name           = "ci"
+  token_duration = "PT1H"
+}
+
+resource "numspot_service_account" "deployer" {
+  organisation_id = "67d97ad4-3005-48dc-a392-60a97ea5a7a5"
+  name            = "deployer"
+  expiration_date = "2027-01-01T00:00:00Z"
+}
+
+output "ci_client_secret" {
+  value     = numspot_service_account.ci.secret
+  sensitive = true
+}
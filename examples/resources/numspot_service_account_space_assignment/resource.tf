@@ -0,0 +1,10 @@
+resource "numspot_service_account" "deployer" {
+  organisation_id = "67d97ad4-3005-48dc-a392-60a97ea5a7a5"
+  name            = "deployer"
+}
+
+resource "numspot_service_account_space_assignment" "deployer" {
+  organisation_id    = numspot_service_account.deployer.organisation_id
+  service_account_id = numspot_service_account.deployer.id
+  space_id           = "bba8c1df-609f-4775-9638-952d488502e6"
+}
@@ -0,0 +1,11 @@
+resource "numspot_user" "jane" {
+  organisation_id = "67d97ad4-3005-48dc-a392-60a97ea5a7a5"
+  email           = "jane.doe@example.com"
+  firstname       = "Jane"
+  lastname        = "Doe"
+}
+
+resource "numspot_space_user" "jane" {
+  user_id  = numspot_user.jane.id
+  space_id = "bba8c1df-609f-4775-9638-952d488502e6"
+}
@@ -0,0 +1,14 @@
+resource "numspot_user" "jane" {
+  organisation_id = "67d97ad4-3005-48dc-a392-60a97ea5a7a5"
+  email           = "jane.doe@example.com"
+  firstname       = "Jane"
+  lastname        = "Doe"
+}
+
+resource "numspot_user" "john" {
+  organisation_id = "67d97ad4-3005-48dc-a392-60a97ea5a7a5"
+  email           = "john.doe@example.com"
+  firstname       = "John"
+  lastname        = "Doe"
+  active          = false
+}
@@ -0,0 +1,89 @@
+// Package clienttest provides an SDK backed by a stub server for the tests of the packages using the NumSpot API.
+package clienttest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/sdk/api"
+)
+
+// NewStubSDK returns an SDK sending its requests to a TLS server answering the token requests itself and forwarding
+// every other request to handler
+func NewStubSDK(t *testing.T, handler http.Handler) *client.NumSpotSDK {
+	t.Helper()
+
+	return newSDK(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/iam/token" {
+			WriteJSON(w, http.StatusOK, api.TokenResp{AccessToken: "token", ExpiresIn: 3600, TokenType: "Bearer"})
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+}
+
+// NewExpiringStubSDK is NewStubSDK with a new access token expiring after expiresIn seconds for each token request.
+// The requests sent with an expired token are answered with 401 Unauthorized.
+func NewExpiringStubSDK(t *testing.T, handler http.Handler, expiresIn int) *client.NumSpotSDK {
+	t.Helper()
+
+	var mu sync.Mutex
+	tokenExpirations := map[string]time.Time{}
+
+	return newSDK(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.URL.Path == "/iam/token" {
+			token := uuid.NewString()
+			tokenExpirations[token] = time.Now().Add(time.Duration(expiresIn) * time.Second)
+			WriteJSON(w, http.StatusOK, api.TokenResp{AccessToken: token, ExpiresIn: expiresIn, TokenType: "Bearer"})
+			return
+		}
+
+		expiration, ok := tokenExpirations[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")]
+		if !ok || time.Now().After(expiration) {
+			WriteJSON(w, http.StatusUnauthorized, api.Error{Title: "Unauthorized"})
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+}
+
+func newSDK(t *testing.T, handler http.Handler) *client.NumSpotSDK {
+	t.Helper()
+
+	server := httptest.NewTLSServer(handler)
+	t.Cleanup(server.Close)
+
+	provider, err := client.NewNumSpotSDK(context.Background(),
+		client.WithHost(server.URL),
+		client.WithHostOs(server.URL),
+		client.WithClientID(uuid.NewString()),
+		client.WithClientSecret("secret"),
+		client.WithSpaceID(uuid.NewString()),
+	)
+	require.NoError(t, err)
+
+	return provider
+}
+
+// WriteJSON answers with body, encoded as an API error for the error status codes
+func WriteJSON(w http.ResponseWriter, statusCode int, body any) {
+	contentType := "application/json"
+	if statusCode >= http.StatusBadRequest {
+		contentType = "application/problem+json"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -3,9 +3,12 @@ package client
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/securityprovider"
@@ -21,6 +24,9 @@ const (
 	Credentials        = "client_credentials"
 	serviceS3          = "s3"
 	regionS3           = "eu-west-2"
+
+	// tokenRefreshMargin is how long before its expiration the access token is refreshed
+	tokenRefreshMargin = 5 * time.Minute
 )
 
 type NumSpotSDK struct {
@@ -35,6 +41,21 @@ type NumSpotSDK struct {
 	Host                  string
 	HostOs                string
 	AccessTokenExpiration time.Time
+	SignFuncExpiration    time.Time
+	InsecureSkipVerify    bool
+	RootCAs               *x509.CertPool
+	ClientCertificates    []tls.Certificate
+
+	// tokenMutex guards the access token and the clients and credentials derived from it, which are replaced when it is
+	// refreshed
+	tokenMutex  sync.RWMutex
+	accessToken string
+
+	// parent is the SDK holding the access token of the SDKs returned by ForSpace
+	parent *NumSpotSDK
+
+	// insecureSkipVerifySet is true when the verification has been enabled or disabled with WithInsecureSkipVerify
+	insecureSkipVerifySet bool
 }
 
 type Option func(s *NumSpotSDK) error
@@ -75,6 +96,7 @@ func WithClientSecret(clientSecret string) Option {
 	}
 }
 
+// WithHTTPClient replaces the HTTP client built by the SDK, it cannot be combined with the TLS options.
 func WithHTTPClient(client *http.Client) Option {
 	return func(s *NumSpotSDK) error {
 		s.HTTPClient = client
@@ -89,6 +111,43 @@ func WithHostOs(hostOs string) Option {
 	}
 }
 
+// WithInsecureSkipVerify disables the verification of the server certificate chain and host name. The verification is
+// disabled by default, unless a CA bundle or a client certificate is given.
+func WithInsecureSkipVerify(insecure bool) Option {
+	return func(s *NumSpotSDK) error {
+		s.InsecureSkipVerify = insecure
+		s.insecureSkipVerifySet = true
+		return nil
+	}
+}
+
+// WithCABundle adds the PEM encoded certificates of caBundle to the system roots used to verify the servers.
+func WithCABundle(caBundle []byte) Option {
+	return func(s *NumSpotSDK) error {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(caBundle) {
+			return errors.New("no valid PEM certificate found in CA bundle")
+		}
+		s.RootCAs = rootCAs
+		return nil
+	}
+}
+
+// WithClientCertificate presents the PEM encoded certificate and key to the servers requesting mutual TLS.
+func WithClientCertificate(certificate, key []byte) Option {
+	return func(s *NumSpotSDK) error {
+		clientCertificate, err := tls.X509KeyPair(certificate, key)
+		if err != nil {
+			return fmt.Errorf("invalid client certificate : %v", err)
+		}
+		s.ClientCertificates = append(s.ClientCertificates, clientCertificate)
+		return nil
+	}
+}
+
 func NewNumSpotSDK(ctx context.Context, options ...Option) (*NumSpotSDK, error) {
 	sdk := &NumSpotSDK{
 		ID:                    uuid.NewString(),
@@ -100,6 +159,16 @@ func NewNumSpotSDK(ctx context.Context, options ...Option) (*NumSpotSDK, error)
 		}
 	}
 
+	// The TLS options configure the clients built by the SDK, an injected client would silently ignore them
+	if sdk.HTTPClient != nil && (sdk.insecureSkipVerifySet || sdk.RootCAs != nil || len(sdk.ClientCertificates) > 0) {
+		return nil, errors.New("the insecure, CA bundle and client certificate options cannot be used with a custom HTTP client")
+	}
+
+	// The CA bundle and the client certificate would be pointless without verifying the servers
+	if !sdk.insecureSkipVerifySet {
+		sdk.InsecureSkipVerify = sdk.RootCAs == nil && len(sdk.ClientCertificates) == 0
+	}
+
 	err := sdk.createClientAPI()
 	if err != nil {
 		return nil, err
@@ -118,11 +187,51 @@ func NewNumSpotSDK(ctx context.Context, options ...Option) (*NumSpotSDK, error)
 	return sdk, nil
 }
 
+// ForSpace returns an SDK operating in spaceID. It shares the access token and the clients of s, so that the resources
+// of several spaces can be managed with the same credentials.
+func (s *NumSpotSDK) ForSpace(spaceID api.SpaceId) *NumSpotSDK {
+	root := s
+	if s.parent != nil {
+		root = s.parent
+	}
+
+	if spaceID == root.SpaceID {
+		return root
+	}
+
+	return &NumSpotSDK{
+		ID:       root.ID,
+		OsClient: root.OsClient,
+		SpaceID:  spaceID,
+		ClientID: root.ClientID,
+		Host:     root.Host,
+		HostOs:   root.HostOs,
+		parent:   root,
+	}
+}
+
 func isTokenExpired(expirationTime time.Time) bool {
 	return time.Now().After(expirationTime)
 }
 
+// GetClient returns the API client, after refreshing the access token if it is about to expire. It is safe for concurrent use.
 func (s *NumSpotSDK) GetClient(ctx context.Context) (*api.ClientWithResponses, error) {
+	if s.parent != nil {
+		return s.parent.GetClient(ctx)
+	}
+
+	s.tokenMutex.RLock()
+	numspotClient, expiration := s.Client, s.AccessTokenExpiration
+	s.tokenMutex.RUnlock()
+
+	if !isTokenExpired(expiration) {
+		return numspotClient, nil
+	}
+
+	// Only the first caller refreshes the access token, the others wait for it and get the new client
+	s.tokenMutex.Lock()
+	defer s.tokenMutex.Unlock()
+
 	if isTokenExpired(s.AccessTokenExpiration) {
 		if err := s.authenticateUser(ctx); err != nil {
 			return nil, fmt.Errorf("error while refreshing access token : %v", err)
@@ -131,6 +240,38 @@ func (s *NumSpotSDK) GetClient(ctx context.Context) (*api.ClientWithResponses, e
 	return s.Client, nil
 }
 
+// GetSignFunc returns the request editor signing the object storage requests, after refreshing the access token and
+// the object storage credentials if they are about to expire. It is safe for concurrent use.
+func (s *NumSpotSDK) GetSignFunc(ctx context.Context) (objectstorage.RequestEditorFn, error) {
+	if s.parent != nil {
+		return s.parent.GetSignFunc(ctx)
+	}
+
+	s.tokenMutex.RLock()
+	signFunc, expiration := s.SignFunc, s.SignFuncExpiration
+	s.tokenMutex.RUnlock()
+
+	if signFunc != nil && !isTokenExpired(expiration) {
+		return signFunc, nil
+	}
+
+	s.tokenMutex.Lock()
+	defer s.tokenMutex.Unlock()
+
+	if isTokenExpired(s.AccessTokenExpiration) {
+		if err := s.authenticateUser(ctx); err != nil {
+			return nil, fmt.Errorf("error while refreshing access token : %v", err)
+		}
+	}
+
+	if s.SignFunc == nil || isTokenExpired(s.SignFuncExpiration) {
+		if err := s.setupS3Client(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return s.SignFunc, nil
+}
+
 func (s *NumSpotSDK) createClientAPI() error {
 	requestEditor := api.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
 		req.Header.Add(UserAgentHeader, TerraformUserAgent)
@@ -148,16 +289,7 @@ func (s *NumSpotSDK) createClientAPI() error {
 
 func (s *NumSpotSDK) createClientOs() error {
 	newTransportOs := func(c *objectstorage.Client) error {
-		if s.HTTPClient != nil {
-			c.Client = s.HTTPClient
-		} else {
-			c.Client = &http.Client{
-				Transport: &http.Transport{
-					TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-					Proxy:           http.ProxyFromEnvironment,
-				},
-			}
-		}
+		c.Client = s.newHTTPClient()
 		return nil
 	}
 
@@ -175,6 +307,8 @@ func (s *NumSpotSDK) createClientOs() error {
 	return nil
 }
 
+// authenticateUser retrieves a new access token and replaces the clients using it, tokenMutex must be held by the caller
+// once the SDK is shared.
 func (s *NumSpotSDK) authenticateUser(ctx context.Context) error {
 	basicAuth := buildBasicAuth(s.ClientID.String(), s.ClientSecret)
 
@@ -188,20 +322,19 @@ func (s *NumSpotSDK) authenticateUser(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	err = utils.ParseHTTPError(response.Body, response.StatusCode())
+	err = utils.ParseHTTPError(response.Body, response.StatusCode(), response.HTTPResponse.Header)
 	if err != nil {
 		return fmt.Errorf("error while retrieving access token for client : %v", err.Error())
 	}
 
-	expirationTimeMargin := 5 * 60 // Add a margin of 5 minutes to refresh the token
-	var expirationTime int
-	if response.JSON200.ExpiresIn > expirationTimeMargin {
-		expirationTime = response.JSON200.ExpiresIn - expirationTimeMargin
-	} else {
-		return fmt.Errorf("error while retrieving access token expiration time. Invalid expiration time found. Found %v but expected and expiration time higher than %v", response.JSON200.ExpiresIn, expirationTimeMargin)
+	expiresIn := time.Duration(response.JSON200.ExpiresIn) * time.Second
+	if expiresIn <= 0 {
+		return fmt.Errorf("error while retrieving access token expiration time. Invalid expiration time found : %v", response.JSON200.ExpiresIn)
 	}
 
-	s.AccessTokenExpiration = time.Now().Add(time.Duration(expirationTime) * time.Second)
+	// Refresh the token before it expires, at the latest halfway through its lifetime for short-lived tokens
+	refreshMargin := min(tokenRefreshMargin, expiresIn/2)
+	expiration := time.Now().Add(expiresIn - refreshMargin)
 
 	bearerProvider, err := securityprovider.NewSecurityProviderBearerToken(response.JSON200.AccessToken)
 	if err != nil {
@@ -213,23 +346,44 @@ func (s *NumSpotSDK) authenticateUser(ctx context.Context) error {
 		return err
 	}
 
-	err = s.setupS3Client(ctx, response)
-	if err != nil {
-		return err
-	}
+	s.accessToken = response.JSON200.AccessToken
+	s.AccessTokenExpiration = expiration
+	// The object storage credentials are converted from the access token, they are converted again on first use
+	s.SignFuncExpiration = time.Time{}
 
 	return nil
 }
 
-func (s *NumSpotSDK) setupS3Client(ctx context.Context, response *api.TokenResponse) error {
-	res, err := s.Client.ConvertTokenWithResponse(ctx, api.ConvertTokenJSONRequestBody{Token: response.JSON200.AccessToken})
-	if err != nil || res.StatusCode() != 200 {
-		return err
+// setupS3Client converts the access token into the credentials signing the object storage requests, tokenMutex must be
+// held by the caller.
+func (s *NumSpotSDK) setupS3Client(ctx context.Context) error {
+	res, err := s.Client.ConvertTokenWithResponse(ctx, api.ConvertTokenJSONRequestBody{Token: s.accessToken})
+	if err != nil {
+		return fmt.Errorf("error while converting access token to object storage credentials : %v", err)
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		errorString := utils.NewAPIError(res.Body, res.StatusCode(), res.HTTPResponse.Header).Error()
+		for _, oauthError := range []*api.ErrorOauth2{res.JSON400, res.JSON500} {
+			if oauthError != nil {
+				errorString = oauthError.Error
+				if oauthError.ErrorDescription != nil {
+					errorString = errorString + ": " + *oauthError.ErrorDescription
+				}
+			}
+		}
+		return fmt.Errorf("error while converting access token to object storage credentials : got http status code %v. %v", res.StatusCode(), errorString)
+	}
+
+	if res.JSON200 == nil || res.JSON200.Ak == "" || res.JSON200.Sk == "" {
+		return errors.New("error while converting access token to object storage credentials : no credentials in response")
 	}
 
 	s.SignFunc = utils.SignRequest(serviceS3, regionS3, res.JSON200.Ak, res.JSON200.Sk)
+	// The credentials are valid as long as the access token they were converted from
+	s.SignFuncExpiration = s.AccessTokenExpiration
 
-	return err
+	return nil
 }
 
 func buildBasicAuth(username, password string) string {
@@ -239,16 +393,29 @@ func buildBasicAuth(username, password string) string {
 
 func (s *NumSpotSDK) newApiTransport() func(c *api.Client) error {
 	return func(c *api.Client) error {
-		if s.HTTPClient != nil {
-			c.Client = s.HTTPClient
-		} else {
-			c.Client = &http.Client{
-				Transport: &http.Transport{
-					TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-					Proxy:           http.ProxyFromEnvironment,
-				},
-			}
-		}
+		c.Client = s.newHTTPClient()
 		return nil
 	}
 }
+
+func (s *NumSpotSDK) newHTTPClient() *http.Client {
+	if s.HTTPClient != nil {
+		return s.HTTPClient
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: s.newTLSConfig(),
+			Proxy:           http.ProxyFromEnvironment,
+		},
+	}
+}
+
+func (s *NumSpotSDK) newTLSConfig() *tls.Config {
+	return &tls.Config{
+		InsecureSkipVerify: s.InsecureSkipVerify,
+		RootCAs:            s.RootCAs,
+		Certificates:       s.ClientCertificates,
+		MinVersion:         tls.VersionTLS12,
+	}
+}
@@ -0,0 +1,356 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-numspot/internal/sdk/api"
+)
+
+// newFakeNumSpotHandler serves the IAM endpoints hit while building a NumSpotSDK
+func newFakeNumSpotHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/iam/token", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(api.TokenResp{AccessToken: "token", ExpiresIn: 3600, TokenType: "Bearer"})
+	})
+	mux.HandleFunc("/iam/token/convert", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(api.AKSK{Ak: "ak", Sk: "sk"})
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	return mux
+}
+
+// newCountingNumSpotHandler serves the same endpoints as newFakeNumSpotHandler, with access tokens expiring after
+// expiresIn seconds, and counts the token requests
+func newCountingNumSpotHandler(tokenRequests *atomic.Int64, expiresIn int) http.Handler {
+	fakeHandler := newFakeNumSpotHandler()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/iam/token" {
+			fakeHandler.ServeHTTP(w, r)
+			return
+		}
+
+		tokenRequests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(api.TokenResp{AccessToken: uuid.NewString(), ExpiresIn: expiresIn, TokenType: "Bearer"})
+	})
+}
+
+func serverCABundle(server *httptest.Server) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+}
+
+func newSelfSignedClientCertificate(t *testing.T) (certPEM, keyPEM []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform-provider-numspot"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func newTestSDK(server *httptest.Server, options ...Option) (*NumSpotSDK, error) {
+	defaultOptions := []Option{
+		WithHost(server.URL),
+		WithHostOs(server.URL),
+		WithClientID(uuid.NewString()),
+		WithClientSecret("secret"),
+		WithSpaceID(uuid.NewString()),
+	}
+	return NewNumSpotSDK(context.Background(), append(defaultOptions, options...)...)
+}
+
+func TestNewNumSpotSDK_InsecureByDefault(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewTLSServer(newFakeNumSpotHandler())
+	defer server.Close()
+
+	sdk, err := newTestSDK(server)
+	require.NoError(t, err)
+
+	signFunc, err := sdk.GetSignFunc(context.Background())
+	require.NoError(t, err)
+	assert.NotNil(t, signFunc)
+}
+
+func TestNewNumSpotSDK_VerifyUnknownAuthority(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewTLSServer(newFakeNumSpotHandler())
+	defer server.Close()
+
+	_, err := newTestSDK(server, WithInsecureSkipVerify(false))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "certificate")
+}
+
+func TestNewNumSpotSDK_VerifyWithCABundle(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewTLSServer(newFakeNumSpotHandler())
+	defer server.Close()
+
+	sdk, err := newTestSDK(server, WithInsecureSkipVerify(false), WithCABundle(serverCABundle(server)))
+	require.NoError(t, err)
+
+	signFunc, err := sdk.GetSignFunc(context.Background())
+	require.NoError(t, err)
+
+	res, err := sdk.OsClient.ListBucketsWithResponse(context.Background(), sdk.SpaceID, signFunc)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode())
+}
+
+func TestNewNumSpotSDK_VerifyByDefaultWithCABundleOrClientCertificate(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewTLSServer(newFakeNumSpotHandler())
+	defer server.Close()
+	certPEM, keyPEM := newSelfSignedClientCertificate(t)
+
+	// The server is not trusted by a CA bundle holding an unrelated certificate
+	_, err := newTestSDK(server, WithCABundle(certPEM))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "certificate")
+
+	_, err = newTestSDK(server, WithClientCertificate(certPEM, keyPEM))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "certificate")
+
+	_, err = newTestSDK(server, WithCABundle(certPEM), WithInsecureSkipVerify(true))
+	require.NoError(t, err)
+
+	_, err = newTestSDK(server, WithCABundle(serverCABundle(server)))
+	require.NoError(t, err)
+}
+
+func TestNewNumSpotSDK_InvalidCABundle(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewNumSpotSDK(context.Background(), WithCABundle([]byte("not a certificate")))
+
+	require.Error(t, err)
+}
+
+func TestNewNumSpotSDK_TLSOptionsWithHTTPClient(t *testing.T) {
+	t.Parallel()
+	certPEM, keyPEM := newSelfSignedClientCertificate(t)
+
+	for _, option := range []Option{WithInsecureSkipVerify(true), WithCABundle(certPEM), WithClientCertificate(certPEM, keyPEM)} {
+		_, err := NewNumSpotSDK(context.Background(), WithHTTPClient(http.DefaultClient), option)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "custom HTTP client")
+	}
+}
+
+func TestNewNumSpotSDK_ClientCertificate(t *testing.T) {
+	t.Parallel()
+	certPEM, keyPEM := newSelfSignedClientCertificate(t)
+
+	clientCAs := x509.NewCertPool()
+	require.True(t, clientCAs.AppendCertsFromPEM(certPEM))
+
+	server := httptest.NewUnstartedServer(newFakeNumSpotHandler())
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	defer server.Close()
+
+	_, err := newTestSDK(server, WithInsecureSkipVerify(false), WithCABundle(serverCABundle(server)))
+	require.Error(t, err)
+
+	sdk, err := newTestSDK(server,
+		WithInsecureSkipVerify(false),
+		WithCABundle(serverCABundle(server)),
+		WithClientCertificate(certPEM, keyPEM),
+	)
+	require.NoError(t, err)
+
+	signFunc, err := sdk.GetSignFunc(context.Background())
+	require.NoError(t, err)
+
+	res, err := sdk.OsClient.ListBucketsWithResponse(context.Background(), sdk.SpaceID, signFunc)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode())
+}
+
+func TestWithClientCertificate_InvalidKeyPair(t *testing.T) {
+	t.Parallel()
+	certPEM, _ := newSelfSignedClientCertificate(t)
+	_, otherKeyPEM := newSelfSignedClientCertificate(t)
+
+	err := WithClientCertificate(certPEM, otherKeyPEM)(&NumSpotSDK{})
+
+	require.Error(t, err)
+}
+
+func TestGetClient_ConcurrentRefresh(t *testing.T) {
+	t.Parallel()
+	var tokenRequests atomic.Int64
+	server := httptest.NewTLSServer(newCountingNumSpotHandler(&tokenRequests, 3600))
+	defer server.Close()
+
+	sdk, err := newTestSDK(server)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), tokenRequests.Load())
+
+	for round := int64(2); round <= 4; round++ {
+		sdk.tokenMutex.Lock()
+		sdk.AccessTokenExpiration = time.Now()
+		sdk.tokenMutex.Unlock()
+
+		var wg sync.WaitGroup
+		for range 50 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				numspotClient, err := sdk.GetClient(context.Background())
+				assert.NoError(t, err)
+				assert.NotNil(t, numspotClient)
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, round, tokenRequests.Load())
+	}
+}
+
+func TestGetClient_ProactiveRefresh(t *testing.T) {
+	t.Parallel()
+	var tokenRequests atomic.Int64
+	server := httptest.NewTLSServer(newCountingNumSpotHandler(&tokenRequests, 2))
+	defer server.Close()
+
+	sdk, err := newTestSDK(server)
+	require.NoError(t, err)
+
+	// A 2 seconds token is refreshed after 1 second
+	assert.WithinDuration(t, time.Now().Add(time.Second), sdk.AccessTokenExpiration, 500*time.Millisecond)
+
+	_, err = sdk.GetClient(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), tokenRequests.Load())
+
+	time.Sleep(1100 * time.Millisecond)
+	_, err = sdk.GetClient(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), tokenRequests.Load())
+}
+
+func TestGetSignFunc_ConversionFailure(t *testing.T) {
+	t.Parallel()
+	fakeHandler := newFakeNumSpotHandler()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/iam/token/convert" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(api.ErrorOauth2{Error: "invalid_token"})
+			return
+		}
+		fakeHandler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	sdk, err := newTestSDK(server)
+	require.NoError(t, err)
+
+	_, err = sdk.GetSignFunc(context.Background())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid_token")
+}
+
+func TestGetSignFunc_RefreshedWithAccessToken(t *testing.T) {
+	t.Parallel()
+	var convertRequests atomic.Int64
+	fakeHandler := newFakeNumSpotHandler()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/iam/token/convert" {
+			convertRequests.Add(1)
+		}
+		fakeHandler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	sdk, err := newTestSDK(server)
+	require.NoError(t, err)
+
+	for range 3 {
+		_, err = sdk.GetSignFunc(context.Background())
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int64(1), convertRequests.Load())
+
+	sdk.tokenMutex.Lock()
+	sdk.AccessTokenExpiration = time.Now()
+	sdk.tokenMutex.Unlock()
+
+	_, err = sdk.GetClient(context.Background())
+	require.NoError(t, err)
+	_, err = sdk.GetSignFunc(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), convertRequests.Load())
+}
+
+func TestForSpace_SharesAccessToken(t *testing.T) {
+	t.Parallel()
+	var tokenRequests atomic.Int64
+	server := httptest.NewTLSServer(newCountingNumSpotHandler(&tokenRequests, 3600))
+	defer server.Close()
+
+	sdk, err := newTestSDK(server)
+	require.NoError(t, err)
+
+	spaceID := uuid.New()
+	spaceSDK := sdk.ForSpace(spaceID)
+	assert.Equal(t, spaceID, spaceSDK.SpaceID)
+	assert.Same(t, sdk, spaceSDK.ForSpace(sdk.SpaceID))
+	assert.Same(t, sdk, sdk.ForSpace(sdk.SpaceID))
+
+	sdk.tokenMutex.Lock()
+	sdk.AccessTokenExpiration = time.Now()
+	sdk.tokenMutex.Unlock()
+
+	spaceClient, err := spaceSDK.GetClient(context.Background())
+	require.NoError(t, err)
+	numspotClient, err := sdk.GetClient(context.Background())
+	require.NoError(t, err)
+
+	assert.Same(t, numspotClient, spaceClient)
+	assert.Equal(t, int64(2), tokenRequests.Load())
+}
@@ -0,0 +1,147 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
+)
+
+// CreateACL grants the permission of the ACL on a single resource to the subject, in the organisation when organisationID is set,
+// in the space of the provider otherwise. The other ACL functions use organisationID the same way
+func CreateACL(ctx context.Context, provider *client.NumSpotSDK, organisationID *api.OrganisationId, subjectType api.SubjectType, subjectID api.SubjectId, acl api.ACL) error {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	body := api.ACLList{Items: []api.ACL{acl}}
+
+	switch {
+	case organisationID != nil && subjectType == api.Users:
+		res, err := numspotClient.CreateACLUserOrganisationBulkWithResponse(ctx, *organisationID, subjectID, body)
+		if err != nil {
+			return err
+		}
+		return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+	case organisationID != nil:
+		res, err := numspotClient.CreateACLServiceAccountOrganisationBulkWithResponse(ctx, *organisationID, subjectID, body)
+		if err != nil {
+			return err
+		}
+		return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+	case subjectType == api.Users:
+		res, err := numspotClient.CreateACLUserSpaceBulkWithResponse(ctx, provider.SpaceID, subjectID, body)
+		if err != nil {
+			return err
+		}
+		return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+	default:
+		res, err := numspotClient.CreateACLServiceAccountSpaceBulkWithResponse(ctx, provider.SpaceID, subjectID, body)
+		if err != nil {
+			return err
+		}
+		return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+	}
+}
+
+// ReadACL returns the ACL of the subject matching the permission and the resource of acl
+func ReadACL(ctx context.Context, provider *client.NumSpotSDK, organisationID *api.OrganisationId, subjectType api.SubjectType, subjectID api.SubjectId, acl api.ACL) (*api.ACLListName, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var page *api.ListPolicyPage
+	for {
+		var list *api.ACLPaginatedList
+		switch {
+		case organisationID != nil && subjectType == api.Users:
+			res, err := numspotClient.GetACLUserOrganisationWithResponse(ctx, *organisationID, subjectID, &api.GetACLUserOrganisationParams{Page: page, Service: acl.Service, Resource: acl.Resource})
+			if err != nil {
+				return nil, err
+			}
+			if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+				return nil, err
+			}
+			list = res.JSON200
+		case organisationID != nil:
+			res, err := numspotClient.GetACLServiceAccountOrganisationWithResponse(ctx, *organisationID, subjectID, &api.GetACLServiceAccountOrganisationParams{Page: page, Service: acl.Service, Resource: acl.Resource})
+			if err != nil {
+				return nil, err
+			}
+			if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+				return nil, err
+			}
+			list = res.JSON200
+		case subjectType == api.Users:
+			res, err := numspotClient.GetACLUserSpaceWithResponse(ctx, provider.SpaceID, subjectID, &api.GetACLUserSpaceParams{Page: page, Service: acl.Service, Resource: acl.Resource})
+			if err != nil {
+				return nil, err
+			}
+			if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+				return nil, err
+			}
+			list = res.JSON200
+		default:
+			res, err := numspotClient.GetACLServiceAccountSpaceWithResponse(ctx, provider.SpaceID, subjectID, &api.GetACLServiceAccountSpaceParams{Page: page, Service: acl.Service, Resource: acl.Resource})
+			if err != nil {
+				return nil, err
+			}
+			if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+				return nil, err
+			}
+			list = res.JSON200
+		}
+
+		for _, item := range list.Items {
+			if item.PermissionId == acl.PermissionId && item.ResourceId == acl.ResourceId {
+				return &item, nil
+			}
+		}
+
+		if utils.GetPtrValue(list.NextPageToken) == "" {
+			return nil, &utils.NotFoundError{Err: fmt.Errorf("acl of permission %s on %s %s not found", acl.PermissionId, acl.Resource, acl.ResourceId)}
+		}
+		page = &api.ListPolicyPage{NextToken: list.NextPageToken}
+	}
+}
+
+// DeleteACL revokes the permission of the ACL on its resource from the subject
+func DeleteACL(ctx context.Context, provider *client.NumSpotSDK, organisationID *api.OrganisationId, subjectType api.SubjectType, subjectID api.SubjectId, acl api.ACL) error {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	body := api.ACLList{Items: []api.ACL{acl}}
+
+	switch {
+	case organisationID != nil && subjectType == api.Users:
+		res, err := numspotClient.DeleteACLUserOrganisationBulkWithResponse(ctx, *organisationID, subjectID, body)
+		if err != nil {
+			return err
+		}
+		return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+	case organisationID != nil:
+		res, err := numspotClient.DeleteACLServiceAccountOrganisationBulkWithResponse(ctx, *organisationID, subjectID, body)
+		if err != nil {
+			return err
+		}
+		return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+	case subjectType == api.Users:
+		res, err := numspotClient.DeleteACLUserSpaceBulkWithResponse(ctx, provider.SpaceID, subjectID, body)
+		if err != nil {
+			return err
+		}
+		return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+	default:
+		res, err := numspotClient.DeleteACLServiceAccountSpaceBulkWithResponse(ctx, provider.SpaceID, subjectID, body)
+		if err != nil {
+			return err
+		}
+		return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+	}
+}
@@ -1,15 +1,23 @@
 package core
 
 import (
+	"bytes"
 	"context"
+	"crypto/md5"
+	"encoding/base64"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 
 	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/sdk/objectstorage"
+	"terraform-provider-numspot/internal/utils"
 )
 
+const xmlContentType = "application/xml"
+
 type ListBucketsOutput struct {
 	AllBuckets *Buckets `json:"buckets,omitempty" xml:"Buckets"`
 }
@@ -24,15 +32,16 @@ type Bucket struct {
 }
 
 func CreateBucket(ctx context.Context, provider *client.NumSpotSDK, bucketName string) error {
-	_, err := provider.GetClient(ctx)
+	signFunc, err := provider.GetSignFunc(ctx)
 	if err != nil {
 		return err
 	}
 
-	res, err := provider.OsClient.CreateBucket(ctx, provider.SpaceID, bucketName, provider.SignFunc)
+	res, err := provider.OsClient.CreateBucket(ctx, provider.SpaceID, bucketName, signFunc)
 	if err != nil {
 		return err
 	}
+	defer res.Body.Close()
 	if res.StatusCode != http.StatusCreated {
 		return fmt.Errorf("failed to create Bucket %d", res.StatusCode)
 	}
@@ -41,56 +50,51 @@ func CreateBucket(ctx context.Context, provider *client.NumSpotSDK, bucketName s
 }
 
 func DeleteBucket(ctx context.Context, provider *client.NumSpotSDK, bucketName string) error {
-	_, err := provider.GetClient(ctx)
+	signFunc, err := provider.GetSignFunc(ctx)
 	if err != nil {
 		return err
 	}
 
-	res, err := provider.OsClient.DeleteBucket(ctx, provider.SpaceID, bucketName, provider.SignFunc)
+	res, err := provider.OsClient.DeleteBucket(ctx, provider.SpaceID, bucketName, signFunc)
 	if err != nil {
 		return err
 	}
+	defer res.Body.Close()
 	if res.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("failed to create Bucket %d", res.StatusCode)
+		return fmt.Errorf("failed to delete Bucket %d", res.StatusCode)
 	}
 
 	return nil
 }
 
 func ReadBucket(ctx context.Context, provider *client.NumSpotSDK, bucketName string) (*Bucket, error) {
-	_, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	res, err := ReadBuckets(ctx, provider)
 	if err != nil {
 		return nil, err
 	}
 
-	var ret Bucket
-	ll := len(res.AllBuckets.Buckets)
-	brk := false
-	for i := 0; ll > i && !brk; i++ {
-		if bucketName == (res.AllBuckets.Buckets)[i].Name {
-			brk = true
-			ret = (res.AllBuckets.Buckets)[i]
+	if res.AllBuckets != nil {
+		for _, bucket := range res.AllBuckets.Buckets {
+			if bucketName == bucket.Name {
+				return &bucket, nil
+			}
 		}
 	}
 
-	return &ret, nil
+	return nil, &utils.NotFoundError{Err: fmt.Errorf("bucket %s not found", bucketName)}
 }
 
 func ReadBuckets(ctx context.Context, provider *client.NumSpotSDK) (*ListBucketsOutput, error) {
-	_, err := provider.GetClient(ctx)
+	signFunc, err := provider.GetSignFunc(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := provider.OsClient.ListBuckets(ctx, provider.SpaceID, provider.SignFunc)
+	res, err := provider.OsClient.ListBuckets(ctx, provider.SpaceID, signFunc)
 	if err != nil {
 		return nil, err
 	}
+	defer res.Body.Close()
 
 	if http.StatusOK != res.StatusCode {
 		return nil, fmt.Errorf("failed to list buckets: %d", res.StatusCode)
@@ -105,3 +109,82 @@ func ReadBuckets(ctx context.Context, provider *client.NumSpotSDK) (*ListBuckets
 
 	return &listBucketResponseSchema, nil
 }
+
+// ReadBucketTags returns the tags of a bucket, the object storage answers NoSuchTagSet to buckets without tags
+func ReadBucketTags(ctx context.Context, provider *client.NumSpotSDK, bucketName string) ([]objectstorage.Tag, error) {
+	signFunc, err := provider.GetSignFunc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := provider.OsClient.GetBucketTaggingWithResponse(ctx, provider.SpaceID, bucketName, signFunc)
+	if err != nil {
+		return nil, err
+	}
+	err = utils.ParseObjectStorageError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+	var apiError *utils.APIError
+	if errors.As(err, &apiError) && apiError.Title == "NoSuchTagSet" {
+		return []objectstorage.Tag{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if res.XML200 == nil {
+		return []objectstorage.Tag{}, nil
+	}
+
+	return res.XML200.TagSet, nil
+}
+
+// UpdateBucketTags replaces the tags of a bucket, removing them all when tags is empty
+func UpdateBucketTags(ctx context.Context, provider *client.NumSpotSDK, bucketName string, tags []objectstorage.Tag) ([]objectstorage.Tag, error) {
+	signFunc, err := provider.GetSignFunc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tags) == 0 {
+		res, err := provider.OsClient.DeleteBucketTaggingWithResponse(ctx, provider.SpaceID, bucketName, signFunc)
+		if err != nil {
+			return nil, err
+		}
+		if err = utils.ParseObjectStorageError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+			return nil, err
+		}
+
+		return ReadBucketTags(ctx, provider, bucketName)
+	}
+
+	body, contentMD5, err := marshalBucketConfiguration(objectstorage.Tagging{TagSet: tags})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := provider.OsClient.PutBucketTaggingWithBodyWithResponse(ctx, provider.SpaceID, bucketName, xmlContentType, body, contentMD5, signFunc)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.ParseObjectStorageError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	return ReadBucketTags(ctx, provider, bucketName)
+}
+
+// marshalBucketConfiguration encodes a bucket sub-resource configuration to XML, with the editor setting its
+// Content-MD5 header, which the object storage requires on most configuration uploads
+func marshalBucketConfiguration(configuration any) (io.Reader, objectstorage.RequestEditorFn, error) {
+	payload, err := xml.Marshal(configuration)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	checksum := md5.Sum(payload)
+	contentMD5 := func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Content-MD5", base64.StdEncoding.EncodeToString(checksum[:]))
+		return nil
+	}
+
+	return bytes.NewReader(payload), contentMD5, nil
+}
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"context"
+
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/sdk/objectstorage"
+	"terraform-provider-numspot/internal/utils"
+)
+
+func ReadBucketCorsConfiguration(ctx context.Context, provider *client.NumSpotSDK, bucketName string) (*objectstorage.CORSConfiguration, error) {
+	signFunc, err := provider.GetSignFunc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := provider.OsClient.GetBucketCorsWithResponse(ctx, provider.SpaceID, bucketName, signFunc)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.ParseObjectStorageError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	if res.XML200 == nil {
+		return &objectstorage.CORSConfiguration{}, nil
+	}
+
+	return res.XML200, nil
+}
+
+func PutBucketCorsConfiguration(ctx context.Context, provider *client.NumSpotSDK, bucketName string, configuration objectstorage.CORSConfiguration) (*objectstorage.CORSConfiguration, error) {
+	signFunc, err := provider.GetSignFunc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	body, contentMD5, err := marshalBucketConfiguration(configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := provider.OsClient.PutBucketCorsWithBodyWithResponse(ctx, provider.SpaceID, bucketName, xmlContentType, body, contentMD5, signFunc)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.ParseObjectStorageError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	return ReadBucketCorsConfiguration(ctx, provider, bucketName)
+}
+
+func DeleteBucketCorsConfiguration(ctx context.Context, provider *client.NumSpotSDK, bucketName string) error {
+	signFunc, err := provider.GetSignFunc(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := provider.OsClient.DeleteBucketCorsWithResponse(ctx, provider.SpaceID, bucketName, signFunc)
+	if err != nil {
+		return err
+	}
+
+	return utils.ParseObjectStorageError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+}
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"context"
+
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/sdk/objectstorage"
+	"terraform-provider-numspot/internal/utils"
+)
+
+func ReadBucketLifecycleConfiguration(ctx context.Context, provider *client.NumSpotSDK, bucketName string) (*objectstorage.LifecycleConfiguration, error) {
+	signFunc, err := provider.GetSignFunc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := provider.OsClient.GetBucketLifecycleConfigurationWithResponse(ctx, provider.SpaceID, bucketName, signFunc)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.ParseObjectStorageError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	if res.XML200 == nil {
+		return &objectstorage.LifecycleConfiguration{}, nil
+	}
+
+	return res.XML200, nil
+}
+
+func PutBucketLifecycleConfiguration(ctx context.Context, provider *client.NumSpotSDK, bucketName string, configuration objectstorage.LifecycleConfiguration) (*objectstorage.LifecycleConfiguration, error) {
+	signFunc, err := provider.GetSignFunc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	body, contentMD5, err := marshalBucketConfiguration(configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := provider.OsClient.PutBucketLifecycleConfigurationWithBodyWithResponse(ctx, provider.SpaceID, bucketName, xmlContentType, body, contentMD5, signFunc)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.ParseObjectStorageError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	return ReadBucketLifecycleConfiguration(ctx, provider, bucketName)
+}
+
+func DeleteBucketLifecycleConfiguration(ctx context.Context, provider *client.NumSpotSDK, bucketName string) error {
+	signFunc, err := provider.GetSignFunc(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := provider.OsClient.DeleteBucketLifecycleWithResponse(ctx, provider.SpaceID, bucketName, signFunc)
+	if err != nil {
+		return err
+	}
+
+	return utils.ParseObjectStorageError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+}
@@ -0,0 +1,147 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/private/protocol/rest"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/utils"
+)
+
+const (
+	defaultObjectContentType = "binary/octet-stream"
+	objectMetadataHeader     = "X-Amz-Meta-"
+	objectVersionIdHeader    = "X-Amz-Version-Id"
+)
+
+// BucketObject is an object of a bucket, Body is only set when the object is read with ReadBucketObject
+type BucketObject struct {
+	Body          []byte
+	ContentType   string
+	ContentLength int64
+	ETag          string
+	LastModified  string
+	VersionId     string
+	Metadata      map[string]string
+}
+
+// PutBucketObject uploads the content of an object, replacing any object with the same key
+func PutBucketObject(ctx context.Context, provider *client.NumSpotSDK, bucketName, key string, content []byte, contentType string, metadata map[string]string) (*BucketObject, error) {
+	signFunc, err := provider.GetSignFunc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if contentType == "" {
+		contentType = defaultObjectContentType
+	}
+
+	checksum := md5.Sum(content)
+	objectHeaders := func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Content-MD5", base64.StdEncoding.EncodeToString(checksum[:]))
+		for name, value := range metadata {
+			req.Header.Set(objectMetadataHeader+name, value)
+		}
+		return nil
+	}
+
+	res, err := provider.OsClient.PutObjectWithBodyWithResponse(ctx, provider.SpaceID, bucketName, key, contentType, bytes.NewReader(content), objectKeyPath, objectHeaders, signFunc)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.ParseObjectStorageError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	return HeadBucketObject(ctx, provider, bucketName, key)
+}
+
+// HeadBucketObject returns the metadata of an object, without its body
+func HeadBucketObject(ctx context.Context, provider *client.NumSpotSDK, bucketName, key string) (*BucketObject, error) {
+	signFunc, err := provider.GetSignFunc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := provider.OsClient.HeadObjectWithResponse(ctx, provider.SpaceID, bucketName, key, objectKeyPath, signFunc)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.ParseObjectStorageError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	return bucketObjectFromHeader(res.HTTPResponse.Header), nil
+}
+
+// ReadBucketObject returns an object with its body
+func ReadBucketObject(ctx context.Context, provider *client.NumSpotSDK, bucketName, key string) (*BucketObject, error) {
+	signFunc, err := provider.GetSignFunc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := provider.OsClient.GetObjectWithResponse(ctx, provider.SpaceID, bucketName, key, nil, objectKeyPath, signFunc)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.ParseObjectStorageError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	object := bucketObjectFromHeader(res.HTTPResponse.Header)
+	object.Body = res.Body
+	object.ContentLength = int64(len(res.Body))
+
+	return object, nil
+}
+
+func DeleteBucketObject(ctx context.Context, provider *client.NumSpotSDK, bucketName, key string) error {
+	signFunc, err := provider.GetSignFunc(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := provider.OsClient.DeleteObjectWithResponse(ctx, provider.SpaceID, bucketName, key, objectKeyPath, signFunc)
+	if err != nil {
+		return err
+	}
+
+	return utils.ParseObjectStorageError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+}
+
+// objectKeyPath keeps the "/" of object keys in the request path, the generated client escapes them like any other
+// path parameter. It must be applied before the request is signed.
+func objectKeyPath(_ context.Context, req *http.Request) error {
+	req.URL.RawPath = rest.EscapePath(req.URL.Path, false)
+	return nil
+}
+
+func bucketObjectFromHeader(header http.Header) *BucketObject {
+	object := &BucketObject{
+		ContentType:  header.Get("Content-Type"),
+		ETag:         strings.Trim(header.Get("ETag"), `"`),
+		LastModified: header.Get("Last-Modified"),
+		VersionId:    header.Get(objectVersionIdHeader),
+		Metadata:     map[string]string{},
+	}
+
+	if contentLength, err := strconv.ParseInt(header.Get("Content-Length"), 10, 64); err == nil {
+		object.ContentLength = contentLength
+	}
+
+	// Header names are canonicalized by net/http, metadata keys are lower case as in the S3 API
+	for name, values := range header {
+		if metadataKey, ok := strings.CutPrefix(name, objectMetadataHeader); ok && len(values) > 0 {
+			object.Metadata[strings.ToLower(metadataKey)] = values[0]
+		}
+	}
+
+	return object
+}
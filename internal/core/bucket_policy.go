@@ -0,0 +1,60 @@
+package core
+
+import (
+	"context"
+	"strings"
+
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/utils"
+)
+
+const jsonContentType = "application/json"
+
+// ReadBucketPolicy returns the JSON policy document of a bucket, as stored by the object storage
+func ReadBucketPolicy(ctx context.Context, provider *client.NumSpotSDK, bucketName string) (string, error) {
+	signFunc, err := provider.GetSignFunc(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := provider.OsClient.GetBucketPolicyWithResponse(ctx, provider.SpaceID, bucketName, signFunc)
+	if err != nil {
+		return "", err
+	}
+	if err = utils.ParseObjectStorageError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return "", err
+	}
+
+	return string(res.Body), nil
+}
+
+func PutBucketPolicy(ctx context.Context, provider *client.NumSpotSDK, bucketName, policy string) (string, error) {
+	signFunc, err := provider.GetSignFunc(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := provider.OsClient.PutBucketPolicyWithBodyWithResponse(ctx, provider.SpaceID, bucketName, jsonContentType, strings.NewReader(policy), signFunc)
+	if err != nil {
+		return "", err
+	}
+	if err = utils.ParseObjectStorageError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return "", err
+	}
+
+	return ReadBucketPolicy(ctx, provider, bucketName)
+}
+
+func DeleteBucketPolicy(ctx context.Context, provider *client.NumSpotSDK, bucketName string) error {
+	signFunc, err := provider.GetSignFunc(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := provider.OsClient.DeleteBucketPolicyWithResponse(ctx, provider.SpaceID, bucketName, signFunc)
+	if err != nil {
+		return err
+	}
+
+	return utils.ParseObjectStorageError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+}
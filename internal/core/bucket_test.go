@@ -0,0 +1,331 @@
+package core
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-numspot/internal/client/clienttest"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/sdk/objectstorage"
+	"terraform-provider-numspot/internal/utils"
+)
+
+const testBucketName = "bucket"
+
+// s3Stub is an S3-compatible object storage keeping the sub-resources and the objects of a single bucket in memory
+type s3Stub struct {
+	mu           sync.Mutex
+	subResources map[string][]byte
+	objects      map[string]s3StubObject
+}
+
+func newS3Stub() *s3Stub {
+	return &s3Stub{subResources: map[string][]byte{}, objects: map[string]s3StubObject{}}
+}
+
+type s3StubObject struct {
+	body   []byte
+	header http.Header
+}
+
+var s3StubNotFoundCodes = map[string]string{
+	"versioning": "",
+	"lifecycle":  "NoSuchLifecycleConfiguration",
+	"cors":       "NoSuchCORSConfiguration",
+	"policy":     "NoSuchBucketPolicy",
+	"tagging":    "NoSuchTagSet",
+}
+
+func (s *s3Stub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/iam/token/convert" {
+		clienttest.WriteJSON(w, http.StatusOK, api.AKSK{Ak: "ak", Sk: "sk"})
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	payloadHash := sha256.Sum256(body)
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 ") || r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(payloadHash[:]) {
+		writeS3Error(w, http.StatusForbidden, "SignatureDoesNotMatch", "The request signature does not match")
+		return
+	}
+	if contentMD5 := r.Header.Get("Content-MD5"); contentMD5 != "" {
+		checksum := md5.Sum(body)
+		if contentMD5 != base64.StdEncoding.EncodeToString(checksum[:]) {
+			writeS3Error(w, http.StatusBadRequest, "BadDigest", "The Content-MD5 does not match")
+			return
+		}
+	}
+
+	// Paths are /spaces/{spaceId}/{bucket}[/{key}], the key keeping its "/"
+	pathParts := strings.SplitN(strings.TrimPrefix(r.URL.EscapedPath(), "/spaces/"), "/", 3)
+	if len(pathParts) == 3 {
+		s.serveObject(w, r, pathParts[1], pathParts[2], body)
+		return
+	}
+
+	// The signer canonicalizes "?cors" into "?cors="
+	subResource := strings.TrimSuffix(r.URL.RawQuery, "=")
+	notFoundCode, ok := s3StubNotFoundCodes[subResource]
+	if !ok {
+		http.Error(w, "unexpected sub-resource "+subResource, http.StatusBadRequest)
+		return
+	}
+	if !strings.HasSuffix(r.URL.Path, "/"+testBucketName) {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		stored, exists := s.subResources[subResource]
+		switch {
+		case exists && subResource == "policy":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(stored)
+		case exists:
+			w.Header().Set("Content-Type", "application/xml")
+			_, _ = w.Write(stored)
+		case notFoundCode == "":
+			w.Header().Set("Content-Type", "application/xml")
+			_, _ = w.Write([]byte("<VersioningConfiguration></VersioningConfiguration>"))
+		default:
+			writeS3Error(w, http.StatusNotFound, notFoundCode, "The configuration does not exist")
+		}
+	case http.MethodPut:
+		s.subResources[subResource] = body
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		delete(s.subResources, subResource)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *s3Stub) serveObject(w http.ResponseWriter, r *http.Request, bucketName, key string, body []byte) {
+	if bucketName != testBucketName {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+		return
+	}
+	if strings.Contains(key, "%2F") {
+		http.Error(w, "escaped key separator "+key, http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	object, exists := s.objects[key]
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		if !exists {
+			writeS3Error(w, http.StatusNotFound, "NoSuchKey", "The specified key does not exist")
+			return
+		}
+		for name, values := range object.header {
+			w.Header()[name] = values
+		}
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(object.body)
+		}
+	case http.MethodPut:
+		checksum := md5.Sum(body)
+		header := http.Header{
+			"Content-Type": {r.Header.Get("Content-Type")},
+			"Etag":         {`"` + hex.EncodeToString(checksum[:]) + `"`},
+		}
+		for name, values := range r.Header {
+			if strings.HasPrefix(name, "X-Amz-Meta-") {
+				header[name] = values
+			}
+		}
+		s.objects[key] = s3StubObject{body: body, header: header}
+		w.Header().Set("Etag", header.Get("Etag"))
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func writeS3Error(w http.ResponseWriter, statusCode int, code, message string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.Header().Set("X-Amz-Request-Id", "request-id")
+	w.WriteHeader(statusCode)
+	_, _ = fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message></Error>", code, message)
+}
+
+func TestBucketVersioning(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	provider := clienttest.NewStubSDK(t, newS3Stub())
+
+	versioning, err := ReadBucketVersioning(ctx, provider, testBucketName)
+	require.NoError(t, err)
+	assert.Nil(t, versioning.Status)
+
+	versioning, err = UpdateBucketVersioning(ctx, provider, testBucketName, objectstorage.BucketVersioningStatusEnabled)
+	require.NoError(t, err)
+	require.NotNil(t, versioning.Status)
+	assert.Equal(t, objectstorage.BucketVersioningStatusEnabled, *versioning.Status)
+
+	versioning, err = UpdateBucketVersioning(ctx, provider, testBucketName, objectstorage.BucketVersioningStatusSuspended)
+	require.NoError(t, err)
+	require.NotNil(t, versioning.Status)
+	assert.Equal(t, objectstorage.BucketVersioningStatusSuspended, *versioning.Status)
+}
+
+func TestBucketLifecycleConfiguration(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	provider := clienttest.NewStubSDK(t, newS3Stub())
+
+	_, err := ReadBucketLifecycleConfiguration(ctx, provider, testBucketName)
+	assert.True(t, utils.IsNotFound(err))
+
+	configuration := objectstorage.LifecycleConfiguration{Rules: []objectstorage.LifecycleRule{
+		{
+			Id:         utils.PointerOf("logs"),
+			Status:     objectstorage.ExpirationStatusEnabled,
+			Filter:     &objectstorage.LifecycleRuleFilter{Prefix: utils.PointerOf("logs/")},
+			Expiration: &objectstorage.LifecycleExpiration{Days: utils.PointerOf(30)},
+			AbortIncompleteMultipartUpload: &objectstorage.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: utils.PointerOf(7),
+			},
+		},
+		{
+			Id:                          utils.PointerOf("versions"),
+			Status:                      objectstorage.ExpirationStatusDisabled,
+			Filter:                      &objectstorage.LifecycleRuleFilter{Prefix: utils.PointerOf("")},
+			NoncurrentVersionExpiration: &objectstorage.NoncurrentVersionExpiration{NoncurrentDays: utils.PointerOf(90)},
+		},
+	}}
+
+	lifecycleConfiguration, err := PutBucketLifecycleConfiguration(ctx, provider, testBucketName, configuration)
+	require.NoError(t, err)
+	assert.Equal(t, configuration, *lifecycleConfiguration)
+
+	require.NoError(t, DeleteBucketLifecycleConfiguration(ctx, provider, testBucketName))
+	_, err = ReadBucketLifecycleConfiguration(ctx, provider, testBucketName)
+	assert.True(t, utils.IsNotFound(err))
+}
+
+func TestBucketCorsConfiguration(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	provider := clienttest.NewStubSDK(t, newS3Stub())
+
+	configuration := objectstorage.CORSConfiguration{CorsRules: []objectstorage.CORSRule{
+		{
+			AllowedHeaders: &[]string{"*"},
+			AllowedMethods: []string{"GET", "PUT"},
+			AllowedOrigins: []string{"https://www.example.com"},
+			ExposeHeaders:  &[]string{"ETag"},
+			Id:             utils.PointerOf("website"),
+			MaxAgeSeconds:  utils.PointerOf(3000),
+		},
+	}}
+
+	corsConfiguration, err := PutBucketCorsConfiguration(ctx, provider, testBucketName, configuration)
+	require.NoError(t, err)
+	assert.Equal(t, configuration, *corsConfiguration)
+
+	require.NoError(t, DeleteBucketCorsConfiguration(ctx, provider, testBucketName))
+	_, err = ReadBucketCorsConfiguration(ctx, provider, testBucketName)
+	assert.True(t, utils.IsNotFound(err))
+}
+
+func TestBucketPolicy(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	provider := clienttest.NewStubSDK(t, newS3Stub())
+
+	document := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`
+
+	policy, err := PutBucketPolicy(ctx, provider, testBucketName, document)
+	require.NoError(t, err)
+	assert.JSONEq(t, document, policy)
+
+	require.NoError(t, DeleteBucketPolicy(ctx, provider, testBucketName))
+	_, err = ReadBucketPolicy(ctx, provider, testBucketName)
+	assert.True(t, utils.IsNotFound(err))
+}
+
+func TestBucketTags(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	provider := clienttest.NewStubSDK(t, newS3Stub())
+
+	bucketTags, err := ReadBucketTags(ctx, provider, testBucketName)
+	require.NoError(t, err)
+	assert.Empty(t, bucketTags)
+
+	tags := []objectstorage.Tag{{Key: "env", Value: "test"}, {Key: "team", Value: "storage"}}
+	bucketTags, err = UpdateBucketTags(ctx, provider, testBucketName, tags)
+	require.NoError(t, err)
+	assert.Equal(t, tags, bucketTags)
+
+	bucketTags, err = UpdateBucketTags(ctx, provider, testBucketName, nil)
+	require.NoError(t, err)
+	assert.Empty(t, bucketTags)
+}
+
+func TestBucketConfiguration_UnknownBucket(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	provider := clienttest.NewStubSDK(t, newS3Stub())
+
+	_, err := UpdateBucketVersioning(ctx, provider, "unknown", objectstorage.BucketVersioningStatusEnabled)
+
+	require.Error(t, err)
+	assert.True(t, utils.IsNotFound(err))
+	assert.Equal(t, "NoSuchBucket: The specified bucket does not exist [request ID: request-id]", err.Error())
+}
+
+func TestBucketObject(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	provider := clienttest.NewStubSDK(t, newS3Stub())
+
+	key := "config/app settings.json"
+	content := []byte(`{"debug":true}`)
+	checksum := md5.Sum(content)
+
+	object, err := PutBucketObject(ctx, provider, testBucketName, key, content, "application/json", map[string]string{"owner": "team"})
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(checksum[:]), object.ETag)
+	assert.Equal(t, "application/json", object.ContentType)
+	assert.Equal(t, map[string]string{"owner": "team"}, object.Metadata)
+
+	object, err = ReadBucketObject(ctx, provider, testBucketName, key)
+	require.NoError(t, err)
+	assert.Equal(t, content, object.Body)
+	assert.Equal(t, int64(len(content)), object.ContentLength)
+
+	object, err = PutBucketObject(ctx, provider, testBucketName, key, []byte("raw"), "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, defaultObjectContentType, object.ContentType)
+	assert.Empty(t, object.Metadata)
+
+	require.NoError(t, DeleteBucketObject(ctx, provider, testBucketName, key))
+	_, err = HeadBucketObject(ctx, provider, testBucketName, key)
+	assert.True(t, utils.IsNotFound(err))
+	_, err = ReadBucketObject(ctx, provider, testBucketName, key)
+	assert.True(t, utils.IsNotFound(err))
+}
@@ -0,0 +1,52 @@
+package core
+
+import (
+	"context"
+
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/sdk/objectstorage"
+	"terraform-provider-numspot/internal/utils"
+)
+
+func ReadBucketVersioning(ctx context.Context, provider *client.NumSpotSDK, bucketName string) (*objectstorage.VersioningConfiguration, error) {
+	signFunc, err := provider.GetSignFunc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := provider.OsClient.GetBucketVersioningWithResponse(ctx, provider.SpaceID, bucketName, signFunc)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.ParseObjectStorageError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	if res.XML200 == nil {
+		return &objectstorage.VersioningConfiguration{}, nil
+	}
+
+	return res.XML200, nil
+}
+
+func UpdateBucketVersioning(ctx context.Context, provider *client.NumSpotSDK, bucketName string, status objectstorage.BucketVersioningStatus) (*objectstorage.VersioningConfiguration, error) {
+	signFunc, err := provider.GetSignFunc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	body, contentMD5, err := marshalBucketConfiguration(objectstorage.VersioningConfiguration{Status: &status})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := provider.OsClient.PutBucketVersioningWithBodyWithResponse(ctx, provider.SpaceID, bucketName, xmlContentType, body, contentMD5, signFunc)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.ParseObjectStorageError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	return ReadBucketVersioning(ctx, provider, bucketName)
+}
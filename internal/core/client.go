@@ -0,0 +1,72 @@
+package core
+
+import (
+	"context"
+
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/sdk/api"
+)
+
+// The functions below bind a method of the API client to the client returned by provider.GetClient on
+// each call, so that the functions retried by the utils helpers always use a valid token, even when it
+// is refreshed while waiting for a resource.
+
+func withClient[R any](
+	provider *client.NumSpotSDK,
+	fun func(*api.ClientWithResponses, context.Context, api.SpaceId, ...api.RequestEditorFn) (R, error),
+) func(context.Context, api.SpaceId, ...api.RequestEditorFn) (R, error) {
+	return func(ctx context.Context, spaceID api.SpaceId, reqEditors ...api.RequestEditorFn) (R, error) {
+		numspotClient, err := provider.GetClient(ctx)
+		if err != nil {
+			var res R
+			return res, err
+		}
+
+		return fun(numspotClient, ctx, spaceID, reqEditors...)
+	}
+}
+
+func withClientBody[R, BodyType any](
+	provider *client.NumSpotSDK,
+	fun func(*api.ClientWithResponses, context.Context, api.SpaceId, BodyType, ...api.RequestEditorFn) (R, error),
+) func(context.Context, api.SpaceId, BodyType, ...api.RequestEditorFn) (R, error) {
+	return func(ctx context.Context, spaceID api.SpaceId, body BodyType, reqEditors ...api.RequestEditorFn) (R, error) {
+		numspotClient, err := provider.GetClient(ctx)
+		if err != nil {
+			var res R
+			return res, err
+		}
+
+		return fun(numspotClient, ctx, spaceID, body, reqEditors...)
+	}
+}
+
+func withClientID[R, ID any](
+	provider *client.NumSpotSDK,
+	fun func(*api.ClientWithResponses, context.Context, api.SpaceId, ID, ...api.RequestEditorFn) (R, error),
+) func(context.Context, api.SpaceId, ID, ...api.RequestEditorFn) (R, error) {
+	return func(ctx context.Context, spaceID api.SpaceId, id ID, reqEditors ...api.RequestEditorFn) (R, error) {
+		numspotClient, err := provider.GetClient(ctx)
+		if err != nil {
+			var res R
+			return res, err
+		}
+
+		return fun(numspotClient, ctx, spaceID, id, reqEditors...)
+	}
+}
+
+func withClientIDBody[R, BodyType any](
+	provider *client.NumSpotSDK,
+	fun func(*api.ClientWithResponses, context.Context, api.SpaceId, string, BodyType, ...api.RequestEditorFn) (R, error),
+) func(context.Context, api.SpaceId, string, BodyType, ...api.RequestEditorFn) (R, error) {
+	return func(ctx context.Context, spaceID api.SpaceId, id string, body BodyType, reqEditors ...api.RequestEditorFn) (R, error) {
+		numspotClient, err := provider.GetClient(ctx)
+		if err != nil {
+			var res R
+			return res, err
+		}
+
+		return fun(numspotClient, ctx, spaceID, id, body, reqEditors...)
+	}
+}
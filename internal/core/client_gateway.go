@@ -17,13 +17,8 @@ var (
 func CreateClientGateway(ctx context.Context, provider *client.NumSpotSDK, numSpotClientGatewayCreate api.CreateClientGatewayJSONRequestBody) (numSpotClientGateway *api.ClientGateway, err error) {
 	spaceID := provider.SpaceID
 
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	var retryCreate *api.CreateClientGatewayResponse
-	if retryCreate, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, numSpotClientGatewayCreate, numspotClient.CreateClientGatewayWithResponse); err != nil {
+	if retryCreate, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, numSpotClientGatewayCreate, withClientBody(provider, (*api.ClientWithResponses).CreateClientGatewayWithResponse)); err != nil {
 		return nil, err
 	}
 
@@ -33,11 +28,7 @@ func CreateClientGateway(ctx context.Context, provider *client.NumSpotSDK, numSp
 }
 
 func DeleteClientGateway(ctx context.Context, provider *client.NumSpotSDK, clientGatewayID api.ResourceIdentifier) error {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return err
-	}
-	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, clientGatewayID, numspotClient.DeleteClientGatewayWithResponse)
+	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, clientGatewayID, withClientID(provider, (*api.ClientWithResponses).DeleteClientGatewayWithResponse))
 }
 
 func ReadClientGateway(ctx context.Context, provider *client.NumSpotSDK, clientGatewayID api.ResourceIdentifier) (*api.ClientGateway, error) {
@@ -50,7 +41,7 @@ func ReadClientGateway(ctx context.Context, provider *client.NumSpotSDK, clientG
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(numSpotClientGateway.Body, numSpotClientGateway.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotClientGateway.Body, numSpotClientGateway.StatusCode(), numSpotClientGateway.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -67,7 +58,7 @@ func ReadClientGateways(ctx context.Context, provider *client.NumSpotSDK) ([]api
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(numSpotClientGateways.Body, numSpotClientGateways.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotClientGateways.Body, numSpotClientGateways.StatusCode(), numSpotClientGateways.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -75,12 +66,7 @@ func ReadClientGateways(ctx context.Context, provider *client.NumSpotSDK) ([]api
 }
 
 func RetryReadClientGateway(ctx context.Context, provider *client.NumSpotSDK, op string, clientGatewayID api.ResourceIdentifier) (*api.ClientGateway, error) {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	read, err := utils.RetryReadUntilStateValid(ctx, clientGatewayID, provider.SpaceID, clientGatewayPendingStates, clientGatewayTargetStates, numspotClient.ReadClientGatewayWithResponse)
+	read, err := utils.RetryReadUntilStateValid(ctx, clientGatewayID, provider.SpaceID, clientGatewayPendingStates, clientGatewayTargetStates, withClientID(provider, (*api.ClientWithResponses).ReadClientGatewayWithResponse))
 	if err != nil {
 		return nil, err
 	}
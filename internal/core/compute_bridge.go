@@ -19,7 +19,7 @@ func CreateComputeBridge(ctx context.Context, provider *client.NumSpotSDK, creat
 		return nil, err
 	}
 
-	err = utils.ParseHTTPError(res.Body, res.StatusCode())
+	err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +39,7 @@ func DeleteComputeBridge(ctx context.Context, provider *client.NumSpotSDK, compu
 	if err != nil {
 		return err
 	}
-	err = utils.ParseHTTPError(res.Body, res.StatusCode())
+	err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
 	if err != nil {
 		return err
 	}
@@ -58,7 +58,7 @@ func ReadComputeBridges(ctx context.Context, provider *client.NumSpotSDK) (*api.
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(read.Body, read.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(read.Body, read.StatusCode(), read.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -76,7 +76,7 @@ func ReadComputeBridge(ctx context.Context, provider *client.NumSpotSDK, compute
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(read.Body, read.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(read.Body, read.StatusCode(), read.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
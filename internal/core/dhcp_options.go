@@ -12,12 +12,7 @@ func CreateDHCPOptions(ctx context.Context, provider *client.NumSpotSDK, numSpot
 	spaceID := provider.SpaceID
 
 	var retryCreate *api.CreateDhcpOptionsResponse
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	if retryCreate, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, numSpotDHCPOptionsCreate, numspotClient.CreateDhcpOptionsWithResponse); err != nil {
+	if retryCreate, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, numSpotDHCPOptionsCreate, withClientBody(provider, (*api.ClientWithResponses).CreateDhcpOptionsWithResponse)); err != nil {
 		return nil, err
 	}
 
@@ -42,12 +37,8 @@ func UpdateDHCPOptionsTags(ctx context.Context, provider *client.NumSpotSDK, dhc
 func DeleteDHCPOptions(ctx context.Context, provider *client.NumSpotSDK, dhcpOptionsID string) error {
 	spaceID := provider.SpaceID
 
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return err
-	}
 	if err := utils.RetryDeleteUntilResourceAvailable(ctx, spaceID, dhcpOptionsID,
-		numspotClient.DeleteDhcpOptionsWithResponse); err != nil {
+		withClientID(provider, (*api.ClientWithResponses).DeleteDhcpOptionsWithResponse)); err != nil {
 		return err
 	}
 
@@ -64,7 +55,7 @@ func ReadDHCPOptions(ctx context.Context, provider *client.NumSpotSDK, dhcpOptio
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(read.Body, read.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(read.Body, read.StatusCode(), read.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -81,7 +72,7 @@ func ReadDHCPOption(ctx context.Context, provider *client.NumSpotSDK, dhcpOption
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(read.Body, read.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(read.Body, read.StatusCode(), read.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,99 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	directLinkPendingStates = []string{requested, pending}
+	directLinkTargetStates  = []string{available}
+)
+
+func CreateDirectLink(ctx context.Context, provider *client.NumSpotSDK, numSpotDirectLinkCreate api.CreateDirectLinkJSONRequestBody) (*api.DirectLink, error) {
+	retryCreate, err := utils.RetryCreateUntilResourceAvailableWithBody(ctx, provider.SpaceID, numSpotDirectLinkCreate, withClientBody(provider, (*api.ClientWithResponses).CreateDirectLinkWithResponse))
+	if err != nil {
+		return nil, err
+	}
+
+	return RetryReadDirectLink(ctx, provider, createOp, retryCreate.JSON201.Id)
+}
+
+func DeleteDirectLink(ctx context.Context, provider *client.NumSpotSDK, directLinkID api.ResourceIdentifier) error {
+	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, directLinkID, withClientID(provider, (*api.ClientWithResponses).DeleteDirectLinkWithResponse))
+}
+
+func ReadDirectLink(ctx context.Context, provider *client.NumSpotSDK, directLinkID api.ResourceIdentifier) (*api.DirectLink, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := numspotClient.ReadDirectLinkWithResponse(ctx, provider.SpaceID, directLinkID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	return res.JSON200, nil
+}
+
+func ReadDirectLinks(ctx context.Context, provider *client.NumSpotSDK) ([]api.DirectLink, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := numspotClient.ListDirectLinksWithResponse(ctx, provider.SpaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	if res.JSON200.Items == nil {
+		return nil, fmt.Errorf("HTTP call failed : expected a list of direct links but got nil")
+	}
+
+	return res.JSON200.Items, nil
+}
+
+func RetryReadDirectLink(ctx context.Context, provider *client.NumSpotSDK, op string, directLinkID api.ResourceIdentifier) (*api.DirectLink, error) {
+	read, err := utils.RetryReadUntilStateValid(ctx, directLinkID, provider.SpaceID, directLinkPendingStates, directLinkTargetStates, withClientID(provider, (*api.ClientWithResponses).ReadDirectLinkWithResponse))
+	if err != nil {
+		return nil, err
+	}
+
+	numSpotDirectLink, assert := read.(*api.DirectLink)
+	if !assert {
+		return nil, fmt.Errorf("invalid direct link assertion %s: %s", directLinkID, op)
+	}
+	return numSpotDirectLink, nil
+}
+
+func ReadLocations(ctx context.Context, provider *client.NumSpotSDK) ([]api.Location, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := numspotClient.ListLocationsWithResponse(ctx, provider.SpaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	return res.JSON200.Items, nil
+}
@@ -1,61 +1,81 @@
 package core
 
-//
-//import (
-//	"context"
-//	"terraform-provider-numspot/internal/client"
-//	"terraform-provider-numspot/internal/sdk/api"
-//	"terraform-provider-numspot/internal/utils"
-//)
-//
-//var (
-//	directLinkInterfaceStates       = []string{creating, pending}
-//	directLinkInterfaceTargetStates = []string{available}
-//)
-//
-//func CreateDirectLinkInterface(ctx context.Context, provider *client.NumSpotSDK, createRequest api.CreateDirectLinkInterfaceRequest) (*api.DirectLinkInterface, error) {
-//	spaceID := provider.SpaceID
-//
-//	numspotClient, err := provider.GetClient(ctx)
-//	if err != nil {
-//		return nil, err
-//	}
-//
-//	var retryCreateResponse *api.CreateDirectLinkInterfaceResponse
-//	if retryCreateResponse, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, createRequest, numspotClient.CreateDirectLinkInterfaceWithResponse); err != nil {
-//		return nil, err
-//	}
-//
-//	return retryCreateResponse.JSON201, nil
-//}
-//
-//func DeleteDirectLinkInterface(ctx context.Context, provider *client.NumSpotSDK) (*api.DirectLinkInterface, error) {
-//	spaceID := provider.SpaceID
-//
-//	numspotClient, err := provider.GetClient(ctx)
-//	if err != nil {
-//		return nil, err
-//	}
-//
-//}
-//
-//func RetryReadDirectLinkInterface(ctx context.Context, provider *client.NumSpotSDK, directLinkInterfaceID string) {
-//	numspotClient, err := provider.GetClient(ctx)
-//	if err != nil {
-//		return nil, err
-//	}
-//
-//	read, err := utils.RetryReadUntilStateValid(ctx, directLinkInterfaceID, provider.SpaceID, imagePendingStates, imageTargetStates, numspotClient.ReadImagesByIdWithResponse)
-//	if err != nil {
-//		return nil, err
-//	}
-//
-//}
-//
-//func ReadDirectLinkInterfaces() {
-//
-//}
-//
-//func ReadDirectLinkInterface() {
-//
-//}
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	directLinkInterfacePendingStates = []string{creating, pending}
+	directLinkInterfaceTargetStates  = []string{available}
+)
+
+func CreateDirectLinkInterface(ctx context.Context, provider *client.NumSpotSDK, numSpotDirectLinkInterfaceCreate api.CreateDirectLinkInterfaceJSONRequestBody) (*api.DirectLinkInterface, error) {
+	retryCreate, err := utils.RetryCreateUntilResourceAvailableWithBody(ctx, provider.SpaceID, numSpotDirectLinkInterfaceCreate, withClientBody(provider, (*api.ClientWithResponses).CreateDirectLinkInterfaceWithResponse))
+	if err != nil {
+		return nil, err
+	}
+
+	return RetryReadDirectLinkInterface(ctx, provider, createOp, retryCreate.JSON201.Id)
+}
+
+func DeleteDirectLinkInterface(ctx context.Context, provider *client.NumSpotSDK, directLinkInterfaceID api.ResourceIdentifier) error {
+	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, directLinkInterfaceID, withClientID(provider, (*api.ClientWithResponses).DeleteDirectLinkInterfaceWithResponse))
+}
+
+func ReadDirectLinkInterface(ctx context.Context, provider *client.NumSpotSDK, directLinkInterfaceID api.ResourceIdentifier) (*api.DirectLinkInterface, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := numspotClient.ReadDirectLinkInterfaceWithResponse(ctx, provider.SpaceID, directLinkInterfaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	return res.JSON200, nil
+}
+
+func ReadDirectLinkInterfaces(ctx context.Context, provider *client.NumSpotSDK) ([]api.DirectLinkInterface, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := numspotClient.ListDirectLinkInterfacesWithResponse(ctx, provider.SpaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	if res.JSON200.Items == nil {
+		return nil, fmt.Errorf("HTTP call failed : expected a list of direct link interfaces but got nil")
+	}
+
+	return res.JSON200.Items, nil
+}
+
+func RetryReadDirectLinkInterface(ctx context.Context, provider *client.NumSpotSDK, op string, directLinkInterfaceID api.ResourceIdentifier) (*api.DirectLinkInterface, error) {
+	read, err := utils.RetryReadUntilStateValid(ctx, directLinkInterfaceID, provider.SpaceID, directLinkInterfacePendingStates, directLinkInterfaceTargetStates, withClientID(provider, (*api.ClientWithResponses).ReadDirectLinkInterfaceWithResponse))
+	if err != nil {
+		return nil, err
+	}
+
+	numSpotDirectLinkInterface, assert := read.(*api.DirectLinkInterface)
+	if !assert {
+		return nil, fmt.Errorf("invalid direct link interface assertion %s: %s", directLinkInterfaceID, op)
+	}
+	return numSpotDirectLinkInterface, nil
+}
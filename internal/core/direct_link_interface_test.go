@@ -0,0 +1,102 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-numspot/internal/client/clienttest"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
+)
+
+// directLinkInterfaceStub keeps direct link interfaces in memory, an interface becomes available after being read once
+// in the pending state
+type directLinkInterfaceStub struct {
+	mu         sync.Mutex
+	interfaces map[string]*api.DirectLinkInterface
+}
+
+func (s *directLinkInterfaceStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Paths are /connectivity/spaces/{spaceId}/directLinkInterfaces[/{id}]
+	pathParts := strings.Split(strings.TrimPrefix(r.URL.Path, "/connectivity/spaces/"), "/")
+	switch {
+	case len(pathParts) == 2 && r.Method == http.MethodGet:
+		items := make([]api.DirectLinkInterface, 0, len(s.interfaces))
+		for _, directLinkInterface := range s.interfaces {
+			items = append(items, *directLinkInterface)
+		}
+		clienttest.WriteJSON(w, http.StatusOK, api.DirectLinkInterfaces{Items: items})
+	case len(pathParts) == 2 && r.Method == http.MethodPost:
+		var body api.CreateDirectLinkInterface
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		directLinkInterface := &api.DirectLinkInterface{
+			BgpAsn:                  body.BgpAsn,
+			ClientPrivateIp:         "172.16.0.2/30",
+			DirectLinkId:            body.DirectLinkId,
+			DirectLinkInterfaceName: body.Name,
+			Id:                      uuid.New(),
+			InterfaceType:           "private",
+			Location:                "PAR1",
+			Mtu:                     1500,
+			NumspotPrivateIp:        "172.16.0.1/30",
+			State:                   pending,
+			VirtualGatewayId:        body.VirtualGatewayId,
+			Vlan:                    body.Vlan,
+		}
+		s.interfaces[directLinkInterface.Id.String()] = directLinkInterface
+		clienttest.WriteJSON(w, http.StatusCreated, directLinkInterface)
+	case len(pathParts) == 3 && s.interfaces[pathParts[2]] == nil:
+		clienttest.WriteJSON(w, http.StatusNotFound, map[string]string{"title": "Not Found"})
+	case len(pathParts) == 3 && r.Method == http.MethodGet:
+		directLinkInterface := *s.interfaces[pathParts[2]]
+		s.interfaces[pathParts[2]].State = available
+		clienttest.WriteJSON(w, http.StatusOK, directLinkInterface)
+	case len(pathParts) == 3 && r.Method == http.MethodDelete:
+		delete(s.interfaces, pathParts[2])
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "unexpected request", http.StatusBadRequest)
+	}
+}
+
+func TestDirectLinkInterface(t *testing.T) {
+	t.Setenv("RETRY_BACKOFF", "1ms")
+	ctx := context.Background()
+	provider := clienttest.NewStubSDK(t, &directLinkInterfaceStub{interfaces: map[string]*api.DirectLinkInterface{}})
+
+	created, err := CreateDirectLinkInterface(ctx, provider, api.CreateDirectLinkInterfaceJSONRequestBody{
+		BgpAsn:           65000,
+		DirectLinkId:     "dxcon-12345678",
+		Name:             "interface",
+		VirtualGatewayId: "vgw-12345678",
+		Vlan:             42,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, available, created.State)
+	assert.Equal(t, "interface", created.DirectLinkInterfaceName)
+	assert.Equal(t, 42, created.Vlan)
+
+	directLinkInterfaces, err := ReadDirectLinkInterfaces(ctx, provider)
+	require.NoError(t, err)
+	require.Len(t, directLinkInterfaces, 1)
+	assert.Equal(t, created.Id, directLinkInterfaces[0].Id)
+
+	require.NoError(t, DeleteDirectLinkInterface(ctx, provider, created.Id))
+
+	_, err = ReadDirectLinkInterface(ctx, provider, created.Id)
+	require.Error(t, err)
+	assert.True(t, utils.IsNotFound(err))
+}
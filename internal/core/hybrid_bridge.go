@@ -20,7 +20,7 @@ func CreateHybridBridge(ctx context.Context, provider *client.NumSpotSDK, create
 	if err != nil {
 		return nil, err
 	}
-	err = utils.ParseHTTPError(res.Body, res.StatusCode())
+	err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +55,7 @@ func ReadHybridBridges(ctx context.Context, provider *client.NumSpotSDK) (*api.H
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(read.Body, read.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(read.Body, read.StatusCode(), read.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -73,7 +73,7 @@ func ReadHybridBridge(ctx context.Context, provider *client.NumSpotSDK, HybridBr
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(read.Body, read.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(read.Body, read.StatusCode(), read.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
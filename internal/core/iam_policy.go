@@ -0,0 +1,114 @@
+package core
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
+)
+
+// ReadIAMPolicy returns the roles and permissions granted to the subject in the organisation when organisationID is set,
+// in the space of the provider otherwise
+func ReadIAMPolicy(ctx context.Context, provider *client.NumSpotSDK, organisationID *api.OrganisationId, subjectType api.SubjectType, subjectID api.SubjectId) (*api.IAMPolicy, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if organisationID != nil {
+		res, err := numspotClient.GetIAMPolicyOrganisationWithResponse(ctx, *organisationID, subjectType, subjectID)
+		if err != nil {
+			return nil, err
+		}
+		if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+			return nil, err
+		}
+		return res.JSON200, nil
+	}
+
+	res, err := numspotClient.GetIAMPolicySpaceWithResponse(ctx, provider.SpaceID, subjectType, subjectID)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+// UpdateIAMPolicy grants the roles and permissions of add to the subject and revokes the ones of remove, in the same scope as ReadIAMPolicy
+func UpdateIAMPolicy(ctx context.Context, provider *client.NumSpotSDK, organisationID *api.OrganisationId, subjectType api.SubjectType, subjectID api.SubjectId, add, remove api.IAMPolicy) error {
+	if isEmptyIAMPolicy(add) && isEmptyIAMPolicy(remove) {
+		return nil
+	}
+
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	body := api.SetIAMPolicySpaceJSONRequestBody{}
+	if !isEmptyIAMPolicy(add) {
+		body.Add = &add
+	}
+	if !isEmptyIAMPolicy(remove) {
+		body.Delete = &remove
+	}
+
+	if organisationID != nil {
+		res, err := numspotClient.SetIAMPolicyOrganisationWithResponse(ctx, *organisationID, subjectType, subjectID, api.SetIAMPolicyOrganisationJSONRequestBody(body))
+		if err != nil {
+			return err
+		}
+		return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+	}
+
+	res, err := numspotClient.SetIAMPolicySpaceWithResponse(ctx, provider.SpaceID, subjectType, subjectID, body)
+	if err != nil {
+		return err
+	}
+	return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+}
+
+// IAMPolicyChanges returns the roles and permissions to add to the current policy and to remove from it to get the desired policy
+func IAMPolicyChanges(current, desired api.IAMPolicy) (add, remove api.IAMPolicy) {
+	add = api.IAMPolicy{
+		Permissions: missingIDs(desired.Permissions, current.Permissions),
+		Roles:       missingIDs(desired.Roles, current.Roles),
+	}
+	remove = api.IAMPolicy{
+		Permissions: missingIDs(current.Permissions, desired.Permissions),
+		Roles:       missingIDs(current.Roles, desired.Roles),
+	}
+	return add, remove
+}
+
+// IAMPolicyIntersection returns the roles and permissions present in both policies
+func IAMPolicyIntersection(a, b api.IAMPolicy) api.IAMPolicy {
+	return api.IAMPolicy{
+		Permissions: missingIDs(a.Permissions, missingIDs(a.Permissions, b.Permissions)),
+		Roles:       missingIDs(a.Roles, missingIDs(a.Roles, b.Roles)),
+	}
+}
+
+// missingIDs returns the IDs of ids not in from, nil when there are none
+func missingIDs(ids, from *[]uuid.UUID) *[]uuid.UUID {
+	var missing []uuid.UUID
+	for _, id := range utils.GetPtrValue(ids) {
+		if from == nil || !slices.Contains(*from, id) {
+			missing = append(missing, id)
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+	return &missing
+}
+
+func isEmptyIAMPolicy(policy api.IAMPolicy) bool {
+	return len(utils.GetPtrValue(policy.Permissions)) == 0 && len(utils.GetPtrValue(policy.Roles)) == 0
+}
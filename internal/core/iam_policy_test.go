@@ -0,0 +1,34 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"terraform-provider-numspot/internal/sdk/api"
+)
+
+func TestIAMPolicyChanges(t *testing.T) {
+	kept, granted, revoked, role := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	current := api.IAMPolicy{Permissions: &[]uuid.UUID{kept, revoked}}
+	desired := api.IAMPolicy{Permissions: &[]uuid.UUID{kept, granted}, Roles: &[]uuid.UUID{role}}
+
+	add, remove := IAMPolicyChanges(current, desired)
+	assert.Equal(t, api.IAMPolicy{Permissions: &[]uuid.UUID{granted}, Roles: &[]uuid.UUID{role}}, add)
+	assert.Equal(t, api.IAMPolicy{Permissions: &[]uuid.UUID{revoked}}, remove)
+
+	add, remove = IAMPolicyChanges(desired, desired)
+	assert.True(t, isEmptyIAMPolicy(add))
+	assert.True(t, isEmptyIAMPolicy(remove))
+}
+
+func TestIAMPolicyIntersection(t *testing.T) {
+	shared, bound, granted, role := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	a := api.IAMPolicy{Permissions: &[]uuid.UUID{shared, bound}, Roles: &[]uuid.UUID{role}}
+	b := api.IAMPolicy{Permissions: &[]uuid.UUID{granted, shared}}
+
+	assert.Equal(t, api.IAMPolicy{Permissions: &[]uuid.UUID{shared}}, IAMPolicyIntersection(a, b))
+	assert.True(t, isEmptyIAMPolicy(IAMPolicyIntersection(api.IAMPolicy{}, b)))
+}
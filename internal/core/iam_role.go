@@ -0,0 +1,209 @@
+package core
+
+import (
+	"context"
+
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
+)
+
+// CreateRole creates a custom role in the organisation when organisationID is set, in the space of the provider otherwise.
+// The other role and permission functions use organisationID the same way
+func CreateRole(ctx context.Context, provider *client.NumSpotSDK, organisationID *api.OrganisationId, numSpotRoleCreate api.Role) (*api.RegisteredRole, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if organisationID != nil {
+		res, err := numspotClient.CreateRoleOrganisationWithResponse(ctx, *organisationID, numSpotRoleCreate)
+		if err != nil {
+			return nil, err
+		}
+		if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+			return nil, err
+		}
+		return res.JSON200, nil
+	}
+
+	res, err := numspotClient.CreateRoleSpaceWithResponse(ctx, provider.SpaceID, numSpotRoleCreate)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func ReadRole(ctx context.Context, provider *client.NumSpotSDK, organisationID *api.OrganisationId, roleID api.RoleUuid) (*api.RegisteredRole, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if organisationID != nil {
+		res, err := numspotClient.GetRoleOrganisationWithResponse(ctx, *organisationID, roleID)
+		if err != nil {
+			return nil, err
+		}
+		if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+			return nil, err
+		}
+		return res.JSON200, nil
+	}
+
+	res, err := numspotClient.GetRoleSpaceWithResponse(ctx, provider.SpaceID, roleID)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func DeleteRole(ctx context.Context, provider *client.NumSpotSDK, organisationID *api.OrganisationId, roleID api.RoleUuid) error {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	if organisationID != nil {
+		res, err := numspotClient.DeleteRoleOrganisationWithResponse(ctx, *organisationID, roleID)
+		if err != nil {
+			return err
+		}
+		return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+	}
+
+	res, err := numspotClient.DeleteRoleSpaceWithResponse(ctx, provider.SpaceID, roleID)
+	if err != nil {
+		return err
+	}
+	return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+}
+
+// ReadRoles returns the built-in and custom roles, named name when it is set, following the pages of the listing
+func ReadRoles(ctx context.Context, provider *client.NumSpotSDK, organisationID *api.OrganisationId, name *api.RoleName) ([]api.RegisteredRole, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		roles []api.RegisteredRole
+		page  *api.ListRolesPage
+	)
+	for {
+		var list *api.RolesPaginatedList
+		if organisationID != nil {
+			res, err := numspotClient.ListRolesOrganisationWithResponse(ctx, *organisationID, &api.ListRolesOrganisationParams{Name: name, Page: page})
+			if err != nil {
+				return nil, err
+			}
+			if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+				return nil, err
+			}
+			list = res.JSON200
+		} else {
+			res, err := numspotClient.ListRolesSpaceWithResponse(ctx, provider.SpaceID, &api.ListRolesSpaceParams{Name: name, Page: page})
+			if err != nil {
+				return nil, err
+			}
+			if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+				return nil, err
+			}
+			list = res.JSON200
+		}
+
+		roles = append(roles, list.Items...)
+
+		if utils.GetPtrValue(list.NextPageToken) == "" {
+			return roles, nil
+		}
+		page = &api.ListRolesPage{NextToken: list.NextPageToken}
+	}
+}
+
+// ReadPermissions returns the permissions matching the filters of params, following the pages of the listing
+func ReadPermissions(ctx context.Context, provider *client.NumSpotSDK, organisationID *api.OrganisationId, params api.ListPermissionsSpaceParams) ([]api.RegisteredPermission, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var permissions []api.RegisteredPermission
+	for {
+		var list *api.PermissionsPaginatedList
+		if organisationID != nil {
+			res, err := numspotClient.ListPermissionsOrganisationWithResponse(ctx, *organisationID, (*api.ListPermissionsOrganisationParams)(&params))
+			if err != nil {
+				return nil, err
+			}
+			if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+				return nil, err
+			}
+			list = res.JSON200
+		} else {
+			res, err := numspotClient.ListPermissionsSpaceWithResponse(ctx, provider.SpaceID, &params)
+			if err != nil {
+				return nil, err
+			}
+			if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+				return nil, err
+			}
+			list = res.JSON200
+		}
+
+		permissions = append(permissions, list.Items...)
+
+		if utils.GetPtrValue(list.NextPageToken) == "" {
+			return permissions, nil
+		}
+		params.Page = &api.ListPermissionsPage{NextToken: list.NextPageToken}
+	}
+}
+
+// ReadRolePermissions returns the permissions granted by the role, following the pages of the listing
+func ReadRolePermissions(ctx context.Context, provider *client.NumSpotSDK, organisationID *api.OrganisationId, roleID api.RoleUuid) ([]api.RegisteredPermission, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		permissions []api.RegisteredPermission
+		page        *api.ListRolePermissionsPage
+	)
+	for {
+		var list *api.PermissionsPaginatedList
+		if organisationID != nil {
+			res, err := numspotClient.GetRolePermissionsOrganisationWithResponse(ctx, *organisationID, roleID, &api.GetRolePermissionsOrganisationParams{Page: page})
+			if err != nil {
+				return nil, err
+			}
+			if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+				return nil, err
+			}
+			list = res.JSON200
+		} else {
+			res, err := numspotClient.GetRolePermissionsSpaceWithResponse(ctx, provider.SpaceID, roleID, &api.GetRolePermissionsSpaceParams{Page: page})
+			if err != nil {
+				return nil, err
+			}
+			if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+				return nil, err
+			}
+			list = res.JSON200
+		}
+
+		permissions = append(permissions, list.Items...)
+
+		if utils.GetPtrValue(list.NextPageToken) == "" {
+			return permissions, nil
+		}
+		page = &api.ListRolePermissionsPage{NextToken: list.NextPageToken}
+	}
+}
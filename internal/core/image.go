@@ -17,26 +17,21 @@ var (
 func CreateImage(ctx context.Context, provider *client.NumSpotSDK, body api.CreateImage, tags []api.ResourceTag, access *api.Access) (*api.Image, error) {
 	spaceID := provider.SpaceID
 
-	numspotClient, err := provider.GetClient(ctx)
+	retryCreateResponse, err := utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, body, withClientBody(provider, (*api.ClientWithResponses).CreateImageWithResponse))
 	if err != nil {
 		return nil, err
 	}
 
-	var retryCreateResponse *api.CreateImageResponse
-	if retryCreateResponse, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, body, numspotClient.CreateImageWithResponse); err != nil {
-		return nil, err
-	}
-
 	imageID := *retryCreateResponse.JSON201.Id
 
 	if len(tags) > 0 {
-		if err = createTags(ctx, provider, imageID, tags); err != nil {
+		if err := createTags(ctx, provider, imageID, tags); err != nil {
 			return nil, err
 		}
 	}
 
 	if access != nil {
-		if _, err = UpdateImageAccess(ctx, provider, imageID, *access); err != nil {
+		if _, err := UpdateImageAccess(ctx, provider, imageID, *access); err != nil {
 			return nil, err
 		}
 	}
@@ -96,12 +91,7 @@ func UpdateImageTags(ctx context.Context, provider *client.NumSpotSDK, imageID s
 }
 
 func DeleteImage(ctx context.Context, provider *client.NumSpotSDK, imageID string) (err error) {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return err
-	}
-
-	err = utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, imageID, numspotClient.DeleteImageWithResponse)
+	err = utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, imageID, withClientID(provider, (*api.ClientWithResponses).DeleteImageWithResponse))
 	if err != nil {
 		return err
 	}
@@ -118,7 +108,7 @@ func ReadImageWithID(ctx context.Context, provider *client.NumSpotSDK, imageID s
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadImage.Body, numSpotReadImage.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadImage.Body, numSpotReadImage.StatusCode(), numSpotReadImage.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -126,11 +116,7 @@ func ReadImageWithID(ctx context.Context, provider *client.NumSpotSDK, imageID s
 }
 
 func RetryReadImage(ctx context.Context, provider *client.NumSpotSDK, imageID string) (*api.Image, error) {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-	read, err := utils.RetryReadUntilStateValid(ctx, imageID, provider.SpaceID, imagePendingStates, imageTargetStates, numspotClient.ReadImagesByIdWithResponse)
+	read, err := utils.RetryReadUntilStateValid(ctx, imageID, provider.SpaceID, imagePendingStates, imageTargetStates, withClientID(provider, (*api.ClientWithResponses).ReadImagesByIdWithResponse))
 	if err != nil {
 		return nil, err
 	}
@@ -11,16 +11,11 @@ import (
 func CreateInternetGateway(ctx context.Context, provider *client.NumSpotSDK, tags []api.ResourceTag, vpcID string) (*api.InternetGateway, error) {
 	spaceID := provider.SpaceID
 
-	numspotClient, err := provider.GetClient(ctx)
+	retryCreateResponse, err := utils.RetryCreateUntilResourceAvailable(ctx, spaceID, withClient(provider, (*api.ClientWithResponses).CreateInternetGatewayWithResponse))
 	if err != nil {
 		return nil, err
 	}
 
-	var retryCreateResponse *api.CreateInternetGatewayResponse
-	if retryCreateResponse, err = utils.RetryCreateUntilResourceAvailable(ctx, spaceID, numspotClient.CreateInternetGatewayWithResponse); err != nil {
-		return nil, err
-	}
-
 	internetGatewayID := *retryCreateResponse.JSON201.Id
 
 	if len(tags) > 0 {
@@ -30,6 +25,11 @@ func CreateInternetGateway(ctx context.Context, provider *client.NumSpotSDK, tag
 	}
 
 	if vpcID != "" {
+		numspotClient, err := provider.GetClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
 		var linkVPCResponse *api.LinkInternetGatewayResponse
 		if linkVPCResponse, err = numspotClient.LinkInternetGatewayWithResponse(ctx, provider.SpaceID, internetGatewayID,
 			api.LinkInternetGatewayJSONRequestBody{
@@ -38,7 +38,7 @@ func CreateInternetGateway(ctx context.Context, provider *client.NumSpotSDK, tag
 		); err != nil {
 			return nil, err
 		}
-		if err = utils.ParseHTTPError(linkVPCResponse.Body, linkVPCResponse.StatusCode()); err != nil {
+		if err = utils.ParseHTTPError(linkVPCResponse.Body, linkVPCResponse.StatusCode(), linkVPCResponse.HTTPResponse.Header); err != nil {
 			return nil, err
 		}
 	}
@@ -61,21 +61,16 @@ func UpdateInternetGatewayTags(ctx context.Context, provider *client.NumSpotSDK,
 func DeleteInternetGateway(ctx context.Context, provider *client.NumSpotSDK, internetGatewayID string, vpcID string) (err error) {
 	spaceID := provider.SpaceID
 
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return err
-	}
-
 	if vpcID != "" {
 		if _, err = utils.RetryUntilResourceAvailableWithBody(ctx, spaceID, internetGatewayID,
 			api.UnlinkInternetGatewayJSONRequestBody{
 				VpcId: vpcID,
-			}, numspotClient.UnlinkInternetGatewayWithResponse); err != nil {
+			}, withClientIDBody(provider, (*api.ClientWithResponses).UnlinkInternetGatewayWithResponse)); err != nil {
 			return err
 		}
 	}
 
-	err = utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, internetGatewayID, numspotClient.DeleteInternetGatewayWithResponse)
+	err = utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, internetGatewayID, withClientID(provider, (*api.ClientWithResponses).DeleteInternetGatewayWithResponse))
 	if err != nil {
 		return err
 	}
@@ -92,7 +87,7 @@ func ReadInternetGatewaysWithID(ctx context.Context, provider *client.NumSpotSDK
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadInternetGateway.Body, numSpotReadInternetGateway.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadInternetGateway.Body, numSpotReadInternetGateway.StatusCode(), numSpotReadInternetGateway.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -108,7 +103,7 @@ func ReadInternetGatewaysWithParams(ctx context.Context, provider *client.NumSpo
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadInternetGateway.Body, numSpotReadInternetGateway.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadInternetGateway.Body, numSpotReadInternetGateway.StatusCode(), numSpotReadInternetGateway.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -11,28 +11,17 @@ import (
 func CreateKeypair(ctx context.Context, provider *client.NumSpotSDK, numSpotKeypairCreate api.CreateKeypairJSONRequestBody) (*api.CreateKeypair, error) {
 	spaceID := provider.SpaceID
 
-	numspotClient, err := provider.GetClient(ctx)
+	retryCreateResponse, err := utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, numSpotKeypairCreate,
+		withClientBody(provider, (*api.ClientWithResponses).CreateKeypairWithResponse))
 	if err != nil {
 		return nil, err
 	}
 
-	var retryCreateResponse *api.CreateKeypairResponse
-
-	if retryCreateResponse, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, numSpotKeypairCreate,
-		numspotClient.CreateKeypairWithResponse); err != nil {
-		return nil, err
-	}
-
 	return retryCreateResponse.JSON201, nil
 }
 
 func DeleteKeypair(ctx context.Context, provider *client.NumSpotSDK, keypairID string) (err error) {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return err
-	}
-
-	err = utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, keypairID, numspotClient.DeleteKeypairWithResponse)
+	err = utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, keypairID, withClientID(provider, (*api.ClientWithResponses).DeleteKeypairWithResponse))
 	if err != nil {
 		return err
 	}
@@ -49,7 +38,7 @@ func ReadKeypair(ctx context.Context, provider *client.NumSpotSDK, keypairID str
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadKeypair.Body, numSpotReadKeypair.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadKeypair.Body, numSpotReadKeypair.StatusCode(), numSpotReadKeypair.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -65,7 +54,7 @@ func ReadKeypairs(ctx context.Context, provider *client.NumSpotSDK, params api.R
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadKeypair.Body, numSpotReadKeypair.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadKeypair.Body, numSpotReadKeypair.StatusCode(), numSpotReadKeypair.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
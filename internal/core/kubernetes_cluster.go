@@ -3,19 +3,27 @@ package core
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/hashicorp/go-version"
 	"terraform-provider-numspot/internal/client"
 	"terraform-provider-numspot/internal/sdk/api"
 	"terraform-provider-numspot/internal/utils"
 )
 
 func ReadKubernetesClusters(ctx context.Context, provider *client.NumSpotSDK) ([]api.KubernetesCluster, error) {
-	res, err := provider.Client.ListKubernetesClustersWithResponse(ctx, provider.SpaceID, nil)
+	numspotClient, err := provider.GetClient(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
+	res, err := numspotClient.ListKubernetesClustersWithResponse(ctx, provider.SpaceID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -23,18 +31,23 @@ func ReadKubernetesClusters(ctx context.Context, provider *client.NumSpotSDK) ([
 }
 
 func CreateKubernetesCluster(ctx context.Context, provider *client.NumSpotSDK, numSpotClusterCreate api.CreateKubernetesClusterJSONRequestBody) (*api.KubernetesCluster, error) {
-	res, err := provider.Client.CreateKubernetesClusterWithResponse(ctx, provider.SpaceID, numSpotClusterCreate)
+	numspotClient, err := provider.GetClient(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
+	res, err := numspotClient.CreateKubernetesClusterWithResponse(ctx, provider.SpaceID, numSpotClusterCreate)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
 	createdID := res.JSON201.Id
 
-	read, err := utils.RetryReadUntilStatusStateValid(ctx, createdID, provider.SpaceID, utils.StateRetryOnCreate, utils.StateStopRetryOnCreate, provider.Client.GetKubernetesClusterWithResponse)
+	read, err := utils.RetryReadUntilStatusStateValid(ctx, createdID, provider.SpaceID, utils.StateRetryOnCreate, utils.StateStopRetryOnCreate, withClientID(provider, (*api.ClientWithResponses).GetKubernetesClusterWithResponse))
 	if err != nil {
 		return nil, err
 	}
@@ -48,12 +61,17 @@ func CreateKubernetesCluster(ctx context.Context, provider *client.NumSpotSDK, n
 }
 
 func ReadKubernetesCluster(ctx context.Context, provider *client.NumSpotSDK, clusterId api.ClusterId) (*api.GetKubernetesCluster200Response, error) {
-	res, err := provider.Client.GetKubernetesClusterWithResponse(ctx, provider.SpaceID, clusterId)
+	numspotClient, err := provider.GetClient(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
+	res, err := numspotClient.GetKubernetesClusterWithResponse(ctx, provider.SpaceID, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -61,5 +79,61 @@ func ReadKubernetesCluster(ctx context.Context, provider *client.NumSpotSDK, clu
 }
 
 func DeleteKubernetesCluster(ctx context.Context, provider *client.NumSpotSDK, clusterId api.ClusterId) (err error) {
-	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, clusterId, provider.Client.DeleteKubernetesClusterWithResponse)
+	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, clusterId, withClientID(provider, (*api.ClientWithResponses).DeleteKubernetesClusterWithResponse))
+}
+
+// ReadKubernetesVersions returns the Kubernetes versions supported for new clusters
+func ReadKubernetesVersions(ctx context.Context, provider *client.NumSpotSDK) ([]string, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := numspotClient.ListKubernetesVersionsWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	return res.JSON200.Versions, nil
+}
+
+// FilterKubernetesVersions returns the versions starting with prefix and matching the constraint (for example
+// ">= 1.32, < 1.34"), sorted from the oldest to the latest. An empty prefix or constraint matches all the versions.
+func FilterKubernetesVersions(versions []string, prefix, constraint string) ([]string, error) {
+	var constraints version.Constraints
+	if constraint != "" {
+		var err error
+		constraints, err = version.NewConstraint(constraint)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	filtered := make([]*version.Version, 0, len(versions))
+	for _, kubernetesVersion := range versions {
+		if !strings.HasPrefix(kubernetesVersion, prefix) {
+			continue
+		}
+
+		parsedVersion, err := version.NewVersion(kubernetesVersion)
+		if err != nil {
+			return nil, fmt.Errorf("invalid kubernetes version %q: %w", kubernetesVersion, err)
+		}
+		if constraints != nil && !constraints.Check(parsedVersion) {
+			continue
+		}
+		filtered = append(filtered, parsedVersion)
+	}
+	sort.Sort(version.Collection(filtered))
+
+	result := make([]string, 0, len(filtered))
+	for _, parsedVersion := range filtered {
+		result = append(result, parsedVersion.Original())
+	}
+
+	return result, nil
 }
@@ -0,0 +1,44 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterKubernetesVersions(t *testing.T) {
+	t.Parallel()
+	versions := []string{"1.33", "1.31", "1.34", "1.32", "1.32.4"}
+
+	tests := []struct {
+		name       string
+		prefix     string
+		constraint string
+		expected   []string
+	}{
+		{name: "all", expected: []string{"1.31", "1.32", "1.32.4", "1.33", "1.34"}},
+		{name: "prefix", prefix: "1.32", expected: []string{"1.32", "1.32.4"}},
+		{name: "constraint", constraint: ">= 1.32, < 1.34", expected: []string{"1.32", "1.32.4", "1.33"}},
+		{name: "pessimistic constraint", constraint: "~> 1.32.0", expected: []string{"1.32", "1.32.4"}},
+		{name: "no match", prefix: "2.", expected: []string{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			filtered, err := FilterKubernetesVersions(versions, test.prefix, test.constraint)
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, filtered)
+		})
+	}
+}
+
+func TestFilterKubernetesVersions_InvalidConstraint(t *testing.T) {
+	t.Parallel()
+
+	_, err := FilterKubernetesVersions([]string{"1.32"}, "", "newer than 1.31")
+
+	require.Error(t, err)
+}
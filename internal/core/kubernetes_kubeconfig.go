@@ -0,0 +1,127 @@
+package core
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
+)
+
+// Kubeconfig holds the connection settings of the current context of a kubeconfig file, with the certificates and
+// key decoded to PEM
+type Kubeconfig struct {
+	Host                 string
+	ClusterCACertificate string
+	ClientCertificate    string
+	ClientKey            string
+	Token                string
+}
+
+// kubeconfigFile is the subset of the kubeconfig file format needed to connect to a cluster
+type kubeconfigFile struct {
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKeyData         string `yaml:"client-key-data"`
+			Token                 string `yaml:"token"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
+func ReadKubernetesKubeconfig(ctx context.Context, provider *client.NumSpotSDK, clusterID api.ClusterId) (string, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := numspotClient.GetKubeConfigWithResponse(ctx, provider.SpaceID, clusterID)
+	if err != nil {
+		return "", err
+	}
+
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return "", err
+	}
+
+	return res.JSON200.KubeConfig, nil
+}
+
+// ParseKubeconfig returns the connection settings of the current context of a kubeconfig, or of its first context
+// when no current context is set
+func ParseKubeconfig(rawKubeconfig string) (*Kubeconfig, error) {
+	var file kubeconfigFile
+	if err := yaml.Unmarshal([]byte(rawKubeconfig), &file); err != nil {
+		return nil, fmt.Errorf("invalid kubeconfig: %w", err)
+	}
+	if len(file.Contexts) == 0 {
+		return nil, errors.New("invalid kubeconfig: no context found")
+	}
+
+	kubeContext := file.Contexts[0].Context
+	for _, namedContext := range file.Contexts {
+		if namedContext.Name == file.CurrentContext {
+			kubeContext = namedContext.Context
+			break
+		}
+	}
+
+	kubeconfig := &Kubeconfig{}
+	clusterFound := false
+	for _, namedCluster := range file.Clusters {
+		if namedCluster.Name != kubeContext.Cluster {
+			continue
+		}
+
+		clusterFound = true
+		kubeconfig.Host = namedCluster.Cluster.Server
+		caCertificate, err := base64.StdEncoding.DecodeString(namedCluster.Cluster.CertificateAuthorityData)
+		if err != nil {
+			return nil, fmt.Errorf("invalid kubeconfig certificate-authority-data: %w", err)
+		}
+		kubeconfig.ClusterCACertificate = string(caCertificate)
+	}
+	if !clusterFound {
+		return nil, fmt.Errorf("invalid kubeconfig: cluster %q not found", kubeContext.Cluster)
+	}
+
+	for _, namedUser := range file.Users {
+		if namedUser.Name != kubeContext.User {
+			continue
+		}
+
+		clientCertificate, err := base64.StdEncoding.DecodeString(namedUser.User.ClientCertificateData)
+		if err != nil {
+			return nil, fmt.Errorf("invalid kubeconfig client-certificate-data: %w", err)
+		}
+		clientKey, err := base64.StdEncoding.DecodeString(namedUser.User.ClientKeyData)
+		if err != nil {
+			return nil, fmt.Errorf("invalid kubeconfig client-key-data: %w", err)
+		}
+		kubeconfig.ClientCertificate = string(clientCertificate)
+		kubeconfig.ClientKey = string(clientKey)
+		kubeconfig.Token = namedUser.User.Token
+	}
+
+	return kubeconfig, nil
+}
@@ -0,0 +1,70 @@
+package core
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKubeconfig(t *testing.T) {
+	t.Parallel()
+
+	encode := func(value string) string {
+		return base64.StdEncoding.EncodeToString([]byte(value))
+	}
+	rawKubeconfig := `apiVersion: v1
+kind: Config
+current-context: admin@cluster
+clusters:
+  - name: other
+    cluster:
+      server: https://other.example.com:6443
+      certificate-authority-data: ` + encode("other CA") + `
+  - name: cluster
+    cluster:
+      server: https://cluster.example.com:6443
+      certificate-authority-data: ` + encode("cluster CA") + `
+contexts:
+  - name: other@other
+    context:
+      cluster: other
+      user: other
+  - name: admin@cluster
+    context:
+      cluster: cluster
+      user: admin
+users:
+  - name: other
+    user:
+      token: other-token
+  - name: admin
+    user:
+      client-certificate-data: ` + encode("client certificate") + `
+      client-key-data: ` + encode("client key") + `
+      token: admin-token
+`
+
+	kubeconfig, err := ParseKubeconfig(rawKubeconfig)
+
+	require.NoError(t, err)
+	assert.Equal(t, Kubeconfig{
+		Host:                 "https://cluster.example.com:6443",
+		ClusterCACertificate: "cluster CA",
+		ClientCertificate:    "client certificate",
+		ClientKey:            "client key",
+		Token:                "admin-token",
+	}, *kubeconfig)
+}
+
+func TestParseKubeconfig_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseKubeconfig("clusters: [")
+	require.Error(t, err)
+
+	_, err = ParseKubeconfig("contexts: [{name: admin, context: {cluster: unknown, user: admin}}]")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `cluster "unknown" not found`)
+}
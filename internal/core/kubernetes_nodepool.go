@@ -10,12 +10,17 @@ import (
 )
 
 func ReadKubernetesNodePools(ctx context.Context, provider *client.NumSpotSDK, clusterId api.ClusterId) (*api.ListKubernetesNodePools200Response, error) {
-	res, err := provider.Client.ListKubernetesNodePoolsWithResponse(ctx, provider.SpaceID, clusterId, nil)
+	numspotClient, err := provider.GetClient(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
+	res, err := numspotClient.ListKubernetesNodePoolsWithResponse(ctx, provider.SpaceID, clusterId, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -23,12 +28,17 @@ func ReadKubernetesNodePools(ctx context.Context, provider *client.NumSpotSDK, c
 }
 
 func CreateKubernetesNodePool(ctx context.Context, provider *client.NumSpotSDK, numSpotNodePoolCreate api.CreateKubernetesNodePoolJSONRequestBody, clusterId api.ClusterId) (*api.CreateKubernetesNodePool201Response, error) {
-	res, err := provider.Client.CreateKubernetesNodePoolWithResponse(ctx, provider.SpaceID, clusterId, numSpotNodePoolCreate)
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := numspotClient.CreateKubernetesNodePoolWithResponse(ctx, provider.SpaceID, clusterId, numSpotNodePoolCreate)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -36,12 +46,17 @@ func CreateKubernetesNodePool(ctx context.Context, provider *client.NumSpotSDK,
 }
 
 func ReadKubernetesNodePool(ctx context.Context, provider *client.NumSpotSDK, clusterId api.ClusterId, nodePoolId string) (*api.GetKubernetesNodePool200Response, error) {
-	res, err := provider.Client.GetKubernetesNodePoolWithResponse(ctx, provider.SpaceID, clusterId, uuid.MustParse(nodePoolId))
+	numspotClient, err := provider.GetClient(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
+	res, err := numspotClient.GetKubernetesNodePoolWithResponse(ctx, provider.SpaceID, clusterId, uuid.MustParse(nodePoolId))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -49,12 +64,17 @@ func ReadKubernetesNodePool(ctx context.Context, provider *client.NumSpotSDK, cl
 }
 
 func DeleteKubernetesNodePool(ctx context.Context, provider *client.NumSpotSDK, clusterId api.ClusterId, nodePoolId string) (err error) {
-	res, err := provider.Client.DeleteKubernetesNodePoolWithResponse(ctx, provider.SpaceID, clusterId, uuid.MustParse(nodePoolId))
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := numspotClient.DeleteKubernetesNodePoolWithResponse(ctx, provider.SpaceID, clusterId, uuid.MustParse(nodePoolId))
 	if err != nil {
 		return err
 	}
 
-	if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
 		return err
 	}
 
@@ -11,14 +11,9 @@ import (
 func CreateLoadBalancer(ctx context.Context, provider *client.NumSpotSDK, numSpotLoadBalancerCreate api.CreateLoadBalancerJSONRequestBody, numSpotLoadBalancerUpdate api.UpdateLoadBalancerJSONRequestBody, tags []api.ResourceTag, backendVM, backendIP []string) (numSpotVolume *api.LoadBalancer, err error) {
 	spaceID := provider.SpaceID
 
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	var retryCreate *api.CreateLoadBalancerResponse
 	if retryCreate, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, numSpotLoadBalancerCreate,
-		numspotClient.CreateLoadBalancerWithResponse); err != nil {
+		withClientBody(provider, (*api.ClientWithResponses).CreateLoadBalancerWithResponse)); err != nil {
 		return nil, err
 	}
 
@@ -58,12 +53,7 @@ func CreateLoadBalancer(ctx context.Context, provider *client.NumSpotSDK, numSpo
 }
 
 func DeleteLoadBalancer(ctx context.Context, provider *client.NumSpotSDK, loadBalancerID string) (err error) {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return err
-	}
-
-	err = utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, loadBalancerID, numspotClient.DeleteLoadBalancerWithResponse)
+	err = utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, loadBalancerID, withClientID(provider, (*api.ClientWithResponses).DeleteLoadBalancerWithResponse))
 	if err != nil {
 		return err
 	}
@@ -81,7 +71,7 @@ func ReadLoadBalancer(ctx context.Context, provider *client.NumSpotSDK, loadBala
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadLoadBalancer.Body, numSpotReadLoadBalancer.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadLoadBalancer.Body, numSpotReadLoadBalancer.StatusCode(), numSpotReadLoadBalancer.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -98,7 +88,7 @@ func ReadLoadBalancers(ctx context.Context, provider *client.NumSpotSDK, loadBal
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadLoadBalancer.Body, numSpotReadLoadBalancer.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadLoadBalancer.Body, numSpotReadLoadBalancer.StatusCode(), numSpotReadLoadBalancer.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -121,7 +111,7 @@ func UpdateLoadBalancerAttributes(ctx context.Context, provider *client.NumSpotS
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(loadBalancerUpdateResponse.Body, loadBalancerUpdateResponse.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(loadBalancerUpdateResponse.Body, loadBalancerUpdateResponse.StatusCode(), loadBalancerUpdateResponse.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -148,7 +138,7 @@ func UpdateLoadBalancerSecurityGroup(ctx context.Context, provider *client.NumSp
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(loadBalancerUpdateResponse.Body, loadBalancerUpdateResponse.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(loadBalancerUpdateResponse.Body, loadBalancerUpdateResponse.StatusCode(), loadBalancerUpdateResponse.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -171,7 +161,7 @@ func UpdateLoadBalancerTags(ctx context.Context, provider *client.NumSpotSDK, lo
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(loadBalancerDeleteTagsResponse.Body, loadBalancerDeleteTagsResponse.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(loadBalancerDeleteTagsResponse.Body, loadBalancerDeleteTagsResponse.StatusCode(), loadBalancerDeleteTagsResponse.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -183,7 +173,7 @@ func UpdateLoadBalancerTags(ctx context.Context, provider *client.NumSpotSDK, lo
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(loadBalancerCreateTagsResponse.Body, loadBalancerCreateTagsResponse.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(loadBalancerCreateTagsResponse.Body, loadBalancerCreateTagsResponse.StatusCode(), loadBalancerCreateTagsResponse.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -231,7 +221,7 @@ func linkBackend(ctx context.Context, provider *client.NumSpotSDK, loadBalancerN
 	if err != nil {
 		return err
 	}
-	if err = utils.ParseHTTPError(linkLoadBalancerBackendResponse.Body, linkLoadBalancerBackendResponse.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(linkLoadBalancerBackendResponse.Body, linkLoadBalancerBackendResponse.StatusCode(), linkLoadBalancerBackendResponse.HTTPResponse.Header); err != nil {
 		return err
 	}
 
@@ -264,7 +254,7 @@ func unlinkBackend(ctx context.Context, provider *client.NumSpotSDK, loadBalance
 	if err != nil {
 		return err
 	}
-	if err = utils.ParseHTTPError(unlinkLoadBalancerBackendResponse.Body, unlinkLoadBalancerBackendResponse.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(unlinkLoadBalancerBackendResponse.Body, unlinkLoadBalancerBackendResponse.StatusCode(), unlinkLoadBalancerBackendResponse.HTTPResponse.Header); err != nil {
 		return err
 	}
 
@@ -288,7 +278,7 @@ func createLoadBalancerTags(ctx context.Context, provider *client.NumSpotSDK, lo
 	if loadBalancerCreateTagsResponse, err = numspotClient.CreateLoadBalancerTagsWithResponse(ctx, spaceID, createTags); err != nil {
 		return err
 	}
-	if err = utils.ParseHTTPError(loadBalancerCreateTagsResponse.Body, loadBalancerCreateTagsResponse.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(loadBalancerCreateTagsResponse.Body, loadBalancerCreateTagsResponse.StatusCode(), loadBalancerCreateTagsResponse.HTTPResponse.Header); err != nil {
 		return err
 	}
 
@@ -308,7 +298,7 @@ func createListeners(ctx context.Context, provider *client.NumSpotSDK, loadBalan
 		return err
 	}
 
-	if err = utils.ParseHTTPError(numSpotLoadBalancerListenersResponse.Body, numSpotLoadBalancerListenersResponse.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotLoadBalancerListenersResponse.Body, numSpotLoadBalancerListenersResponse.StatusCode(), numSpotLoadBalancerListenersResponse.HTTPResponse.Header); err != nil {
 		return err
 	}
 	return err
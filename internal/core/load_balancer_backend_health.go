@@ -0,0 +1,70 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
+)
+
+// ReadLoadBalancerBackendHealth returns the health of the back-end VMs of the load balancer, every back-end VM when
+// backendVmIds is empty
+func ReadLoadBalancerBackendHealth(ctx context.Context, provider *client.NumSpotSDK, loadBalancerName string, backendVmIds []string) ([]api.BackendVmHealth, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	body := api.ReadVmsHealthJSONRequestBody{}
+	if len(backendVmIds) > 0 {
+		body.BackendVmIds = &backendVmIds
+	}
+
+	res, err := numspotClient.ReadVmsHealthWithResponse(ctx, provider.SpaceID, loadBalancerName, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	return utils.GetPtrValue(res.JSON200.BackendVmHealth), nil
+}
+
+// WaitForLoadBalancerBackendsHealthy polls the health of the back-end VMs of the load balancer until at least
+// minHealthyBackends of them are InService, or the deadline of ctx is reached
+func WaitForLoadBalancerBackendsHealthy(ctx context.Context, provider *client.NumSpotSDK, loadBalancerName string, minHealthyBackends int) error {
+	healthStateConf := &retry.StateChangeConf{
+		Pending: []string{outOfService},
+		Target:  []string{inService},
+		Timeout: utils.RetryTimeout(ctx, utils.TfRequestRetryTimeout),
+		Delay:   utils.ParseRetryBackoff(),
+		Refresh: func() (interface{}, string, error) {
+			backendsHealth, err := ReadLoadBalancerBackendHealth(ctx, provider, loadBalancerName, nil)
+			if err != nil {
+				return nil, "", err
+			}
+
+			healthyBackends := 0
+			for _, backendHealth := range backendsHealth {
+				if utils.GetPtrValue(backendHealth.State) == inService {
+					healthyBackends++
+				}
+			}
+
+			if healthyBackends < minHealthyBackends {
+				return backendsHealth, outOfService, nil
+			}
+			return backendsHealth, inService, nil
+		},
+	}
+
+	if _, err := healthStateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %d back-end VMs of load balancer %s to be %s: %w", minHealthyBackends, loadBalancerName, inService, err)
+	}
+	return nil
+}
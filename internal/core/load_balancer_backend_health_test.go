@@ -0,0 +1,76 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-numspot/internal/client/clienttest"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
+)
+
+// backendHealthStub reports the back-end VMs OutOfService until they have been read healthyAfter times
+type backendHealthStub struct {
+	mu           sync.Mutex
+	healthyAfter map[string]int
+}
+
+func (s *backendHealthStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var body api.ReadVmsHealthJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	backendsHealth := make([]api.BackendVmHealth, 0, len(s.healthyAfter))
+	for vmID, reads := range s.healthyAfter {
+		if body.BackendVmIds != nil && !slices.Contains(*body.BackendVmIds, vmID) {
+			continue
+		}
+
+		state := inService
+		if reads > 0 {
+			state = outOfService
+			s.healthyAfter[vmID]--
+		}
+		backendsHealth = append(backendsHealth, api.BackendVmHealth{State: utils.PointerOf(state), VmId: utils.PointerOf(vmID)})
+	}
+	clienttest.WriteJSON(w, http.StatusOK, api.ReadVmsHealth{BackendVmHealth: &backendsHealth})
+}
+
+func TestReadLoadBalancerBackendHealth(t *testing.T) {
+	ctx := context.Background()
+	provider := clienttest.NewStubSDK(t, &backendHealthStub{healthyAfter: map[string]int{"i-1": 0, "i-2": 0}})
+
+	backendsHealth, err := ReadLoadBalancerBackendHealth(ctx, provider, "load-balancer", []string{"i-2"})
+	require.NoError(t, err)
+	require.Len(t, backendsHealth, 1)
+	assert.Equal(t, "i-2", *backendsHealth[0].VmId)
+	assert.Equal(t, inService, *backendsHealth[0].State)
+}
+
+func TestWaitForLoadBalancerBackendsHealthy(t *testing.T) {
+	t.Setenv("RETRY_BACKOFF", "1ms")
+	provider := clienttest.NewStubSDK(t, &backendHealthStub{healthyAfter: map[string]int{"i-1": 1, "i-2": 100}})
+
+	t.Run("enough backends become healthy", func(t *testing.T) {
+		require.NoError(t, WaitForLoadBalancerBackendsHealthy(context.Background(), provider, "load-balancer", 1))
+	})
+
+	t.Run("not enough healthy backends before the deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		require.Error(t, WaitForLoadBalancerBackendsHealthy(ctx, provider, "load-balancer", 2))
+	})
+}
@@ -0,0 +1,42 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
+)
+
+func CreateLoadBalancerListener(ctx context.Context, provider *client.NumSpotSDK, loadBalancerName string, listener api.ListenerForCreation) (*api.Listener, error) {
+	if err := createListeners(ctx, provider, loadBalancerName, []api.ListenerForCreation{listener}); err != nil {
+		return nil, err
+	}
+
+	return ReadLoadBalancerListener(ctx, provider, loadBalancerName, listener.LoadBalancerPort)
+}
+
+func DeleteLoadBalancerListener(ctx context.Context, provider *client.NumSpotSDK, loadBalancerName string, loadBalancerPort int) error {
+	_, err := utils.RetryDeleteUntilWithBody(ctx, provider.SpaceID, loadBalancerName, api.DeleteLoadBalancerListenersJSONRequestBody{
+		LoadBalancerPorts: []int{loadBalancerPort},
+	}, withClientIDBody(provider, (*api.ClientWithResponses).DeleteLoadBalancerListenersWithResponse))
+
+	return err
+}
+
+// ReadLoadBalancerListener returns the listener of the load balancer on the given port, or a not found error if there is none
+func ReadLoadBalancerListener(ctx context.Context, provider *client.NumSpotSDK, loadBalancerName string, loadBalancerPort int) (*api.Listener, error) {
+	loadBalancer, err := ReadLoadBalancer(ctx, provider, loadBalancerName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, listener := range utils.GetPtrValue(loadBalancer.Listeners) {
+		if utils.GetPtrValue(listener.LoadBalancerPort) == loadBalancerPort {
+			return &listener, nil
+		}
+	}
+
+	return nil, &utils.NotFoundError{Err: fmt.Errorf("listener on port %d of load balancer %s not found", loadBalancerPort, loadBalancerName)}
+}
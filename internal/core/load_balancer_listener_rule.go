@@ -0,0 +1,76 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
+)
+
+func CreateLoadBalancerListenerRule(ctx context.Context, provider *client.NumSpotSDK, numSpotListenerRuleCreate api.CreateListenerRuleJSONRequestBody) (*api.ListenerRule, error) {
+	retryCreate, err := utils.RetryCreateUntilResourceAvailableWithBody(ctx, provider.SpaceID, numSpotListenerRuleCreate, withClientBody(provider, (*api.ClientWithResponses).CreateListenerRuleWithResponse))
+	if err != nil {
+		return nil, err
+	}
+
+	if retryCreate.JSON201.Id == nil {
+		return nil, fmt.Errorf("HTTP call failed : expected the ID of the created listener rule but got nil")
+	}
+
+	return ReadLoadBalancerListenerRule(ctx, provider, fmt.Sprint(*retryCreate.JSON201.Id))
+}
+
+// UpdateLoadBalancerListenerRule updates the host-name and path patterns of a rule, the only attributes the API can change
+func UpdateLoadBalancerListenerRule(ctx context.Context, provider *client.NumSpotSDK, listenerRuleID string, numSpotListenerRuleUpdate api.UpdateListenerRuleJSONRequestBody) (*api.ListenerRule, error) {
+	if _, err := utils.RetryUntilResourceAvailableWithBody(ctx, provider.SpaceID, listenerRuleID, numSpotListenerRuleUpdate, withClientIDBody(provider, (*api.ClientWithResponses).UpdateListenerRuleWithResponse)); err != nil {
+		return nil, err
+	}
+
+	return ReadLoadBalancerListenerRule(ctx, provider, listenerRuleID)
+}
+
+func DeleteLoadBalancerListenerRule(ctx context.Context, provider *client.NumSpotSDK, listenerRuleID string) error {
+	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, listenerRuleID, withClientID(provider, (*api.ClientWithResponses).DeleteListenerRuleWithResponse))
+}
+
+func ReadLoadBalancerListenerRule(ctx context.Context, provider *client.NumSpotSDK, listenerRuleID string) (*api.ListenerRule, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := numspotClient.ReadListenerRulesByIdWithResponse(ctx, provider.SpaceID, listenerRuleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	return res.JSON200, nil
+}
+
+func ReadLoadBalancerListenerRules(ctx context.Context, provider *client.NumSpotSDK, listenerRuleParams api.ReadListenerRulesParams) ([]api.ListenerRule, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := numspotClient.ReadListenerRulesWithResponse(ctx, provider.SpaceID, &listenerRuleParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	if res.JSON200.Items == nil {
+		return nil, fmt.Errorf("HTTP call failed : expected a list of listener rules but got nil")
+	}
+
+	return *res.JSON200.Items, nil
+}
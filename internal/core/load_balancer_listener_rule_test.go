@@ -0,0 +1,117 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-numspot/internal/client/clienttest"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
+)
+
+// listenerRuleStub keeps listener rules in memory, indexed by their ID
+type listenerRuleStub struct {
+	mu     sync.Mutex
+	nextID int
+	rules  map[string]*api.ListenerRule
+}
+
+func (s *listenerRuleStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Paths are /compute/spaces/{spaceId}/listenerRules[/{id}]
+	pathParts := strings.Split(strings.TrimPrefix(r.URL.Path, "/compute/spaces/"), "/")
+	switch {
+	case len(pathParts) == 2 && r.Method == http.MethodGet:
+		items := make([]api.ListenerRule, 0, len(s.rules))
+		for _, rule := range s.rules {
+			items = append(items, *rule)
+		}
+		clienttest.WriteJSON(w, http.StatusOK, api.ReadListenerRules{Items: &items})
+	case len(pathParts) == 2 && r.Method == http.MethodPost:
+		var body api.CreateListenerRule
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.nextID++
+		rule := &api.ListenerRule{
+			Action:          utils.PointerOf("forward"),
+			HostNamePattern: body.ListenerRule.HostNamePattern,
+			Id:              utils.PointerOf(s.nextID),
+			ListenerId:      utils.PointerOf(body.Listener.LoadBalancerPort),
+			Name:            utils.PointerOf(body.ListenerRule.ListenerRuleName),
+			PathPattern:     body.ListenerRule.PathPattern,
+			Priority:        utils.PointerOf(body.ListenerRule.Priority),
+			VmIds:           &body.VmIds,
+		}
+		s.rules[strconv.Itoa(s.nextID)] = rule
+		clienttest.WriteJSON(w, http.StatusCreated, rule)
+	case len(pathParts) == 3 && s.rules[pathParts[2]] == nil:
+		clienttest.WriteJSON(w, http.StatusNotFound, map[string]string{"title": "Not Found"})
+	case len(pathParts) == 3 && r.Method == http.MethodGet:
+		clienttest.WriteJSON(w, http.StatusOK, s.rules[pathParts[2]])
+	case len(pathParts) == 3 && r.Method == http.MethodPut:
+		var body api.UpdateListenerRule
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.rules[pathParts[2]].HostNamePattern = body.HostPattern
+		s.rules[pathParts[2]].PathPattern = body.PathPattern
+		clienttest.WriteJSON(w, http.StatusOK, s.rules[pathParts[2]])
+	case len(pathParts) == 3 && r.Method == http.MethodDelete:
+		delete(s.rules, pathParts[2])
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "unexpected request", http.StatusBadRequest)
+	}
+}
+
+func TestLoadBalancerListenerRule(t *testing.T) {
+	t.Setenv("RETRY_BACKOFF", "1ms")
+	ctx := context.Background()
+	provider := clienttest.NewStubSDK(t, &listenerRuleStub{rules: map[string]*api.ListenerRule{}})
+
+	created, err := CreateLoadBalancerListenerRule(ctx, provider, api.CreateListenerRuleJSONRequestBody{
+		Listener: api.LoadBalancerLight{LoadBalancerName: "load-balancer", LoadBalancerPort: 80},
+		ListenerRule: api.ListenerRuleForCreation{
+			ListenerRuleName: "api",
+			PathPattern:      utils.PointerOf("/api/*"),
+			Priority:         10,
+		},
+		VmIds: []string{"i-12345678"},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, created.Id)
+	assert.Equal(t, "/api/*", *created.PathPattern)
+	assert.Equal(t, 10, *created.Priority)
+
+	listenerRuleID := strconv.Itoa(*created.Id)
+	updated, err := UpdateLoadBalancerListenerRule(ctx, provider, listenerRuleID, api.UpdateListenerRuleJSONRequestBody{
+		HostPattern: utils.PointerOf("api.example.com"),
+		PathPattern: utils.PointerOf("/v2/*"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "api.example.com", *updated.HostNamePattern)
+	assert.Equal(t, "/v2/*", *updated.PathPattern)
+	assert.Equal(t, 10, *updated.Priority)
+
+	listenerRules, err := ReadLoadBalancerListenerRules(ctx, provider, api.ReadListenerRulesParams{})
+	require.NoError(t, err)
+	require.Len(t, listenerRules, 1)
+
+	require.NoError(t, DeleteLoadBalancerListenerRule(ctx, provider, listenerRuleID))
+
+	_, err = ReadLoadBalancerListenerRule(ctx, provider, listenerRuleID)
+	require.Error(t, err)
+	assert.True(t, utils.IsNotFound(err))
+}
@@ -0,0 +1,166 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-numspot/internal/client/clienttest"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
+)
+
+// loadBalancerStub serves a single load balancer, its listeners and its sticky cookie policies
+type loadBalancerStub struct {
+	mu           sync.Mutex
+	loadBalancer api.LoadBalancer
+}
+
+func newLoadBalancerStub(loadBalancerName string) *loadBalancerStub {
+	return &loadBalancerStub{loadBalancer: api.LoadBalancer{
+		ApplicationStickyCookiePolicies: &[]api.ApplicationStickyCookiePolicy{},
+		Listeners:                       &[]api.Listener{},
+		Name:                            utils.PointerOf(loadBalancerName),
+		StickyCookiePolicies:            &[]api.LoadBalancerStickyCookiePolicy{},
+	}}
+}
+
+func (s *loadBalancerStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Paths are /compute/spaces/{spaceId}/loadBalancers/{name}[/listeners|/policies]
+	pathParts := strings.Split(strings.TrimPrefix(r.URL.Path, "/compute/spaces/"), "/")
+	if len(pathParts) < 3 || pathParts[2] != *s.loadBalancer.Name {
+		clienttest.WriteJSON(w, http.StatusNotFound, map[string]string{"title": "Not Found"})
+		return
+	}
+
+	switch {
+	case len(pathParts) == 3 && r.Method == http.MethodGet:
+		clienttest.WriteJSON(w, http.StatusOK, s.loadBalancer)
+	case len(pathParts) == 4 && pathParts[3] == "listeners" && r.Method == http.MethodPost:
+		var body api.CreateLoadBalancerListeners
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for _, listener := range body.Listeners {
+			*s.loadBalancer.Listeners = append(*s.loadBalancer.Listeners, api.Listener{
+				BackendPort:          utils.PointerOf(listener.BackendPort),
+				BackendProtocol:      listener.BackendProtocol,
+				LoadBalancerPort:     utils.PointerOf(listener.LoadBalancerPort),
+				LoadBalancerProtocol: utils.PointerOf(listener.LoadBalancerProtocol),
+				PolicyNames:          &[]string{},
+			})
+		}
+		clienttest.WriteJSON(w, http.StatusCreated, s.loadBalancer)
+	case len(pathParts) == 4 && pathParts[3] == "listeners" && r.Method == http.MethodDelete:
+		var body api.DeleteLoadBalancerListeners
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		*s.loadBalancer.Listeners = slices.DeleteFunc(*s.loadBalancer.Listeners, func(listener api.Listener) bool {
+			return slices.Contains(body.LoadBalancerPorts, *listener.LoadBalancerPort)
+		})
+		w.WriteHeader(http.StatusNoContent)
+	case len(pathParts) == 4 && pathParts[3] == "policies" && r.Method == http.MethodPost:
+		var body api.CreateLoadBalancerPolicy
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if body.PolicyType == LoadBalancerPolicyTypeApp {
+			*s.loadBalancer.ApplicationStickyCookiePolicies = append(*s.loadBalancer.ApplicationStickyCookiePolicies, api.ApplicationStickyCookiePolicy{
+				CookieName: body.CookieName,
+				PolicyName: utils.PointerOf(body.PolicyName),
+			})
+		} else {
+			*s.loadBalancer.StickyCookiePolicies = append(*s.loadBalancer.StickyCookiePolicies, api.LoadBalancerStickyCookiePolicy{
+				CookieExpirationPeriod: body.CookieExpirationPeriod,
+				PolicyName:             utils.PointerOf(body.PolicyName),
+			})
+		}
+		clienttest.WriteJSON(w, http.StatusCreated, s.loadBalancer)
+	case len(pathParts) == 4 && pathParts[3] == "policies" && r.Method == http.MethodDelete:
+		var body api.DeleteLoadBalancerPolicy
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		*s.loadBalancer.ApplicationStickyCookiePolicies = slices.DeleteFunc(*s.loadBalancer.ApplicationStickyCookiePolicies, func(policy api.ApplicationStickyCookiePolicy) bool {
+			return *policy.PolicyName == body.PolicyName
+		})
+		*s.loadBalancer.StickyCookiePolicies = slices.DeleteFunc(*s.loadBalancer.StickyCookiePolicies, func(policy api.LoadBalancerStickyCookiePolicy) bool {
+			return *policy.PolicyName == body.PolicyName
+		})
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "unexpected request", http.StatusBadRequest)
+	}
+}
+
+func TestLoadBalancerListener(t *testing.T) {
+	t.Setenv("RETRY_BACKOFF", "1ms")
+	ctx := context.Background()
+	provider := clienttest.NewStubSDK(t, newLoadBalancerStub("load-balancer"))
+
+	created, err := CreateLoadBalancerListener(ctx, provider, "load-balancer", api.ListenerForCreation{
+		BackendPort:          8080,
+		BackendProtocol:      utils.PointerOf("HTTP"),
+		LoadBalancerPort:     80,
+		LoadBalancerProtocol: "HTTP",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 8080, *created.BackendPort)
+	assert.Equal(t, 80, *created.LoadBalancerPort)
+
+	require.NoError(t, DeleteLoadBalancerListener(ctx, provider, "load-balancer", 80))
+
+	_, err = ReadLoadBalancerListener(ctx, provider, "load-balancer", 80)
+	require.Error(t, err)
+	assert.True(t, utils.IsNotFound(err))
+
+	_, err = ReadLoadBalancerListener(ctx, provider, "unknown", 80)
+	assert.True(t, utils.IsNotFound(err))
+}
+
+func TestLoadBalancerPolicy(t *testing.T) {
+	t.Setenv("RETRY_BACKOFF", "1ms")
+	ctx := context.Background()
+	provider := clienttest.NewStubSDK(t, newLoadBalancerStub("load-balancer"))
+
+	appPolicy, err := CreateLoadBalancerPolicy(ctx, provider, "load-balancer", api.CreateLoadBalancerPolicyJSONRequestBody{
+		CookieName: utils.PointerOf("SESSIONID"),
+		PolicyName: "app-policy",
+		PolicyType: LoadBalancerPolicyTypeApp,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, LoadBalancerPolicyTypeApp, appPolicy.PolicyType)
+	assert.Equal(t, "SESSIONID", *appPolicy.CookieName)
+
+	loadBalancerPolicy, err := CreateLoadBalancerPolicy(ctx, provider, "load-balancer", api.CreateLoadBalancerPolicyJSONRequestBody{
+		CookieExpirationPeriod: utils.PointerOf(3600),
+		PolicyName:             "load-balancer-policy",
+		PolicyType:             LoadBalancerPolicyTypeLoadBalancer,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, LoadBalancerPolicyTypeLoadBalancer, loadBalancerPolicy.PolicyType)
+	assert.Equal(t, 3600, *loadBalancerPolicy.CookieExpirationPeriod)
+
+	require.NoError(t, DeleteLoadBalancerPolicy(ctx, provider, "load-balancer", "app-policy"))
+
+	_, err = ReadLoadBalancerPolicy(ctx, provider, "load-balancer", "app-policy")
+	require.Error(t, err)
+	assert.True(t, utils.IsNotFound(err))
+
+	_, err = ReadLoadBalancerPolicy(ctx, provider, "load-balancer", "load-balancer-policy")
+	require.NoError(t, err)
+}
@@ -0,0 +1,70 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
+)
+
+const (
+	LoadBalancerPolicyTypeApp          = "app"
+	LoadBalancerPolicyTypeLoadBalancer = "load_balancer"
+)
+
+// LoadBalancerPolicy is a sticky cookie policy of a load balancer, the API returns application and load balancer
+// policies in two distinct lists
+type LoadBalancerPolicy struct {
+	CookieExpirationPeriod *int
+	CookieName             *string
+	PolicyName             string
+	PolicyType             string
+}
+
+func CreateLoadBalancerPolicy(ctx context.Context, provider *client.NumSpotSDK, loadBalancerName string, numSpotLoadBalancerPolicyCreate api.CreateLoadBalancerPolicyJSONRequestBody) (*LoadBalancerPolicy, error) {
+	if _, err := utils.RetryUntilResourceAvailableWithBody(ctx, provider.SpaceID, loadBalancerName, numSpotLoadBalancerPolicyCreate, withClientIDBody(provider, (*api.ClientWithResponses).CreateLoadBalancerPolicyWithResponse)); err != nil {
+		return nil, err
+	}
+
+	return ReadLoadBalancerPolicy(ctx, provider, loadBalancerName, numSpotLoadBalancerPolicyCreate.PolicyName)
+}
+
+func DeleteLoadBalancerPolicy(ctx context.Context, provider *client.NumSpotSDK, loadBalancerName, policyName string) error {
+	_, err := utils.RetryDeleteUntilWithBody(ctx, provider.SpaceID, loadBalancerName, api.DeleteLoadBalancerPolicyJSONRequestBody{
+		PolicyName: policyName,
+	}, withClientIDBody(provider, (*api.ClientWithResponses).DeleteLoadBalancerPolicyWithResponse))
+
+	return err
+}
+
+// ReadLoadBalancerPolicy returns the sticky cookie policy of the load balancer with the given name, or a not found error if there is none
+func ReadLoadBalancerPolicy(ctx context.Context, provider *client.NumSpotSDK, loadBalancerName, policyName string) (*LoadBalancerPolicy, error) {
+	loadBalancer, err := ReadLoadBalancer(ctx, provider, loadBalancerName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, policy := range utils.GetPtrValue(loadBalancer.ApplicationStickyCookiePolicies) {
+		if utils.GetPtrValue(policy.PolicyName) == policyName {
+			return &LoadBalancerPolicy{
+				CookieName: policy.CookieName,
+				PolicyName: policyName,
+				PolicyType: LoadBalancerPolicyTypeApp,
+			}, nil
+		}
+	}
+
+	for _, policy := range utils.GetPtrValue(loadBalancer.StickyCookiePolicies) {
+		if utils.GetPtrValue(policy.PolicyName) == policyName {
+			return &LoadBalancerPolicy{
+				CookieExpirationPeriod: policy.CookieExpirationPeriod,
+				PolicyName:             policyName,
+				PolicyType:             LoadBalancerPolicyTypeLoadBalancer,
+			}, nil
+		}
+	}
+
+	return nil, &utils.NotFoundError{Err: fmt.Errorf("policy %s of load balancer %s not found", policyName, loadBalancerName)}
+}
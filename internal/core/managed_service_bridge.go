@@ -21,7 +21,7 @@ package core
 //	if err != nil {
 //		return nil, err
 //	}
-//	err = utils.ParseHTTPError(res.Body, res.StatusCode())
+//	err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
 //	if err != nil {
 //		return nil, err
 //	}
@@ -56,7 +56,7 @@ package core
 //		return nil, err
 //	}
 //
-//	if err = utils.ParseHTTPError(read.Body, read.StatusCode()); err != nil {
+//	if err = utils.ParseHTTPError(read.Body, read.StatusCode(), read.HTTPResponse.Header); err != nil {
 //		return nil, err
 //	}
 //
@@ -74,7 +74,7 @@ package core
 //		return nil, err
 //	}
 //
-//	if err = utils.ParseHTTPError(read.Body, read.StatusCode()); err != nil {
+//	if err = utils.ParseHTTPError(read.Body, read.StatusCode(), read.HTTPResponse.Header); err != nil {
 //		return nil, err
 //	}
 //
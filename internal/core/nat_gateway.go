@@ -17,13 +17,8 @@ var (
 func CreateNATGateway(ctx context.Context, provider *client.NumSpotSDK, tags []api.ResourceTag, body api.CreateNatGatewayJSONRequestBody) (numSpotNatGateway *api.NatGateway, err error) {
 	spaceID := provider.SpaceID
 
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	var retryCreate *api.CreateNatGatewayResponse
-	if retryCreate, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, body, numspotClient.CreateNatGatewayWithResponse); err != nil {
+	if retryCreate, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, body, withClientBody(provider, (*api.ClientWithResponses).CreateNatGatewayWithResponse)); err != nil {
 		return nil, err
 	}
 
@@ -49,12 +44,7 @@ func UpdateNATGatewayTags(ctx context.Context, provider *client.NumSpotSDK, stat
 func DeleteNATGateway(ctx context.Context, provider *client.NumSpotSDK, natGatewayID string) error {
 	spaceID := provider.SpaceID
 
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return err
-	}
-
-	return utils.RetryDeleteUntilResourceAvailable(ctx, spaceID, natGatewayID, numspotClient.DeleteNatGatewayWithResponse)
+	return utils.RetryDeleteUntilResourceAvailable(ctx, spaceID, natGatewayID, withClientID(provider, (*api.ClientWithResponses).DeleteNatGatewayWithResponse))
 }
 
 func ReadNATGateway(ctx context.Context, provider *client.NumSpotSDK, natGatewayID string) (*api.NatGateway, error) {
@@ -70,7 +60,7 @@ func ReadNATGateway(ctx context.Context, provider *client.NumSpotSDK, natGateway
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(numSpotNatGateway.Body, numSpotNatGateway.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotNatGateway.Body, numSpotNatGateway.StatusCode(), numSpotNatGateway.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -80,13 +70,8 @@ func ReadNATGateway(ctx context.Context, provider *client.NumSpotSDK, natGateway
 func RetryReadNATGateway(ctx context.Context, provider *client.NumSpotSDK, natGatewayID string) (*api.NatGateway, error) {
 	spaceID := provider.SpaceID
 
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	read, err := utils.RetryReadUntilStateValid(ctx, natGatewayID, spaceID, natGatewayPendingStates, natGatewayTargetStates,
-		numspotClient.ReadNatGatewayByIdWithResponse)
+		withClientID(provider, (*api.ClientWithResponses).ReadNatGatewayByIdWithResponse))
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +92,7 @@ func ReadNATGatewaysWithParams(ctx context.Context, provider *client.NumSpotSDK,
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadNatGateway.Body, numSpotReadNatGateway.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadNatGateway.Body, numSpotReadNatGateway.StatusCode(), numSpotReadNatGateway.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 	if numSpotReadNatGateway.JSON200.Items == nil {
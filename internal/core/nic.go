@@ -14,20 +14,15 @@ import (
 func CreateNic(ctx context.Context, provider *client.NumSpotSDK, numSpotNicCreate api.CreateNicJSONRequestBody, tags []api.ResourceTag, linkNicBody *api.LinkNicJSONRequestBody) (*api.Nic, error) {
 	spaceID := provider.SpaceID
 
-	numspotClient, err := provider.GetClient(ctx)
+	retryCreateResponse, err := utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, numSpotNicCreate, withClientBody(provider, (*api.ClientWithResponses).CreateNicWithResponse))
 	if err != nil {
 		return nil, err
 	}
 
-	var retryCreateResponse *api.CreateNicResponse
-	if retryCreateResponse, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, numSpotNicCreate, numspotClient.CreateNicWithResponse); err != nil {
-		return nil, err
-	}
-
 	nicID := *retryCreateResponse.JSON201.Id
 
 	if len(tags) > 0 {
-		if err = createTags(ctx, provider, nicID, tags); err != nil {
+		if err := createTags(ctx, provider, nicID, tags); err != nil {
 			return nil, err
 		}
 	}
@@ -65,7 +60,7 @@ func linkNic(ctx context.Context, provider *client.NumSpotSDK, nicID string, lin
 		return nil, err
 	}
 	if res.StatusCode() != http.StatusOK {
-		apiError := utils.HandleError(res.Body)
+		apiError := utils.NewAPIError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
 		return nil, apiError
 	}
 
@@ -89,7 +84,7 @@ func unlinkNic(ctx context.Context, provider *client.NumSpotSDK, nicID string, l
 	}
 
 	if res.StatusCode() != http.StatusNoContent {
-		apiError := utils.HandleError(res.Body)
+		apiError := utils.NewAPIError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
 		return nil, apiError
 	}
 
@@ -132,7 +127,7 @@ func UpdateNicAttributes(ctx context.Context, provider *client.NumSpotSDK, numSp
 		return nil, err
 	}
 	if res.StatusCode() != http.StatusOK {
-		apiError := utils.HandleError(res.Body)
+		apiError := utils.NewAPIError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
 		return nil, apiError
 	}
 
@@ -140,35 +135,31 @@ func UpdateNicAttributes(ctx context.Context, provider *client.NumSpotSDK, numSp
 }
 
 func DeleteNic(ctx context.Context, provider *client.NumSpotSDK, nicID string, unlinkNicBody *api.UnlinkNicJSONRequestBody) (err error) {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return err
-	}
-
 	if unlinkNicBody != nil {
 		_, _ = unlinkNic(ctx, provider, nicID, *unlinkNicBody)
 		// Error not handled, we try to delete internet gateway anyway
 	}
 
-	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, nicID, numspotClient.DeleteNicWithResponse)
+	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, nicID, withClientID(provider, (*api.ClientWithResponses).DeleteNicWithResponse))
 }
 
 func RetryReadLinkNic(ctx context.Context, provider *client.NumSpotSDK, nicID string, startState, targetState []string) (*api.Nic, error) {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
 	createStateConf := &retry.StateChangeConf{
 		Pending: startState,
 		Target:  targetState,
 		Refresh: func() (interface{}, string, error) {
+			numspotClient, err := provider.GetClient(ctx)
+			if err != nil {
+				return nil, "", err
+			}
+
 			resp, err := numspotClient.ReadNicsByIdWithResponse(ctx, provider.SpaceID, nicID)
 			if err != nil {
 				return nil, "", err
 			}
 
 			if resp.StatusCode() != http.StatusOK {
-				apiError := utils.HandleError(resp.Body)
+				apiError := utils.NewAPIError(resp.Body, resp.StatusCode(), resp.HTTPResponse.Header)
 				return nil, "", apiError
 			}
 
@@ -178,7 +169,7 @@ func RetryReadLinkNic(ctx context.Context, provider *client.NumSpotSDK, nicID st
 				return nil, "", fmt.Errorf("error while reading operation. No 'LinkNic.State' field found in response")
 			}
 		},
-		Timeout: utils.TfRequestRetryTimeout,
+		Timeout: utils.RetryTimeout(ctx, utils.TfRequestRetryTimeout),
 		Delay:   utils.ParseRetryBackoff(),
 	}
 
@@ -195,11 +186,7 @@ func RetryReadLinkNic(ctx context.Context, provider *client.NumSpotSDK, nicID st
 }
 
 func RetryReadNic(ctx context.Context, provider *client.NumSpotSDK, nicID string, startState, targetState []string) (*api.Nic, error) {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-	read, err := utils.RetryReadUntilStateValid(ctx, nicID, provider.SpaceID, startState, targetState, numspotClient.ReadNicsByIdWithResponse)
+	read, err := utils.RetryReadUntilStateValid(ctx, nicID, provider.SpaceID, startState, targetState, withClientID(provider, (*api.ClientWithResponses).ReadNicsByIdWithResponse))
 	if err != nil {
 		return nil, err
 	}
@@ -220,7 +207,7 @@ func ReadNicWithID(ctx context.Context, provider *client.NumSpotSDK, nicID strin
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadNic.Body, numSpotReadNic.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadNic.Body, numSpotReadNic.StatusCode(), numSpotReadNic.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -236,7 +223,7 @@ func ReadNicsWithParams(ctx context.Context, provider *client.NumSpotSDK, params
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadNic.Body, numSpotReadNic.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadNic.Body, numSpotReadNic.StatusCode(), numSpotReadNic.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 	if numSpotReadNic.JSON200.Items == nil {
@@ -4,18 +4,24 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"terraform-provider-numspot/internal/client"
 	"terraform-provider-numspot/internal/sdk/api"
 	"terraform-provider-numspot/internal/utils"
 )
 
 func ReadPostgresClusters(ctx context.Context, provider *client.NumSpotSDK) (*api.PostgresqlListClusters200Response, error) {
-	res, err := provider.Client.PostgresqlListClustersWithResponse(ctx, provider.SpaceID)
+	numspotClient, err := provider.GetClient(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
+	res, err := numspotClient.PostgresqlListClustersWithResponse(ctx, provider.SpaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -23,18 +29,23 @@ func ReadPostgresClusters(ctx context.Context, provider *client.NumSpotSDK) (*ap
 }
 
 func CreatePostgresCluster(ctx context.Context, provider *client.NumSpotSDK, body api.PostgresClusterCreationRequest) (*api.PostgresCluster, error) {
-	res, err := provider.Client.PostgresqlCreateClusterWithResponse(ctx, provider.SpaceID, body)
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := numspotClient.PostgresqlCreateClusterWithResponse(ctx, provider.SpaceID, body)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
 	createdID := res.JSON201.Id
 
-	read, err := utils.RetryReadUntilStatusStateValid(ctx, createdID, provider.SpaceID, utils.StateRetryOnCreate, utils.StateStopRetryOnCreate, provider.Client.PostgresqlGetClusterWithResponse)
+	read, err := utils.RetryReadUntilStatusStateValid(ctx, createdID, provider.SpaceID, utils.StateRetryOnCreate, utils.StateStopRetryOnCreate, withClientID(provider, (*api.ClientWithResponses).PostgresqlGetClusterWithResponse))
 	if err != nil {
 		return nil, err
 	}
@@ -47,17 +58,116 @@ func CreatePostgresCluster(ctx context.Context, provider *client.NumSpotSDK, bod
 	return numSpotCluster, nil
 }
 
+// postgresModificationPending is reported while the cluster is still RUNNING with the values it had before the modification
+const postgresModificationPending = "MODIFICATION_PENDING"
+
+// UpdatePostgresCluster modifies the replica count, node configuration or volume size of a cluster, and waits for the
+// cluster to be running with the requested values
+func UpdatePostgresCluster(ctx context.Context, provider *client.NumSpotSDK, clusterID api.PostgresClusterIdParameter, body api.PostgresClusterModificationRequest) (*api.PostgresCluster, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := numspotClient.PostgresqlModifyClusterWithResponse(ctx, provider.SpaceID, clusterID, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	// The cluster may not have left RUNNING yet, it is only considered updated once it reports the requested values
+	clusterStateConf := &retry.StateChangeConf{
+		Pending: append([]string{postgresModificationPending}, utils.StateRetryOnUpdate...),
+		Target:  utils.StateStopRetryOnUpdate,
+		Timeout: utils.RetryTimeout(ctx, utils.TfRequestStateRetryTimeout),
+		Delay:   utils.ParseRetryBackoff(),
+		Refresh: func() (interface{}, string, error) {
+			cluster, err := ReadPostgresCluster(ctx, provider, clusterID)
+			if err != nil {
+				return nil, "", err
+			}
+
+			state := string(cluster.Status.State)
+			if cluster.Status.State == api.PostgresStatusStateRUNNING && !isPostgresClusterModified(cluster, body) {
+				state = postgresModificationPending
+			}
+			return cluster, state, nil
+		},
+	}
+
+	read, err := clusterStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	numSpotCluster, assert := read.(*api.PostgresCluster)
+	if !assert {
+		return nil, fmt.Errorf("invalid cluster assertion %s", clusterID)
+	}
+
+	if numSpotCluster.Status.State == api.PostgresStatusStateFAILED {
+		return nil, fmt.Errorf("postgres cluster %s failed to update: %s", clusterID, numSpotCluster.Status.Message)
+	}
+
+	return numSpotCluster, nil
+}
+
+// isPostgresClusterModified reports whether the cluster has every value requested by the modification
+func isPostgresClusterModified(cluster *api.PostgresCluster, body api.PostgresClusterModificationRequest) bool {
+	if body.ReplicaCount != nil && cluster.ReplicaCount != *body.ReplicaCount {
+		return false
+	}
+
+	if body.NodeConfiguration != nil {
+		if body.NodeConfiguration.MemorySizeGiB != nil && cluster.NodeConfiguration.MemorySizeGiB != *body.NodeConfiguration.MemorySizeGiB {
+			return false
+		}
+		if body.NodeConfiguration.VcpuCount != nil && cluster.NodeConfiguration.VcpuCount != *body.NodeConfiguration.VcpuCount {
+			return false
+		}
+	}
+
+	return body.VolumeSizeGiB == nil || cluster.Volume.SizeGiB == *body.VolumeSizeGiB
+}
+
 func DeletePostgresCluster(ctx context.Context, provider *client.NumSpotSDK, clusterID api.PostgresClusterIdParameter) (err error) {
-	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, clusterID, provider.Client.PostgresqlDeleteClusterWithResponse)
+	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, clusterID, withClientID(provider, (*api.ClientWithResponses).PostgresqlDeleteClusterWithResponse))
 }
 
 func ReadPostgresCluster(ctx context.Context, provider *client.NumSpotSDK, paramID api.PostgresClusterIdParameter) (*api.PostgresCluster, error) {
-	res, err := provider.Client.PostgresqlGetClusterWithResponse(ctx, provider.SpaceID, paramID)
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := numspotClient.PostgresqlGetClusterWithResponse(ctx, provider.SpaceID, paramID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	return res.JSON200, nil
+}
+
+// ReadPostgresClusterCredentials returns the username and password of the user of a cluster
+func ReadPostgresClusterCredentials(ctx context.Context, provider *client.NumSpotSDK, clusterID api.PostgresClusterIdParameter) (*api.PostgresCredentials, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := numspotClient.PostgresqlGetClusterPasswordWithResponse(ctx, provider.SpaceID, clusterID)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-numspot/internal/client/clienttest"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
+)
+
+// postgresClusterStub serves a single RUNNING cluster, the replica count of a modification is only visible after
+// staleReads reads
+type postgresClusterStub struct {
+	mu         sync.Mutex
+	cluster    api.PostgresCluster
+	pending    *api.PostgresClusterModificationRequest
+	staleReads int
+}
+
+func (s *postgresClusterStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPatch:
+		var modification api.PostgresClusterModificationRequest
+		if err := json.NewDecoder(r.Body).Decode(&modification); err != nil || modification.ReplicaCount == nil {
+			http.Error(w, "unexpected modification", http.StatusBadRequest)
+			return
+		}
+		s.pending = &modification
+		clienttest.WriteJSON(w, http.StatusOK, s.cluster)
+	case http.MethodGet:
+		if s.pending != nil {
+			if s.staleReads == 0 {
+				s.cluster.ReplicaCount = *s.pending.ReplicaCount
+				s.pending = nil
+			}
+			s.staleReads--
+		}
+		clienttest.WriteJSON(w, http.StatusOK, s.cluster)
+	default:
+		http.Error(w, "unexpected request", http.StatusBadRequest)
+	}
+}
+
+func TestUpdatePostgresCluster(t *testing.T) {
+	t.Setenv("RETRY_BACKOFF", "1ms")
+	clusterID := uuid.New()
+	stub := &postgresClusterStub{
+		cluster: api.PostgresCluster{
+			Id:           clusterID,
+			ReplicaCount: 1,
+			Status:       api.PostgresStatus{State: api.PostgresStatusStateRUNNING},
+		},
+		staleReads: 2,
+	}
+	provider := clienttest.NewStubSDK(t, stub)
+
+	// The cluster is still RUNNING with one replica right after the modification request
+	cluster, err := UpdatePostgresCluster(context.Background(), provider, clusterID, api.PostgresClusterModificationRequest{
+		ReplicaCount: utils.PointerOf(api.PostgresReplicaCount(2)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, api.PostgresReplicaCount(2), cluster.ReplicaCount)
+}
+
+func TestIsPostgresClusterModified(t *testing.T) {
+	cluster := &api.PostgresCluster{
+		NodeConfiguration: api.PostgresNodeConfiguration{MemorySizeGiB: 4, VcpuCount: 2},
+		ReplicaCount:      1,
+		Volume:            api.PostgresVolume{SizeGiB: 10},
+	}
+
+	assert.True(t, isPostgresClusterModified(cluster, api.PostgresClusterModificationRequest{}))
+	assert.True(t, isPostgresClusterModified(cluster, api.PostgresClusterModificationRequest{
+		NodeConfiguration: &api.PostgresNodeConfigurationModification{VcpuCount: utils.PointerOf(api.PostgresVCPUCount(2))},
+		VolumeSizeGiB:     utils.PointerOf(int32(10)),
+	}))
+	assert.False(t, isPostgresClusterModified(cluster, api.PostgresClusterModificationRequest{
+		NodeConfiguration: &api.PostgresNodeConfigurationModification{MemorySizeGiB: utils.PointerOf(api.PostgresMemorySizeGiB(8))},
+	}))
+	assert.False(t, isPostgresClusterModified(cluster, api.PostgresClusterModificationRequest{VolumeSizeGiB: utils.PointerOf(int32(20))}))
+}
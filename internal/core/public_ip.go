@@ -12,13 +12,8 @@ import (
 func CreatePublicIp(ctx context.Context, provider *client.NumSpotSDK, tags []api.ResourceTag, vmId, nicId string) (numSpotPublicIp *api.PublicIp, err error) {
 	spaceID := provider.SpaceID
 
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	var retryCreate *api.CreatePublicIpResponse
-	if retryCreate, err = utils.RetryCreateUntilResourceAvailable(ctx, spaceID, numspotClient.CreatePublicIpWithResponse); err != nil {
+	if retryCreate, err = utils.RetryCreateUntilResourceAvailable(ctx, spaceID, withClient(provider, (*api.ClientWithResponses).CreatePublicIpWithResponse)); err != nil {
 		return nil, err
 	}
 
@@ -59,7 +54,7 @@ func linkPublicIP(ctx context.Context, provider *client.NumSpotSDK, publicIpId,
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(linkPublicIPResponse.Body, linkPublicIPResponse.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(linkPublicIPResponse.Body, linkPublicIPResponse.StatusCode(), linkPublicIPResponse.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -75,18 +70,13 @@ func UpdatePublicIpTags(ctx context.Context, provider *client.NumSpotSDK, stateT
 
 func DeletePublicIp(ctx context.Context, provider *client.NumSpotSDK, publicIpID, linkPublicIpID string) error {
 	spaceID := provider.SpaceID
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return err
-	}
-
 	if linkPublicIpID != "" {
-		if _, err = utils.RetryDeleteUntilWithBody(ctx, spaceID, publicIpID, api.UnlinkPublicIpJSONRequestBody{LinkPublicIpId: &linkPublicIpID}, numspotClient.UnlinkPublicIpWithResponse); err != nil {
+		if _, err := utils.RetryDeleteUntilWithBody(ctx, spaceID, publicIpID, api.UnlinkPublicIpJSONRequestBody{LinkPublicIpId: &linkPublicIpID}, withClientIDBody(provider, (*api.ClientWithResponses).UnlinkPublicIpWithResponse)); err != nil {
 			return err
 		}
 	}
 
-	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, publicIpID, numspotClient.DeletePublicIpWithResponse)
+	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, publicIpID, withClientID(provider, (*api.ClientWithResponses).DeletePublicIpWithResponse))
 }
 
 func ReadPublicIp(ctx context.Context, provider *client.NumSpotSDK, publicIpID string) (*api.PublicIp, error) {
@@ -99,7 +89,7 @@ func ReadPublicIp(ctx context.Context, provider *client.NumSpotSDK, publicIpID s
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(numSpotPublicIp.Body, numSpotPublicIp.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotPublicIp.Body, numSpotPublicIp.StatusCode(), numSpotPublicIp.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -115,7 +105,7 @@ func ReadPublicIpsWithParams(ctx context.Context, provider *client.NumSpotSDK, p
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadPublicIp.Body, numSpotReadPublicIp.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadPublicIp.Body, numSpotReadPublicIp.StatusCode(), numSpotReadPublicIp.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 	if numSpotReadPublicIp.JSON200.Items == nil {
@@ -10,11 +10,16 @@ import (
 )
 
 func ReadRouteTables(ctx context.Context, provider *client.NumSpotSDK, params api.ReadRouteTablesParams) (*[]api.RouteTable, error) {
-	res, err := provider.Client.ReadRouteTablesWithResponse(ctx, provider.SpaceID, &params)
+	numspotClient, err := provider.GetClient(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
+
+	res, err := numspotClient.ReadRouteTablesWithResponse(ctx, provider.SpaceID, &params)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -22,11 +27,16 @@ func ReadRouteTables(ctx context.Context, provider *client.NumSpotSDK, params ap
 }
 
 func ReadRouteTable(ctx context.Context, provider *client.NumSpotSDK, id string) (*api.RouteTable, error) {
-	res, err := provider.Client.ReadRouteTablesByIdWithResponse(ctx, provider.SpaceID, id)
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := numspotClient.ReadRouteTablesByIdWithResponse(ctx, provider.SpaceID, id)
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -45,7 +55,7 @@ func CreateRouteTable(
 		ctx,
 		provider.SpaceID,
 		payload,
-		provider.Client.CreateRouteTableWithResponse)
+		withClientBody(provider, (*api.ClientWithResponses).CreateRouteTableWithResponse))
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +89,7 @@ func DeleteRouteTable(ctx context.Context, provider *client.NumSpotSDK, id strin
 			return err
 		}
 	}
-	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, id, provider.Client.DeleteRouteTableWithResponse)
+	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, id, withClientID(provider, (*api.ClientWithResponses).DeleteRouteTableWithResponse))
 }
 
 func UpdateRouteTableRoutes(
@@ -154,6 +164,11 @@ func UpdateRouteTableSubnets(
 }
 
 func createRouteTableRoutes(ctx context.Context, provider *client.NumSpotSDK, routeTableId string, routes []api.Route) error {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
 	for _, r := range routes {
 		payload := api.CreateRoute{
 			DestinationIpRange: *r.DestinationIpRange,
@@ -164,11 +179,11 @@ func createRouteTableRoutes(ctx context.Context, provider *client.NumSpotSDK, ro
 			VpcPeeringId:       r.VpcPeeringId,
 		}
 
-		res, err := provider.Client.CreateRouteWithResponse(ctx, provider.SpaceID, routeTableId, payload)
+		res, err := numspotClient.CreateRouteWithResponse(ctx, provider.SpaceID, routeTableId, payload)
 		if err != nil {
 			return err
 		}
-		if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
+		if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
 			return err
 		}
 	}
@@ -176,16 +191,21 @@ func createRouteTableRoutes(ctx context.Context, provider *client.NumSpotSDK, ro
 }
 
 func deleteRouteTableRoutes(ctx context.Context, provider *client.NumSpotSDK, routeTableId string, routes []api.Route) error {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
 	for _, r := range routes {
 		payload := api.DeleteRoute{}
 		if r.DestinationIpRange != nil {
 			payload.DestinationIpRange = *r.DestinationIpRange
 		}
-		res, err := provider.Client.DeleteRouteWithResponse(ctx, provider.SpaceID, routeTableId, payload)
+		res, err := numspotClient.DeleteRouteWithResponse(ctx, provider.SpaceID, routeTableId, payload)
 		if err != nil {
 			return err
 		}
-		if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
+		if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
 			return err
 		}
 	}
@@ -204,15 +224,25 @@ func removeLocalRouteFromRoutes(routes []api.Route) []api.Route {
 }
 
 func linkRouteTable(ctx context.Context, provider *client.NumSpotSDK, routeTableId, subnetId string) error {
-	res, err := provider.Client.LinkRouteTableWithResponse(ctx, provider.SpaceID, routeTableId, api.LinkRouteTableJSONRequestBody{SubnetId: subnetId})
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := numspotClient.LinkRouteTableWithResponse(ctx, provider.SpaceID, routeTableId, api.LinkRouteTableJSONRequestBody{SubnetId: subnetId})
 	if err != nil {
 		return err
 	}
-	return utils.ParseHTTPError(res.Body, res.StatusCode())
+	return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
 }
 
 func unlinkRouteTable(ctx context.Context, provider *client.NumSpotSDK, routeTableId, linkRouteTableId string) error {
-	res, err := provider.Client.UnlinkRouteTableWithResponse(
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := numspotClient.UnlinkRouteTableWithResponse(
 		ctx,
 		provider.SpaceID,
 		routeTableId,
@@ -220,5 +250,5 @@ func unlinkRouteTable(ctx context.Context, provider *client.NumSpotSDK, routeTab
 	if err != nil {
 		return err
 	}
-	return utils.ParseHTTPError(res.Body, res.StatusCode())
+	return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
 }
@@ -19,7 +19,7 @@ func CreateSecurityGroupRule(ctx context.Context, provider *client.NumSpotSDK, i
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -37,7 +37,7 @@ func DeleteSecurityGroupRule(ctx context.Context, provider *client.NumSpotSDK, i
 		return err
 	}
 
-	if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
 		return err
 	}
 
@@ -9,13 +9,8 @@ import (
 )
 
 func CreateSecurityGroup(ctx context.Context, provider *client.NumSpotSDK, payload api.CreateSecurityGroupJSONRequestBody, tags []api.ResourceTag, inboundRules, outboundRules api.CreateSecurityGroupRuleJSONRequestBody) (numSpotSecurityGroup *api.SecurityGroup, err error) {
-	numSpotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	var retryCreate *api.CreateSecurityGroupResponse
-	if retryCreate, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, provider.SpaceID, payload, numSpotClient.CreateSecurityGroupWithResponse); err != nil {
+	if retryCreate, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, provider.SpaceID, payload, withClientBody(provider, (*api.ClientWithResponses).CreateSecurityGroupWithResponse)); err != nil {
 		return nil, err
 	}
 
@@ -113,18 +108,14 @@ func ReadSecurityGroup(ctx context.Context, provider *client.NumSpotSDK, id stri
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadSecurityGroup.Body, numSpotReadSecurityGroup.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadSecurityGroup.Body, numSpotReadSecurityGroup.StatusCode(), numSpotReadSecurityGroup.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 	return numSpotReadSecurityGroup.JSON200, nil
 }
 
 func DeleteSecurityGroup(ctx context.Context, provider *client.NumSpotSDK, id string) error {
-	numSpotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return err
-	}
-	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, id, numSpotClient.DeleteSecurityGroupWithResponse)
+	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, id, withClientID(provider, (*api.ClientWithResponses).DeleteSecurityGroupWithResponse))
 }
 
 func deleteRules(ctx context.Context, provider *client.NumSpotSDK, id string, rulesToDelete api.DeleteSecurityGroupRuleJSONRequestBody) error {
@@ -137,7 +128,7 @@ func deleteRules(ctx context.Context, provider *client.NumSpotSDK, id string, ru
 	if err != nil {
 		return err
 	}
-	if err = utils.ParseHTTPError(numSpotDeleteSecurityGroupRule.Body, numSpotDeleteSecurityGroupRule.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotDeleteSecurityGroupRule.Body, numSpotDeleteSecurityGroupRule.StatusCode(), numSpotDeleteSecurityGroupRule.HTTPResponse.Header); err != nil {
 		return err
 	}
 
@@ -153,7 +144,7 @@ func createRules(ctx context.Context, provider *client.NumSpotSDK, id string, ru
 	if err != nil {
 		return err
 	}
-	if err = utils.ParseHTTPError(numSpotCreateSecurityGroupRule.Body, numSpotCreateSecurityGroupRule.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotCreateSecurityGroupRule.Body, numSpotCreateSecurityGroupRule.StatusCode(), numSpotCreateSecurityGroupRule.HTTPResponse.Header); err != nil {
 		return err
 	}
 	return nil
@@ -168,7 +159,7 @@ func ReadSecurityGroups(ctx context.Context, provider *client.NumSpotSDK, params
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 	return res.JSON200.Items, nil
@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"fmt"
 
 	"terraform-provider-numspot/internal/client"
 	"terraform-provider-numspot/internal/sdk/api"
@@ -21,7 +22,7 @@ func CreateServerCertificate(ctx context.Context, provider *client.NumSpotSDK, n
 		return nil, err
 	}
 
-	err = utils.ParseHTTPError(resp.Body, resp.StatusCode())
+	err = utils.ParseHTTPError(resp.Body, resp.StatusCode(), resp.HTTPResponse.Header)
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +57,7 @@ func ReadServerCertificates(ctx context.Context, provider *client.NumSpotSDK, se
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(read.Body, read.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(read.Body, read.StatusCode(), read.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -69,19 +70,15 @@ func ReadServerCertificate(ctx context.Context, provider *client.NumSpotSDK, ser
 		return nil, err
 	}
 
-	var ret api.ServerCertificate
 	if resp != nil {
-		ll := len(*resp)
-		stop := false
-		for i := 0; ll > i && !stop; i++ {
-			if *(*resp)[i].Name == serverCertificateId {
-				ret = (*resp)[i]
-				stop = true
+		for _, serverCertificate := range *resp {
+			if serverCertificate.Name != nil && *serverCertificate.Name == serverCertificateId {
+				return &serverCertificate, nil
 			}
 		}
 	}
 
-	return &ret, nil
+	return nil, &utils.NotFoundError{Err: fmt.Errorf("server certificate %s not found", serverCertificateId)}
 }
 
 func UpdateServerCertificate(ctx context.Context, provider *client.NumSpotSDK, id string, body api.UpdateServerCertificateJSONRequestBody) (*api.ServerCertificate, error) {
@@ -97,9 +94,13 @@ func UpdateServerCertificate(ctx context.Context, provider *client.NumSpotSDK, i
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(resp.Body, resp.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(resp.Body, resp.StatusCode(), resp.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
+	if body.NewName != nil {
+		id = *body.NewName
+	}
+
 	return ReadServerCertificate(ctx, provider, id)
 }
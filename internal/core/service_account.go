@@ -0,0 +1,164 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
+)
+
+// CreateServiceAccount creates an organisation service account when organisationID is set, a service account of the space of the provider otherwise.
+// The other service account functions use organisationID the same way
+func CreateServiceAccount(ctx context.Context, provider *client.NumSpotSDK, organisationID *api.OrganisationId, numSpotServiceAccountCreate api.ServiceAccount) (*api.CreatedServiceAccount, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if organisationID != nil {
+		res, err := numspotClient.CreateServiceAccountOrganisationWithResponse(ctx, *organisationID, numSpotServiceAccountCreate)
+		if err != nil {
+			return nil, err
+		}
+		if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+			return nil, err
+		}
+		return res.JSON201, nil
+	}
+
+	res, err := numspotClient.CreateServiceAccountSpaceWithResponse(ctx, provider.SpaceID, numSpotServiceAccountCreate)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+	return res.JSON201, nil
+}
+
+func UpdateServiceAccount(ctx context.Context, provider *client.NumSpotSDK, organisationID *api.OrganisationId, serviceAccountID api.ServiceAccountId, numSpotServiceAccountUpdate api.ServiceAccount) (*api.ServiceAccountEdited, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if organisationID != nil {
+		res, err := numspotClient.UpdateServiceAccountOrganisationWithResponse(ctx, *organisationID, serviceAccountID, numSpotServiceAccountUpdate)
+		if err != nil {
+			return nil, err
+		}
+		if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+			return nil, err
+		}
+		return res.JSON200, nil
+	}
+
+	res, err := numspotClient.UpdateServiceAccountSpaceWithResponse(ctx, provider.SpaceID, serviceAccountID, numSpotServiceAccountUpdate)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func ReadServiceAccount(ctx context.Context, provider *client.NumSpotSDK, organisationID *api.OrganisationId, serviceAccountID api.ServiceAccountId) (*api.ServiceAccountEdited, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if organisationID != nil {
+		res, err := numspotClient.GetServiceAccountOrganisationWithResponse(ctx, *organisationID, serviceAccountID)
+		if err != nil {
+			return nil, err
+		}
+		if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+			return nil, err
+		}
+		return res.JSON200, nil
+	}
+
+	res, err := numspotClient.GetServiceAccountSpaceWithResponse(ctx, provider.SpaceID, serviceAccountID)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func DeleteServiceAccount(ctx context.Context, provider *client.NumSpotSDK, organisationID *api.OrganisationId, serviceAccountID api.ServiceAccountId) error {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	if organisationID != nil {
+		res, err := numspotClient.DeleteServiceAccountOrganisationWithResponse(ctx, *organisationID, serviceAccountID)
+		if err != nil {
+			return err
+		}
+		return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+	}
+
+	res, err := numspotClient.DeleteServiceAccountSpaceWithResponse(ctx, provider.SpaceID, serviceAccountID)
+	if err != nil {
+		return err
+	}
+	return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+}
+
+// AssignServiceAccountToSpace gives access to the space of the provider to a service account of the organisation
+func AssignServiceAccountToSpace(ctx context.Context, provider *client.NumSpotSDK, organisationID api.OrganisationId, serviceAccountID api.ServiceAccountId) error {
+	if err := checkOrganisationServiceAccount(ctx, provider, organisationID, serviceAccountID); err != nil {
+		return err
+	}
+
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := numspotClient.AssignServiceAccountToSpaceWithResponse(ctx, provider.SpaceID, serviceAccountID)
+	if err != nil {
+		return err
+	}
+
+	return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+}
+
+// UnassignServiceAccountFromSpace removes a service account of the organisation from the space of the provider.
+// The API documents the underlying request as the deletion of a service account of the space, so the service account
+// is checked to belong to the organisation first and a space service account is never deleted here
+func UnassignServiceAccountFromSpace(ctx context.Context, provider *client.NumSpotSDK, organisationID api.OrganisationId, serviceAccountID api.ServiceAccountId) error {
+	if err := checkOrganisationServiceAccount(ctx, provider, organisationID, serviceAccountID); err != nil {
+		return err
+	}
+
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := numspotClient.DeleteServiceAccountSpaceWithResponse(ctx, provider.SpaceID, serviceAccountID)
+	if err != nil {
+		return err
+	}
+
+	return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+}
+
+// checkOrganisationServiceAccount returns an error when the service account is not a service account of the organisation,
+// the *utils.NotFoundError of the API is kept so that callers can tell a deleted service account apart
+func checkOrganisationServiceAccount(ctx context.Context, provider *client.NumSpotSDK, organisationID api.OrganisationId, serviceAccountID api.ServiceAccountId) error {
+	if _, err := ReadServiceAccount(ctx, provider, &organisationID, serviceAccountID); err != nil {
+		return fmt.Errorf("service account %s is not a service account of organisation %s: %w", serviceAccountID, organisationID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-numspot/internal/client/clienttest"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
+)
+
+// serviceAccountStub records the requests made to the IAM API and answers them with a new service account,
+// organisationServiceAccount is the only service account found in an organisation
+type serviceAccountStub struct {
+	mu                         sync.Mutex
+	requests                   []string
+	organisationServiceAccount string
+}
+
+func (s *serviceAccountStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPost:
+		clienttest.WriteJSON(w, http.StatusCreated, api.CreatedServiceAccount{Id: uuid.NewString(), Name: "ci", Secret: "secret", TokenDuration: utils.PointerOf("PT24H")})
+	case http.MethodDelete:
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodGet:
+		if s.organisationServiceAccount == "" || !strings.HasSuffix(r.URL.Path, "/serviceAccounts/"+s.organisationServiceAccount) {
+			http.NotFound(w, r)
+			return
+		}
+		clienttest.WriteJSON(w, http.StatusOK, api.ServiceAccountEdited{Id: s.organisationServiceAccount, Name: "ci"})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestCreateServiceAccount(t *testing.T) {
+	ctx := context.Background()
+	stub := &serviceAccountStub{}
+	provider := clienttest.NewStubSDK(t, stub)
+	organisationID := uuid.New()
+
+	serviceAccount, err := CreateServiceAccount(ctx, provider, nil, api.ServiceAccount{Name: "ci"})
+	require.NoError(t, err)
+	assert.Equal(t, "secret", serviceAccount.Secret)
+
+	_, err = CreateServiceAccount(ctx, provider, &organisationID, api.ServiceAccount{Name: "ci"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"POST /iam/spaces/" + provider.SpaceID.String() + "/serviceAccounts",
+		"POST /iam/organisations/" + organisationID.String() + "/serviceAccounts",
+	}, stub.requests)
+}
+
+func TestDeleteServiceAccount(t *testing.T) {
+	ctx := context.Background()
+	stub := &serviceAccountStub{}
+	provider := clienttest.NewStubSDK(t, stub)
+	organisationID, serviceAccountID := uuid.New(), uuid.New()
+
+	require.NoError(t, DeleteServiceAccount(ctx, provider, nil, serviceAccountID))
+	require.NoError(t, DeleteServiceAccount(ctx, provider, &organisationID, serviceAccountID))
+
+	assert.Equal(t, []string{
+		"DELETE /iam/spaces/" + provider.SpaceID.String() + "/serviceAccounts/" + serviceAccountID.String(),
+		"DELETE /iam/organisations/" + organisationID.String() + "/serviceAccounts/" + serviceAccountID.String(),
+	}, stub.requests)
+}
+
+func TestUnassignServiceAccountFromSpace(t *testing.T) {
+	ctx := context.Background()
+	organisationID, serviceAccountID, spaceServiceAccountID := uuid.New(), uuid.New(), uuid.New()
+	stub := &serviceAccountStub{organisationServiceAccount: serviceAccountID.String()}
+	provider := clienttest.NewStubSDK(t, stub)
+
+	require.NoError(t, UnassignServiceAccountFromSpace(ctx, provider, organisationID, serviceAccountID))
+
+	// A service account of the space is not found in the organisation and must not be deleted
+	err := UnassignServiceAccountFromSpace(ctx, provider, organisationID, spaceServiceAccountID)
+	require.Error(t, err)
+	assert.True(t, utils.IsNotFound(err))
+
+	assert.Equal(t, []string{
+		"GET /iam/organisations/" + organisationID.String() + "/serviceAccounts/" + serviceAccountID.String(),
+		"DELETE /iam/spaces/" + provider.SpaceID.String() + "/serviceAccounts/" + serviceAccountID.String(),
+		"GET /iam/organisations/" + organisationID.String() + "/serviceAccounts/" + spaceServiceAccountID.String(),
+	}, stub.requests)
+}
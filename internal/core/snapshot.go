@@ -17,13 +17,8 @@ var (
 func CreateSnapshot(ctx context.Context, provider *client.NumSpotSDK, tags []api.ResourceTag, body api.CreateSnapshotJSONRequestBody) (numSpotSnapshot *api.Snapshot, err error) {
 	spaceID := provider.SpaceID
 
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	var retryCreate *api.CreateSnapshotResponse
-	if retryCreate, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, body, numspotClient.CreateSnapshotWithResponse); err != nil {
+	if retryCreate, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, body, withClientBody(provider, (*api.ClientWithResponses).CreateSnapshotWithResponse)); err != nil {
 		return nil, err
 	}
 
@@ -46,12 +41,7 @@ func UpdateSnapshotTags(ctx context.Context, provider *client.NumSpotSDK, stateT
 }
 
 func DeleteSnapshot(ctx context.Context, provider *client.NumSpotSDK, snapshotID string) error {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return err
-	}
-
-	err = utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, snapshotID, numspotClient.DeleteSnapshotWithResponse)
+	err := utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, snapshotID, withClientID(provider, (*api.ClientWithResponses).DeleteSnapshotWithResponse))
 	if err != nil {
 		return err
 	}
@@ -68,7 +58,7 @@ func ReadSnapshot(ctx context.Context, provider *client.NumSpotSDK, snapshotID s
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(numSpotSnapshot.Body, numSpotSnapshot.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotSnapshot.Body, numSpotSnapshot.StatusCode(), numSpotSnapshot.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -76,12 +66,8 @@ func ReadSnapshot(ctx context.Context, provider *client.NumSpotSDK, snapshotID s
 }
 
 func RetryReadSnapshot(ctx context.Context, provider *client.NumSpotSDK, snapshotID string) (*api.Snapshot, error) {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
 	read, err := utils.RetryReadUntilStateValid(ctx, snapshotID, provider.SpaceID, snapshotPendingStates, snapshotTargetStates,
-		numspotClient.ReadSnapshotsByIdWithResponse)
+		withClientID(provider, (*api.ClientWithResponses).ReadSnapshotsByIdWithResponse))
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +88,7 @@ func ReadSnapshotsWithParams(ctx context.Context, provider *client.NumSpotSDK, p
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadSnapshot.Body, numSpotReadSnapshot.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadSnapshot.Body, numSpotReadSnapshot.StatusCode(), numSpotReadSnapshot.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 	if numSpotReadSnapshot.JSON200.Items == nil {
@@ -0,0 +1,32 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-numspot/internal/client/clienttest"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
+)
+
+// TestRetryReadSnapshotRefreshesToken waits for a snapshot for longer than the lifetime of the access tokens, the
+// reads sent after the first token expired must use a refreshed one
+func TestRetryReadSnapshotRefreshesToken(t *testing.T) {
+	t.Setenv("RETRY_BACKOFF", "100ms")
+	completedAt := time.Now().Add(1500 * time.Millisecond)
+	provider := clienttest.NewExpiringStubSDK(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state := inQueue
+		if time.Now().After(completedAt) {
+			state = completed
+		}
+		clienttest.WriteJSON(w, http.StatusOK, api.Snapshot{Id: utils.PointerOf("snapshot"), State: &state})
+	}), 1)
+
+	snapshot, err := RetryReadSnapshot(context.Background(), provider, "snapshot")
+	require.NoError(t, err)
+	assert.Equal(t, completed, *snapshot.State)
+}
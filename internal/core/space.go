@@ -0,0 +1,122 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	spacePendingStates = []string{string(api.SpaceStatusQUEUED), string(api.SpaceStatusRUNNING)}
+	spaceTargetStates  = []string{string(api.SpaceStatusREADY)}
+)
+
+func CreateSpace(ctx context.Context, provider *client.NumSpotSDK, organisationID api.OrganisationId, numSpotSpaceCreate api.CreateSpaceJSONRequestBody) (*api.Space, error) {
+	// Spaces are created in an organisation, its ID takes the place of the space ID of the other resources
+	retryCreate, err := utils.RetryCreateUntilResourceAvailableWithBody(ctx, organisationID, numSpotSpaceCreate, withClientBody(provider, (*api.ClientWithResponses).CreateSpaceWithResponse))
+	if err != nil {
+		return nil, err
+	}
+
+	return RetryReadSpace(ctx, provider, createOp, organisationID, retryCreate.JSON200.Id)
+}
+
+func UpdateSpace(ctx context.Context, provider *client.NumSpotSDK, organisationID api.OrganisationId, spaceID api.SpaceId, numSpotSpaceUpdate api.UpdateSpaceJSONRequestBody) (*api.Space, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := numspotClient.UpdateSpaceWithResponse(ctx, organisationID, spaceID, numSpotSpaceUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	return RetryReadSpace(ctx, provider, updateOp, organisationID, spaceID)
+}
+
+func ReadSpace(ctx context.Context, provider *client.NumSpotSDK, organisationID api.OrganisationId, spaceID api.SpaceId) (*api.Space, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := numspotClient.GetSpaceByIdWithResponse(ctx, organisationID, spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+
+	return res.JSON200, nil
+}
+
+// ReadSpaces returns every space of the organisation, following the pages of the listing
+func ReadSpaces(ctx context.Context, provider *client.NumSpotSDK, organisationID api.OrganisationId) ([]api.Space, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		spaces    []api.Space
+		nextToken *string
+	)
+	for {
+		res, err := numspotClient.ListSpacesWithResponse(ctx, organisationID, &api.ListSpacesParams{
+			Page: &api.ListSpacesPage{NextToken: nextToken},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+			return nil, err
+		}
+
+		spaces = append(spaces, res.JSON200.Items...)
+
+		if utils.GetPtrValue(res.JSON200.NextPageToken) == "" {
+			return spaces, nil
+		}
+		nextToken = res.JSON200.NextPageToken
+	}
+}
+
+// RetryReadSpace waits for the space to be READY, it can only be used from then on
+func RetryReadSpace(ctx context.Context, provider *client.NumSpotSDK, op string, organisationID api.OrganisationId, spaceID api.SpaceId) (*api.Space, error) {
+	spaceStateConf := &retry.StateChangeConf{
+		Pending: spacePendingStates,
+		Target:  spaceTargetStates,
+		Timeout: utils.RetryTimeout(ctx, utils.TfRequestRetryTimeout),
+		Delay:   utils.ParseRetryBackoff(),
+		Refresh: func() (interface{}, string, error) {
+			space, err := ReadSpace(ctx, provider, organisationID, spaceID)
+			if err != nil {
+				return nil, "", err
+			}
+			return space, string(space.Status), nil
+		},
+	}
+
+	read, err := spaceStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	numSpotSpace, assert := read.(*api.Space)
+	if !assert {
+		return nil, fmt.Errorf("invalid space assertion %s: %s", spaceID, op)
+	}
+	return numSpotSpace, nil
+}
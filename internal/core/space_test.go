@@ -0,0 +1,89 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-numspot/internal/client/clienttest"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
+)
+
+// spaceStub keeps the created space QUEUED until it has been read readyAfter times, and lists its spaces one per page
+type spaceStub struct {
+	mu         sync.Mutex
+	readyAfter int
+	spaces     []api.Space
+}
+
+func (s *spaceStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	organisationID, spaceID, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/organisations/"), "/spaces")
+	spaceID = strings.TrimPrefix(spaceID, "/")
+
+	switch {
+	case r.Method == http.MethodPost:
+		space := api.Space{Id: uuid.New(), Name: "space", OrganisationId: uuid.MustParse(organisationID), Status: api.SpaceStatusQUEUED}
+		s.spaces = append(s.spaces, space)
+		clienttest.WriteJSON(w, http.StatusOK, space)
+	case spaceID != "":
+		for i := range s.spaces {
+			if s.spaces[i].Id.String() != spaceID {
+				continue
+			}
+			if s.readyAfter > 0 {
+				s.readyAfter--
+			} else {
+				s.spaces[i].Status = api.SpaceStatusREADY
+			}
+			clienttest.WriteJSON(w, http.StatusOK, s.spaces[i])
+			return
+		}
+		http.NotFound(w, r)
+	default:
+		page := 0
+		if token := r.URL.Query().Get("page[nextToken]"); token != "" {
+			page = len(token)
+		}
+
+		list := api.SpacePaginatedList{Items: s.spaces[page : page+1]}
+		if page+1 < len(s.spaces) {
+			list.NextPageToken = utils.PointerOf(strings.Repeat("n", page+1))
+		}
+		clienttest.WriteJSON(w, http.StatusOK, list)
+	}
+}
+
+func TestCreateSpace(t *testing.T) {
+	t.Setenv("RETRY_BACKOFF", "1ms")
+	provider := clienttest.NewStubSDK(t, &spaceStub{readyAfter: 2})
+	organisationID := uuid.New()
+
+	space, err := CreateSpace(context.Background(), provider, organisationID, api.CreateSpaceJSONRequestBody{Name: "space"})
+	require.NoError(t, err)
+	assert.Equal(t, api.SpaceStatusREADY, space.Status)
+	assert.Equal(t, organisationID, space.OrganisationId)
+}
+
+func TestReadSpaces(t *testing.T) {
+	organisationID := uuid.New()
+	stub := &spaceStub{spaces: []api.Space{
+		{Id: uuid.New(), Name: "first", OrganisationId: organisationID, Status: api.SpaceStatusREADY},
+		{Id: uuid.New(), Name: "second", OrganisationId: organisationID, Status: api.SpaceStatusREADY},
+		{Id: uuid.New(), Name: "third", OrganisationId: organisationID, Status: api.SpaceStatusREADY},
+	}}
+	provider := clienttest.NewStubSDK(t, stub)
+
+	spaces, err := ReadSpaces(context.Background(), provider, organisationID)
+	require.NoError(t, err)
+	require.Len(t, spaces, 3)
+	assert.Equal(t, "third", spaces[2].Name)
+}
@@ -18,6 +18,7 @@ const (
 	updating      = "updating"
 	pending       = "pending"
 	pendingQueued = "pending/queued"
+	requested     = "requested"
 	inQueue       = "in-queue"
 	completed     = "completed"
 	running       = "running"
@@ -29,4 +30,8 @@ const (
 	// created       = "created"
 	available = "available"
 	inUse     = "in-use"
+
+	// Health states of the back-end VMs of a load balancer
+	inService    = "InService"
+	outOfService = "OutOfService"
 )
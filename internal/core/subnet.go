@@ -17,26 +17,21 @@ var (
 func CreateSubnet(ctx context.Context, provider *client.NumSpotSDK, payload api.CreateSubnet, mapPublicIPOnLaunch bool, tags []api.ResourceTag) (*api.Subnet, error) {
 	spaceID := provider.SpaceID
 
-	numspotClient, err := provider.GetClient(ctx)
+	retryCreate, err := utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, payload, withClientBody(provider, (*api.ClientWithResponses).CreateSubnetWithResponse))
 	if err != nil {
 		return nil, err
 	}
 
-	var retryCreate *api.CreateSubnetResponse
-	if retryCreate, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, payload, numspotClient.CreateSubnetWithResponse); err != nil {
-		return nil, err
-	}
-
 	subnetID := *retryCreate.JSON201.Id
 
 	if mapPublicIPOnLaunch {
-		if _, err = UpdateSubnetAttributes(ctx, provider, subnetID, mapPublicIPOnLaunch); err != nil {
+		if _, err := UpdateSubnetAttributes(ctx, provider, subnetID, mapPublicIPOnLaunch); err != nil {
 			return nil, err
 		}
 	}
 
 	if len(tags) > 0 {
-		if err = createTags(ctx, provider, subnetID, tags); err != nil {
+		if err := createTags(ctx, provider, subnetID, tags); err != nil {
 			return nil, err
 		}
 	}
@@ -58,7 +53,7 @@ func UpdateSubnetAttributes(ctx context.Context, provider *client.NumSpotSDK, su
 	); err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotSubnet.Body, numSpotSubnet.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotSubnet.Body, numSpotSubnet.StatusCode(), numSpotSubnet.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -73,12 +68,7 @@ func UpdateSubnetTags(ctx context.Context, provider *client.NumSpotSDK, subnetID
 }
 
 func DeleteSubnet(ctx context.Context, provider *client.NumSpotSDK, subnetID string) error {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return err
-	}
-
-	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, subnetID, numspotClient.DeleteSubnetWithResponse)
+	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, subnetID, withClientID(provider, (*api.ClientWithResponses).DeleteSubnetWithResponse))
 }
 
 func ReadSubnet(ctx context.Context, provider *client.NumSpotSDK, subnetID string) (*api.Subnet, error) {
@@ -91,7 +81,7 @@ func ReadSubnet(ctx context.Context, provider *client.NumSpotSDK, subnetID strin
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadSubnet.Body, numSpotReadSubnet.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadSubnet.Body, numSpotReadSubnet.StatusCode(), numSpotReadSubnet.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -108,7 +98,7 @@ func ReadSubnetsWithParams(ctx context.Context, provider *client.NumSpotSDK, par
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadSubnet.Body, numSpotReadSubnet.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadSubnet.Body, numSpotReadSubnet.StatusCode(), numSpotReadSubnet.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -116,12 +106,8 @@ func ReadSubnetsWithParams(ctx context.Context, provider *client.NumSpotSDK, par
 }
 
 func RetryReadSubnet(ctx context.Context, provider *client.NumSpotSDK, op, subnetID string) (*api.Subnet, error) {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
 	read, err := utils.RetryReadUntilStateValid(ctx, subnetID, provider.SpaceID, subnetPendingStates, subnetTargetStates,
-		numspotClient.ReadSubnetsByIdWithResponse)
+		withClientID(provider, (*api.ClientWithResponses).ReadSubnetsByIdWithResponse))
 	if err != nil {
 		return nil, err
 	}
@@ -30,7 +30,7 @@ func createTags(
 	}
 
 	if res.StatusCode() != http.StatusNoContent {
-		apiError := utils.HandleError(res.Body)
+		apiError := utils.NewAPIError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
 		return apiError
 	}
 
@@ -114,7 +114,7 @@ func deleteTags(
 	}
 
 	if res.StatusCode() != http.StatusNoContent {
-		return utils.HandleError(res.Body)
+		return utils.NewAPIError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
 	}
 
 	return nil
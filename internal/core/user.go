@@ -0,0 +1,156 @@
+package core
+
+import (
+	"context"
+
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
+)
+
+// CreateUser creates a user in the organisation, the user is enabled unless disabled with UpdateUserState
+func CreateUser(ctx context.Context, provider *client.NumSpotSDK, organisationID api.OrganisationId, numSpotUserCreate api.User) (*api.UserCreated, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := numspotClient.CreateUserOrganisationWithResponse(ctx, organisationID, numSpotUserCreate)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+	return res.JSON201, nil
+}
+
+func UpdateUser(ctx context.Context, provider *client.NumSpotSDK, organisationID api.OrganisationId, userID api.UserId, numSpotUserUpdate api.UserUpdate) (*api.UserModified, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := numspotClient.UpdateUserOrganisationWithResponse(ctx, organisationID, userID, numSpotUserUpdate)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+// UpdateUserState enables or disables the user in the organisation
+func UpdateUserState(ctx context.Context, provider *client.NumSpotSDK, organisationID api.OrganisationId, userID api.UserId, active bool) error {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := numspotClient.PatchUserStateOrganisationWithResponse(ctx, organisationID, userID, api.UserState{Active: active})
+	if err != nil {
+		return err
+	}
+	return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+}
+
+// ReadUser reads the user in the organisation when organisationID is set, in the space of the provider otherwise
+func ReadUser(ctx context.Context, provider *client.NumSpotSDK, organisationID *api.OrganisationId, userID api.UserId) (*api.UserModified, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if organisationID != nil {
+		res, err := numspotClient.GetUserOrganisationWithResponse(ctx, *organisationID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+			return nil, err
+		}
+		return res.JSON200, nil
+	}
+
+	res, err := numspotClient.GetUserSpaceWithResponse(ctx, provider.SpaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+// ReadUserByEmail looks the user up by email in the organisation when organisationID is set, in the space of the provider otherwise.
+// The Authorization header parameter is overwritten by the bearer token of the client
+func ReadUserByEmail(ctx context.Context, provider *client.NumSpotSDK, organisationID *api.OrganisationId, email api.UserEmail) (*api.UserModified, error) {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if organisationID != nil {
+		res, err := numspotClient.GetUserOrganisationByEmailWithResponse(ctx, *organisationID, &api.GetUserOrganisationByEmailParams{Email: email})
+		if err != nil {
+			return nil, err
+		}
+		if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+			return nil, err
+		}
+		return res.JSON200, nil
+	}
+
+	res, err := numspotClient.GetUserSpaceByEmailWithResponse(ctx, provider.SpaceID, &api.GetUserSpaceByEmailParams{Email: email})
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func DeleteUser(ctx context.Context, provider *client.NumSpotSDK, organisationID api.OrganisationId, userID api.UserId) error {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := numspotClient.DeleteUserOrganisationWithResponse(ctx, organisationID, userID)
+	if err != nil {
+		return err
+	}
+	return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+}
+
+// AssignUserToSpace gives access to the space of the provider to an organisation user
+func AssignUserToSpace(ctx context.Context, provider *client.NumSpotSDK, userID api.UserId) error {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := numspotClient.AssignUserToSpaceWithResponse(ctx, provider.SpaceID, userID)
+	if err != nil {
+		return err
+	}
+
+	return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+}
+
+// UnassignUserFromSpace removes the access of the user to the space of the provider, the user is kept in the organisation
+func UnassignUserFromSpace(ctx context.Context, provider *client.NumSpotSDK, userID api.UserId) error {
+	numspotClient, err := provider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := numspotClient.UnassignUserSpaceWithResponse(ctx, provider.SpaceID, userID)
+	if err != nil {
+		return err
+	}
+
+	return utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
+}
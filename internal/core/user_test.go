@@ -0,0 +1,38 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-numspot/internal/client/clienttest"
+	"terraform-provider-numspot/internal/sdk/api"
+)
+
+func TestReadUserByEmail(t *testing.T) {
+	ctx := context.Background()
+	organisationID := uuid.New()
+
+	var requests []string
+	provider := clienttest.NewStubSDK(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The empty Authorization header parameter must not replace the access token
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		requests = append(requests, r.URL.Path+"?"+r.URL.RawQuery)
+		clienttest.WriteJSON(w, http.StatusOK, api.UserModified{Active: true, Email: "jane.doe@example.com", Id: uuid.New()})
+	}))
+
+	user, err := ReadUserByEmail(ctx, provider, nil, "jane.doe@example.com")
+	require.NoError(t, err)
+	assert.True(t, user.Active)
+
+	_, err = ReadUserByEmail(ctx, provider, &organisationID, "jane.doe@example.com")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"/iam/spaces/" + provider.SpaceID.String() + "/match/users?email=jane.doe%40example.com",
+		"/iam/organisations/" + organisationID.String() + "/match/users?email=jane.doe%40example.com",
+	}, requests)
+}
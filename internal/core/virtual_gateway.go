@@ -17,13 +17,8 @@ var (
 func CreateVirtualGateway(ctx context.Context, provider *client.NumSpotSDK, numSpotVirtualGatewayCreate api.CreateVirtualGatewayJSONRequestBody, vpcId string) (numSpotVirtualGateway *api.VirtualGateway, err error) {
 	spaceID := provider.SpaceID
 
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	var retryCreate *api.CreateVirtualGatewayResponse
-	if retryCreate, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, numSpotVirtualGatewayCreate, numspotClient.CreateVirtualGatewayWithResponse); err != nil {
+	if retryCreate, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, numSpotVirtualGatewayCreate, withClientBody(provider, (*api.ClientWithResponses).CreateVirtualGatewayWithResponse)); err != nil {
 		return nil, err
 	}
 
@@ -75,11 +70,6 @@ func unlinkVirtualGateway(ctx context.Context, provider *client.NumSpotSDK, gate
 }
 
 func DeleteVirtualGateway(ctx context.Context, provider *client.NumSpotSDK, virtualGatewayID api.ResourceIdentifier, vpcId string) error {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return err
-	}
-
 	// Unlink virtual gateway from VPC
 	if vpcId != "" {
 		err := unlinkVirtualGateway(ctx, provider, virtualGatewayID, vpcId)
@@ -88,7 +78,7 @@ func DeleteVirtualGateway(ctx context.Context, provider *client.NumSpotSDK, virt
 		}
 	}
 
-	err = utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, virtualGatewayID, numspotClient.DeleteVirtualGatewayWithResponse)
+	err := utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, virtualGatewayID, withClientID(provider, (*api.ClientWithResponses).DeleteVirtualGatewayWithResponse))
 	if err != nil {
 		return err
 	}
@@ -105,7 +95,7 @@ func ReadVirtualGateway(ctx context.Context, provider *client.NumSpotSDK, virtua
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(numSpotVirtualGateway.Body, numSpotVirtualGateway.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotVirtualGateway.Body, numSpotVirtualGateway.StatusCode(), numSpotVirtualGateway.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -122,7 +112,7 @@ func ReadVirtualGatewaysWithParams(ctx context.Context, provider *client.NumSpot
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(numSpotVirtualGateway.Body, numSpotVirtualGateway.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotVirtualGateway.Body, numSpotVirtualGateway.StatusCode(), numSpotVirtualGateway.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -134,11 +124,7 @@ func ReadVirtualGatewaysWithParams(ctx context.Context, provider *client.NumSpot
 }
 
 func RetryReadVirtualGateway(ctx context.Context, provider *client.NumSpotSDK, op string, virtualGatewayID api.ResourceIdentifier) (*api.VirtualGateway, error) {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-	read, err := utils.RetryReadUntilStateValid(ctx, virtualGatewayID, provider.SpaceID, virtualGatewayPendingStates, virtualGatewayTargetStates, numspotClient.ReadVirtualGatewayWithResponse)
+	read, err := utils.RetryReadUntilStateValid(ctx, virtualGatewayID, provider.SpaceID, virtualGatewayPendingStates, virtualGatewayTargetStates, withClientID(provider, (*api.ClientWithResponses).ReadVirtualGatewayWithResponse))
 	if err != nil {
 		return nil, err
 	}
@@ -17,14 +17,9 @@ var (
 func CreateVM(ctx context.Context, provider *client.NumSpotSDK, numSpotVMCreate api.CreateVmsJSONRequestBody, tags []api.ResourceTag) (numSpotVM *api.Vm, err error) {
 	spaceID := provider.SpaceID
 
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	var retryCreate *api.CreateVmsResponse
 	if retryCreate, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, numSpotVMCreate,
-		numspotClient.CreateVmsWithResponse); err != nil {
+		withClientBody(provider, (*api.ClientWithResponses).CreateVmsWithResponse)); err != nil {
 		return nil, err
 	}
 
@@ -57,7 +52,7 @@ func UpdateVMAttributes(ctx context.Context, provider *client.NumSpotSDK, numSpo
 	if updateVMResponse, err = numspotClient.UpdateVmWithResponse(ctx, spaceID, vmID, numSpotVMUpdate); err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(updateVMResponse.Body, updateVMResponse.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(updateVMResponse.Body, updateVMResponse.StatusCode(), updateVMResponse.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -94,7 +89,7 @@ func UpdateVMKeypair(ctx context.Context, provider *client.NumSpotSDK, numSpotVM
 	if updateVMResponse, err = numspotClient.UpdateVmWithResponse(ctx, spaceID, vmID, numSpotVMUpdate); err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(updateVMResponse.Body, updateVMResponse.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(updateVMResponse.Body, updateVMResponse.StatusCode(), updateVMResponse.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -113,12 +108,7 @@ func UpdateVMTags(ctx context.Context, provider *client.NumSpotSDK, stateTags []
 }
 
 func DeleteVM(ctx context.Context, provider *client.NumSpotSDK, vmID string) (err error) {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return err
-	}
-
-	err = utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, vmID, numspotClient.DeleteVmsWithResponse)
+	err = utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, vmID, withClientID(provider, (*api.ClientWithResponses).DeleteVmsWithResponse))
 	if err != nil {
 		return err
 	}
@@ -127,11 +117,7 @@ func DeleteVM(ctx context.Context, provider *client.NumSpotSDK, vmID string) (er
 }
 
 func RetryReadVM(ctx context.Context, provider *client.NumSpotSDK, op string, vmID string) (*api.Vm, error) {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-	read, err := utils.RetryReadUntilStateValid(ctx, vmID, provider.SpaceID, vmPendingStates, vmTargetStates, numspotClient.ReadVmsByIdWithResponse)
+	read, err := utils.RetryReadUntilStateValid(ctx, vmID, provider.SpaceID, vmPendingStates, vmTargetStates, withClientID(provider, (*api.ClientWithResponses).ReadVmsByIdWithResponse))
 	if err != nil {
 		return nil, err
 	}
@@ -153,7 +139,7 @@ func ReadVM(ctx context.Context, provider *client.NumSpotSDK, vmID string) (*api
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadVM.Body, numSpotReadVM.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadVM.Body, numSpotReadVM.StatusCode(), numSpotReadVM.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -169,7 +155,7 @@ func ReadVMsWithParams(ctx context.Context, provider *client.NumSpotSDK, params
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadVM.Body, numSpotReadVM.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadVM.Body, numSpotReadVM.StatusCode(), numSpotReadVM.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -211,7 +197,7 @@ func StopVM(ctx context.Context, provider *client.NumSpotSDK, vm string) (err er
 	}
 
 	if _, err = utils.RetryReadUntilStateValid(ctx, vm, provider.SpaceID, []string{stopping}, []string{stopped, terminated},
-		numspotClient.ReadVmsByIdWithResponse); err != nil {
+		withClientID(provider, (*api.ClientWithResponses).ReadVmsByIdWithResponse)); err != nil {
 		return err
 	}
 
@@ -247,7 +233,7 @@ func StartVM(ctx context.Context, provider *client.NumSpotSDK, vm string) (err e
 		return err
 	}
 
-	if _, err = utils.RetryReadUntilStateValid(ctx, vm, provider.SpaceID, []string{pending}, []string{running}, numspotClient.ReadVmsByIdWithResponse); err != nil {
+	if _, err = utils.RetryReadUntilStateValid(ctx, vm, provider.SpaceID, []string{pending}, []string{running}, withClientID(provider, (*api.ClientWithResponses).ReadVmsByIdWithResponse)); err != nil {
 		return err
 	}
 
@@ -18,13 +18,8 @@ var (
 func CreateVolume(ctx context.Context, provider *client.NumSpotSDK, numSpotVolumeCreate api.CreateVolumeJSONRequestBody, tags []api.ResourceTag, vmID, deviceName string) (numSpotVolume *api.Volume, err error) {
 	spaceID := provider.SpaceID
 
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	var retryCreate *api.CreateVolumeResponse
-	if retryCreate, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, numSpotVolumeCreate, numspotClient.CreateVolumeWithResponse); err != nil {
+	if retryCreate, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, numSpotVolumeCreate, withClientBody(provider, (*api.ClientWithResponses).CreateVolumeWithResponse)); err != nil {
 		return nil, err
 	}
 
@@ -63,7 +58,7 @@ func UpdateVolumeAttributes(ctx context.Context, provider *client.NumSpotSDK, nu
 	if updateVolumeResponse, err = numspotClient.UpdateVolumeWithResponse(ctx, provider.SpaceID, volumeID, numSpotVolumeUpdate); err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(updateVolumeResponse.Body, updateVolumeResponse.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(updateVolumeResponse.Body, updateVolumeResponse.StatusCode(), updateVolumeResponse.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -133,19 +128,11 @@ func DeleteVolume(ctx context.Context, provider *client.NumSpotSDK, volumeID, st
 			return err // TODO : remove and try to delete volume anyway ?
 		}
 	}
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return err
-	}
-	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, volumeID, numspotClient.DeleteVolumeWithResponse)
+	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, volumeID, withClientID(provider, (*api.ClientWithResponses).DeleteVolumeWithResponse))
 }
 
 func RetryReadVolume(ctx context.Context, provider *client.NumSpotSDK, op string, volumeID string) (*api.Volume, error) {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-	read, err := utils.RetryReadUntilStateValid(ctx, volumeID, provider.SpaceID, volumePendingStates, volumeTargetStates, numspotClient.ReadVolumesByIdWithResponse)
+	read, err := utils.RetryReadUntilStateValid(ctx, volumeID, provider.SpaceID, volumePendingStates, volumeTargetStates, withClientID(provider, (*api.ClientWithResponses).ReadVolumesByIdWithResponse))
 	if err != nil {
 		return nil, err
 	}
@@ -167,7 +154,7 @@ func ReadVolume(ctx context.Context, provider *client.NumSpotSDK, volumeID strin
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadVolume.Body, numSpotReadVolume.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadVolume.Body, numSpotReadVolume.StatusCode(), numSpotReadVolume.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -183,7 +170,7 @@ func ReadVolumeWithParams(ctx context.Context, provider *client.NumSpotSDK, para
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadVolume.Body, numSpotReadVolume.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadVolume.Body, numSpotReadVolume.StatusCode(), numSpotReadVolume.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 	if numSpotReadVolume.JSON200.Items == nil {
@@ -213,7 +200,7 @@ func unlinkVolume(ctx context.Context, provider *client.NumSpotSDK, volumeID, st
 		if unlinkVolumeResponse, err = numSpotClient.UnlinkVolumeWithResponse(ctx, provider.SpaceID, volumeID, api.UnlinkVolumeJSONRequestBody{}); err != nil {
 			return err
 		}
-		if err = utils.ParseHTTPError(unlinkVolumeResponse.Body, unlinkVolumeResponse.StatusCode()); err != nil {
+		if err = utils.ParseHTTPError(unlinkVolumeResponse.Body, unlinkVolumeResponse.StatusCode(), unlinkVolumeResponse.HTTPResponse.Header); err != nil {
 			return err
 		}
 
@@ -232,18 +219,14 @@ func linkVolume(ctx context.Context, provider *client.NumSpotSDK, op, volumeID,
 		VmId:       vmID,
 	}
 
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return err
-	}
-	if _, err = utils.RetryUntilResourceAvailableWithBody(ctx, spaceID, volumeID, linkBody, numspotClient.LinkVolumeWithResponse); err != nil {
+	if _, err = utils.RetryUntilResourceAvailableWithBody(ctx, spaceID, volumeID, linkBody, withClientIDBody(provider, (*api.ClientWithResponses).LinkVolumeWithResponse)); err != nil {
 		return err
 	}
 
 	createStateConf := &retry.StateChangeConf{
 		Pending: []string{attaching},
 		Target:  []string{attached},
-		Timeout: utils.TfRequestRetryTimeout,
+		Timeout: utils.RetryTimeout(ctx, utils.TfRequestRetryTimeout),
 		Delay:   utils.ParseRetryBackoff(),
 		Refresh: func() (interface{}, string, error) {
 			var volume *api.Volume
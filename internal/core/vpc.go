@@ -17,25 +17,25 @@ var (
 func CreateVPC(ctx context.Context, provider *client.NumSpotSDK, numSpotCreateVPC api.CreateVpcJSONRequestBody, dhcpOptionsSetID string, tags []api.ResourceTag) (*api.Vpc, error) {
 	spaceID := provider.SpaceID
 
-	numspotClient, err := provider.GetClient(ctx)
+	retryCreate, err := utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, numSpotCreateVPC, withClientBody(provider, (*api.ClientWithResponses).CreateVpcWithResponse))
 	if err != nil {
 		return nil, err
 	}
 
-	var retryCreate *api.CreateVpcResponse
-	if retryCreate, err = utils.RetryCreateUntilResourceAvailableWithBody(ctx, spaceID, numSpotCreateVPC, numspotClient.CreateVpcWithResponse); err != nil {
-		return nil, err
-	}
-
 	vpcID := *retryCreate.JSON201.Id
 
 	if dhcpOptionsSetID != "" {
+		numspotClient, err := provider.GetClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
 		var numSpotUpdateVPC *api.UpdateVpcResponse
 		numSpotUpdateVPC, err = numspotClient.UpdateVpcWithResponse(ctx, spaceID, vpcID, api.UpdateVpcJSONRequestBody{DhcpOptionsSetId: dhcpOptionsSetID})
 		if err != nil {
 			return nil, err
 		}
-		if err = utils.ParseHTTPError(numSpotUpdateVPC.Body, numSpotUpdateVPC.StatusCode()); err != nil {
+		if err = utils.ParseHTTPError(numSpotUpdateVPC.Body, numSpotUpdateVPC.StatusCode(), numSpotUpdateVPC.HTTPResponse.Header); err != nil {
 			return nil, err
 		}
 	}
@@ -59,7 +59,7 @@ func ReadVPC(ctx context.Context, provider *client.NumSpotSDK, vpcID string) (*a
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadVPC.Body, numSpotReadVPC.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadVPC.Body, numSpotReadVPC.StatusCode(), numSpotReadVPC.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 	return numSpotReadVPC.JSON200, nil
@@ -74,18 +74,14 @@ func ReadVPCsWithParams(ctx context.Context, provider *client.NumSpotSDK, params
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadVPC.Body, numSpotReadVPC.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadVPC.Body, numSpotReadVPC.StatusCode(), numSpotReadVPC.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 	return numSpotReadVPC.JSON200.Items, nil
 }
 
 func RetryReadVPC(ctx context.Context, provider *client.NumSpotSDK, _ string, vpcID string) (*api.Vpc, error) {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-	read, err := utils.RetryReadUntilStateValid(ctx, vpcID, provider.SpaceID, vpcPendingStates, vpcTargetStates, numspotClient.ReadVpcsByIdWithResponse)
+	read, err := utils.RetryReadUntilStateValid(ctx, vpcID, provider.SpaceID, vpcPendingStates, vpcTargetStates, withClientID(provider, (*api.ClientWithResponses).ReadVpcsByIdWithResponse))
 	if err != nil {
 		return nil, err
 	}
@@ -104,9 +100,5 @@ func UpdateVPCTags(ctx context.Context, provider *client.NumSpotSDK, volumeID st
 }
 
 func DeleteVPC(ctx context.Context, provider *client.NumSpotSDK, vpcID string) error {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return err
-	}
-	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, vpcID, numspotClient.DeleteVpcWithResponse)
+	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, vpcID, withClientID(provider, (*api.ClientWithResponses).DeleteVpcWithResponse))
 }
@@ -2,7 +2,6 @@ package core
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"slices"
 	"time"
@@ -32,7 +31,8 @@ func retryCreateVpnCondition(
 	if err != nil {
 		return nil, err
 	}
-	retryError := retry.RetryContext(ctx, utils.TfRequestRetryTimeout, func() *retry.RetryError {
+	timeout := utils.RetryTimeout(ctx, utils.TfRequestRetryTimeout)
+	retryError := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		var err error
 		res, err = numspotClient.CreateVPNConnectionWithResponse(ctx, spaceID, body)
 		if err != nil {
@@ -44,22 +44,17 @@ func retryCreateVpnCondition(
 			time.Sleep(5 * time.Second)
 			_, err := RetryReadVpnConnection(ctx, provider, (*res.JSON201).Id.String())
 			if err != nil {
-				return retry.RetryableError(fmt.Errorf("error : retry timeout reached (%v). Error message : %v", utils.TfRequestRetryTimeout, err))
+				return retry.RetryableError(fmt.Errorf("error : retry timeout reached (%v). Error message : %v", timeout, err))
 			} else {
 				return nil
 			}
 		} else {
-			errorMessage, err := utils.GetErrorMessage(res)
+			apiError, err := utils.GetAPIError(res)
 			if err != nil {
 				return retry.NonRetryableError(fmt.Errorf("error : got http status code %v but failed to parse error message. Reason : %v", res.StatusCode(), err))
 			}
 
-			if slices.Contains(utils.StatusCodeRetryOnCreate, res.StatusCode()) {
-				time.Sleep(utils.ParseRetryBackoff())
-				return retry.RetryableError(fmt.Errorf("error : retry timeout reached (%v). Error message : %v", utils.TfRequestRetryTimeout, errorMessage))
-			} else {
-				return retry.NonRetryableError(errors.New(errorMessage))
-			}
+			return utils.RetryAPIError(apiError, utils.StatusCodeRetryOnCreate, timeout)
 		}
 	})
 
@@ -106,12 +101,7 @@ func UpdateVpnConnectionRoutes(ctx context.Context, provider *client.NumSpotSDK,
 }
 
 func DeleteVpnConnection(ctx context.Context, provider *client.NumSpotSDK, vpnConnectionID api.ResourceIdentifier) error {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return err
-	}
-
-	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, vpnConnectionID, numspotClient.DeleteVPNConnectionWithResponse)
+	return utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, vpnConnectionID, withClientID(provider, (*api.ClientWithResponses).DeleteVPNConnectionWithResponse))
 }
 
 func ReadVpnConnection(ctx context.Context, provider *client.NumSpotSDK, vpnConnectionID api.ResourceIdentifier) (*api.VPNConnection, error) {
@@ -124,7 +114,7 @@ func ReadVpnConnection(ctx context.Context, provider *client.NumSpotSDK, vpnConn
 		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(numSpotVpnConnection.Body, numSpotVpnConnection.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotVpnConnection.Body, numSpotVpnConnection.StatusCode(), numSpotVpnConnection.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 
@@ -132,11 +122,7 @@ func ReadVpnConnection(ctx context.Context, provider *client.NumSpotSDK, vpnConn
 }
 
 func RetryReadVpnConnection(ctx context.Context, provider *client.NumSpotSDK, vpnConnectionID string) (*api.VPNConnection, error) {
-	numspotClient, err := provider.GetClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-	read, err := utils.RetryReadUntilStateValid(ctx, uuid.MustParse(vpnConnectionID), provider.SpaceID, vpnConnectionPendingStates, vpnConnectionTargetStates, numspotClient.ReadVPNConnectionWithResponse)
+	read, err := utils.RetryReadUntilStateValid(ctx, uuid.MustParse(vpnConnectionID), provider.SpaceID, vpnConnectionPendingStates, vpnConnectionTargetStates, withClientID(provider, (*api.ClientWithResponses).ReadVPNConnectionWithResponse))
 	if err != nil {
 		return nil, err
 	}
@@ -157,7 +143,7 @@ func ReadVpnConnectionsWithParams(ctx context.Context, provider *client.NumSpotS
 	if err != nil {
 		return nil, err
 	}
-	if err = utils.ParseHTTPError(numSpotReadVpnConnection.Body, numSpotReadVpnConnection.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(numSpotReadVpnConnection.Body, numSpotReadVpnConnection.StatusCode(), numSpotReadVpnConnection.HTTPResponse.Header); err != nil {
 		return nil, err
 	}
 	if numSpotReadVpnConnection.JSON200.Items == nil {
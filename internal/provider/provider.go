@@ -4,36 +4,62 @@ import (
 	"context"
 	"net/http"
 	"os"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/services/acl"
 	"terraform-provider-numspot/internal/services/bucket"
+	"terraform-provider-numspot/internal/services/bucketcorsconfiguration"
+	"terraform-provider-numspot/internal/services/bucketlifecycleconfiguration"
+	"terraform-provider-numspot/internal/services/bucketobject"
+	"terraform-provider-numspot/internal/services/bucketpolicy"
+	"terraform-provider-numspot/internal/services/bucketversioning"
 	"terraform-provider-numspot/internal/services/clientgateway"
 	"terraform-provider-numspot/internal/services/computebridge"
 	"terraform-provider-numspot/internal/services/dhcpoptions"
+	"terraform-provider-numspot/internal/services/directlink"
+	"terraform-provider-numspot/internal/services/directlinkinterface"
 	"terraform-provider-numspot/internal/services/flexiblegpu"
 	"terraform-provider-numspot/internal/services/hybridbridge"
+	"terraform-provider-numspot/internal/services/iampolicy"
+	"terraform-provider-numspot/internal/services/iamrole"
 	"terraform-provider-numspot/internal/services/image"
 	"terraform-provider-numspot/internal/services/internetgateway"
 	"terraform-provider-numspot/internal/services/keypair"
 	"terraform-provider-numspot/internal/services/kubernetes_cluster"
+	"terraform-provider-numspot/internal/services/kubernetes_kubeconfig"
 	"terraform-provider-numspot/internal/services/kubernetes_nodepool"
+	"terraform-provider-numspot/internal/services/kubernetes_versions"
 	"terraform-provider-numspot/internal/services/loadbalancer"
+	"terraform-provider-numspot/internal/services/loadbalancerbackendhealth"
+	"terraform-provider-numspot/internal/services/loadbalancerlistener"
+	"terraform-provider-numspot/internal/services/loadbalancerlistenerrule"
+	"terraform-provider-numspot/internal/services/loadbalancerpolicy"
+	"terraform-provider-numspot/internal/services/location"
 	"terraform-provider-numspot/internal/services/natgateway"
 	"terraform-provider-numspot/internal/services/nic"
+	"terraform-provider-numspot/internal/services/permission"
 	"terraform-provider-numspot/internal/services/postgres_cluster"
+	"terraform-provider-numspot/internal/services/postgres_cluster_credentials"
 	"terraform-provider-numspot/internal/services/publicip"
 	"terraform-provider-numspot/internal/services/routetable"
 	"terraform-provider-numspot/internal/services/securitygroup"
 	"terraform-provider-numspot/internal/services/securitygrouprule"
 	"terraform-provider-numspot/internal/services/servercertificate"
+	"terraform-provider-numspot/internal/services/serviceaccount"
+	"terraform-provider-numspot/internal/services/serviceaccountspaceassignment"
 	"terraform-provider-numspot/internal/services/snapshot"
+	"terraform-provider-numspot/internal/services/space"
+	"terraform-provider-numspot/internal/services/spaceuser"
 	"terraform-provider-numspot/internal/services/subnet"
+	"terraform-provider-numspot/internal/services/user"
 	"terraform-provider-numspot/internal/services/virtualgateway"
 	"terraform-provider-numspot/internal/services/vm"
 	"terraform-provider-numspot/internal/services/volume"
@@ -42,14 +68,21 @@ import (
 )
 
 type NumspotProviderModel struct {
-	NumSpotHost   types.String `tfsdk:"numspot_host"`
-	ClientId      types.String `tfsdk:"client_id"`
-	ClientSecret  types.String `tfsdk:"client_secret"`
-	SpaceId       types.String `tfsdk:"space_id"`
-	NumSpotHostOs types.String `tfsdk:"numspot_host_os"`
+	NumSpotHost       types.String `tfsdk:"numspot_host"`
+	ClientId          types.String `tfsdk:"client_id"`
+	ClientSecret      types.String `tfsdk:"client_secret"`
+	SpaceId           types.String `tfsdk:"space_id"`
+	NumSpotHostOs     types.String `tfsdk:"numspot_host_os"`
+	Insecure          types.Bool   `tfsdk:"insecure"`
+	CABundle          types.String `tfsdk:"ca_bundle"`
+	ClientCertificate types.String `tfsdk:"client_certificate"`
+	ClientKey         types.String `tfsdk:"client_key"`
 }
 
-var _ provider.Provider = (*numspotProvider)(nil)
+var (
+	_ provider.Provider                       = (*numspotProvider)(nil)
+	_ provider.ProviderWithEphemeralResources = (*numspotProvider)(nil)
+)
 
 type numspotProvider struct {
 	version    string
@@ -91,6 +124,22 @@ func (p *numspotProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				MarkdownDescription: "Numspot API Host of Object Storage.",
 				Optional:            true,
 			},
+			"insecure": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of the API and Object Storage server certificates. Defaults to `true`, unless `ca_bundle` or `client_certificate` is set. Set to `false` to enable TLS verification.",
+				Optional:            true,
+			},
+			"ca_bundle": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM encoded CA bundle used, in addition to the system roots, to verify the API and Object Storage server certificates.",
+				Optional:            true,
+			},
+			"client_certificate": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM encoded client certificate presented to the API and Object Storage servers (mutual TLS). Requires `client_key`.",
+				Optional:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "Path to the PEM encoded private key of `client_certificate`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -154,6 +203,42 @@ func (p *numspotProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if config.Insecure.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("insecure"),
+			"Unknown Numspot TLS verification setting",
+			"The provider cannot create the Numspot API provider as there is an unknown configuration value for insecure. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the NUMSPOT_INSECURE environment variable.",
+		)
+	}
+
+	if config.CABundle.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_bundle"),
+			"Unknown Numspot CA bundle",
+			"The provider cannot create the Numspot API provider as there is an unknown configuration value for the CA bundle. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the NUMSPOT_CA_BUNDLE environment variable.",
+		)
+	}
+
+	if config.ClientCertificate.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_certificate"),
+			"Unknown Numspot client certificate",
+			"The provider cannot create the Numspot API provider as there is an unknown configuration value for the client certificate. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the NUMSPOT_CLIENT_CERTIFICATE environment variable.",
+		)
+	}
+
+	if config.ClientKey.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_key"),
+			"Unknown Numspot client key",
+			"The provider cannot create the Numspot API provider as there is an unknown configuration value for the client key. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the NUMSPOT_CLIENT_KEY environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -165,6 +250,25 @@ func (p *numspotProvider) Configure(ctx context.Context, req provider.ConfigureR
 	clientSecret := os.Getenv("NUMSPOT_CLIENT_SECRET")
 	spaceId := os.Getenv("NUMSPOT_SPACE_ID")
 	numSpotHostOs := os.Getenv("NUMSPOT_HOST_OS")
+	caBundle := os.Getenv("NUMSPOT_CA_BUNDLE")
+	clientCertificate := os.Getenv("NUMSPOT_CLIENT_CERTIFICATE")
+	clientKey := os.Getenv("NUMSPOT_CLIENT_KEY")
+
+	// TLS verification stays disabled by default to preserve the historical behaviour, the SDK enables it when a CA
+	// bundle or a client certificate is set and insecure is left unset
+	var insecure *bool
+	if insecureEnv := os.Getenv("NUMSPOT_INSECURE"); insecureEnv != "" {
+		insecureValue, err := strconv.ParseBool(insecureEnv)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("insecure"),
+				"Invalid Numspot TLS verification setting",
+				"The provider cannot create the Numspot API provider as the NUMSPOT_INSECURE environment variable is not a valid boolean: "+err.Error(),
+			)
+			return
+		}
+		insecure = &insecureValue
+	}
 
 	if !config.NumSpotHost.IsNull() {
 		numSpotHost = config.NumSpotHost.ValueString()
@@ -186,6 +290,22 @@ func (p *numspotProvider) Configure(ctx context.Context, req provider.ConfigureR
 		numSpotHostOs = config.NumSpotHostOs.ValueString()
 	}
 
+	if !config.Insecure.IsNull() {
+		insecure = config.Insecure.ValueBoolPointer()
+	}
+
+	if !config.CABundle.IsNull() {
+		caBundle = config.CABundle.ValueString()
+	}
+
+	if !config.ClientCertificate.IsNull() {
+		clientCertificate = config.ClientCertificate.ValueString()
+	}
+
+	if !config.ClientKey.IsNull() {
+		clientKey = config.ClientKey.ValueString()
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 	if numSpotHost == "" {
@@ -238,6 +358,24 @@ func (p *numspotProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if (clientCertificate == "") != (clientKey == "") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_certificate"),
+			"Incomplete Numspot client certificate",
+			"The provider cannot create the Numspot API provider as client_certificate and client_key must be set together. "+
+				"Set both values in the configuration or use the NUMSPOT_CLIENT_CERTIFICATE and NUMSPOT_CLIENT_KEY environment variables.",
+		)
+	}
+
+	if insecure != nil && *insecure && caBundle != "" {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("ca_bundle"),
+			"Numspot CA bundle not used",
+			"The server certificates are not verified as insecure is set to true, so the CA bundle is ignored. "+
+				"Set insecure to false or leave it unset to verify them with the CA bundle.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -256,6 +394,33 @@ func (p *numspotProvider) Configure(ctx context.Context, req provider.ConfigureR
 		client.WithHostOs(config.NumSpotHostOs.ValueString()),
 	}
 
+	if insecure != nil {
+		options = append(options, client.WithInsecureSkipVerify(*insecure))
+	}
+
+	if caBundle != "" {
+		caBundlePEM, err := os.ReadFile(caBundle)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("ca_bundle"), "Unable to read Numspot CA bundle", err.Error())
+			return
+		}
+		options = append(options, client.WithCABundle(caBundlePEM))
+	}
+
+	if clientCertificate != "" {
+		clientCertificatePEM, err := os.ReadFile(clientCertificate)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("client_certificate"), "Unable to read Numspot client certificate", err.Error())
+			return
+		}
+		clientKeyPEM, err := os.ReadFile(clientKey)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("client_key"), "Unable to read Numspot client key", err.Error())
+			return
+		}
+		options = append(options, client.WithClientCertificate(clientCertificatePEM, clientKeyPEM))
+	}
+
 	if p.httpClient != nil {
 		options = append(options, client.WithHTTPClient(p.httpClient))
 	}
@@ -268,6 +433,7 @@ func (p *numspotProvider) Configure(ctx context.Context, req provider.ConfigureR
 
 	resp.DataSourceData = numSpotSDK
 	resp.ResourceData = numSpotSDK
+	resp.EphemeralResourceData = numSpotSDK
 }
 
 func (p *numspotProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -278,6 +444,8 @@ func (p *numspotProvider) Metadata(_ context.Context, _ provider.MetadataRequest
 func (p *numspotProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		loadbalancer.NewLoadBalancersDataSource,
+		loadbalancerbackendhealth.NewLoadBalancerBackendHealthDataSource,
+		loadbalancerlistenerrule.NewLoadBalancerListenerRulesDataSource,
 		dhcpoptions.NewDHCPOptionsDataSource,
 		volume.NewVolumesDataSource,
 		vpc.NewVPCsDataSource,
@@ -293,15 +461,33 @@ func (p *numspotProvider) DataSources(_ context.Context) []func() datasource.Dat
 		vm.NewVmsDataSource,
 		flexiblegpu.NewFlexibleGpusDataSource,
 		bucket.NewBucketsDataSource,
+		bucketobject.NewBucketObjectDataSource,
 		servercertificate.NewServerCertificateDataSource,
 		clientgateway.NewClientGatewaysDataSource,
 		virtualgateway.NewVirtualGatewaysDataSource,
 		vpnconnection.NewVpnConnectionsDataSource,
+		directlink.NewDirectLinksDataSource,
+		directlinkinterface.NewDirectLinkInterfacesDataSource,
+		location.NewLocationsDataSource,
 		computebridge.NewComputeBridgeDataSource,
 		hybridbridge.NewHybridBridgeDataSource,
 		kubernetes_cluster.NewKubernetesClusterDataSource,
+		kubernetes_kubeconfig.NewKubernetesKubeconfigDataSource,
 		kubernetes_nodepool.NewKubernetesNodepoolDataSource,
+		kubernetes_versions.NewKubernetesVersionsDataSource,
 		postgres_cluster.NewPostgresClusterDataSource,
+		postgres_cluster_credentials.NewPostgresClusterCredentialsDataSource,
+		space.NewSpacesDataSource,
+		iamrole.NewRolesDataSource,
+		permission.NewPermissionsDataSource,
+		user.NewUserDataSource,
+	}
+}
+
+func (p *numspotProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		kubernetes_kubeconfig.NewKubernetesKubeconfigEphemeralResource,
+		postgres_cluster_credentials.NewPostgresClusterCredentialsEphemeralResource,
 	}
 }
 
@@ -311,6 +497,9 @@ func (p *numspotProvider) Resources(_ context.Context) []func() resource.Resourc
 		image.NewImageResource,
 		internetgateway.NewInternetGatewayResource,
 		loadbalancer.NewLoadBalancerResource,
+		loadbalancerlistener.NewLoadBalancerListenerResource,
+		loadbalancerlistenerrule.NewLoadBalancerListenerRuleResource,
+		loadbalancerpolicy.NewLoadBalancerPolicyResource,
 		natgateway.NewNatGatewayResource,
 		vpc.NewVPCResource,
 		nic.NewNicResource,
@@ -326,13 +515,29 @@ func (p *numspotProvider) Resources(_ context.Context) []func() resource.Resourc
 		dhcpoptions.NewDhcpOptionsResource,
 		servercertificate.NewServerCertificateResource,
 		bucket.NewBucketResource,
+		bucketversioning.NewBucketVersioningResource,
+		bucketlifecycleconfiguration.NewBucketLifecycleConfigurationResource,
+		bucketcorsconfiguration.NewBucketCorsConfigurationResource,
+		bucketpolicy.NewBucketPolicyResource,
+		bucketobject.NewBucketObjectResource,
 		clientgateway.NewClientGatewayResource,
 		virtualgateway.NewVirtualGatewayResource,
 		vpnconnection.NewVpnConnectionResource,
+		directlink.NewDirectLinkResource,
+		directlinkinterface.NewDirectLinkInterfaceResource,
 		computebridge.NewComputeBridgeResource,
 		hybridbridge.NewHybridBridgeResource,
 		kubernetes_cluster.NewKubernetesClusterResource,
 		kubernetes_nodepool.NewKubernetesNodepoolResource,
 		postgres_cluster.NewPostgresClusterResource,
+		space.NewSpaceResource,
+		serviceaccount.NewServiceAccountResource,
+		serviceaccountspaceassignment.NewServiceAccountSpaceAssignmentResource,
+		iamrole.NewIAMRoleResource,
+		iampolicy.NewIAMPolicyResource,
+		iampolicy.NewIAMPolicyBindingResource,
+		acl.NewACLResource,
+		user.NewUserResource,
+		spaceuser.NewSpaceUserResource,
 	}
 }
@@ -4,7 +4,9 @@
 package objectstorage
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	"io"
@@ -21,6 +23,53 @@ const (
 	AwsSigV4Scopes = "AwsSigV4.Scopes"
 )
 
+// Defines values for BucketVersioningStatus.
+const (
+	BucketVersioningStatusEnabled   BucketVersioningStatus = "Enabled"
+	BucketVersioningStatusSuspended BucketVersioningStatus = "Suspended"
+)
+
+// Defines values for ChecksumAlgorithm.
+const (
+	CRC32  ChecksumAlgorithm = "CRC32"
+	CRC32C ChecksumAlgorithm = "CRC32C"
+	SHA1   ChecksumAlgorithm = "SHA1"
+	SHA256 ChecksumAlgorithm = "SHA256"
+)
+
+// Defines values for EncodingType.
+const (
+	Url EncodingType = "url"
+)
+
+// Defines values for ExpirationStatus.
+const (
+	ExpirationStatusDisabled ExpirationStatus = "Disabled"
+	ExpirationStatusEnabled  ExpirationStatus = "Enabled"
+)
+
+// Defines values for ObjectStorageClass.
+const (
+	DEEPARCHIVE        ObjectStorageClass = "DEEP_ARCHIVE"
+	GLACIER            ObjectStorageClass = "GLACIER"
+	GLACIERIR          ObjectStorageClass = "GLACIER_IR"
+	INTELLIGENTTIERING ObjectStorageClass = "INTELLIGENT_TIERING"
+	ONEZONEIA          ObjectStorageClass = "ONEZONE_IA"
+	OUTPOSTS           ObjectStorageClass = "OUTPOSTS"
+	REDUCEDREDUNDANCY  ObjectStorageClass = "REDUCED_REDUNDANCY"
+	SNOW               ObjectStorageClass = "SNOW"
+	STANDARD           ObjectStorageClass = "STANDARD"
+	STANDARDIA         ObjectStorageClass = "STANDARD_IA"
+)
+
+// AbortIncompleteMultipartUpload defines model for AbortIncompleteMultipartUpload.
+type AbortIncompleteMultipartUpload struct {
+	DaysAfterInitiation *int `json:"daysAfterInitiation,omitempty" xml:"DaysAfterInitiation,omitempty"`
+}
+
+// Body defines model for Body.
+type Body = openapi_types.File
+
 // Bucket defines model for Bucket.
 type Bucket struct {
 	CreationDate *CreationDate `json:"creationDate,omitempty"`
@@ -36,26 +85,218 @@ type BucketAlreadyOwnedByYou = interface{}
 // BucketName defines model for BucketName.
 type BucketName = string
 
+// BucketPolicy defines model for BucketPolicy.
+type BucketPolicy map[string]interface{}
+
+// BucketVersioningStatus defines model for BucketVersioningStatus.
+type BucketVersioningStatus string
+
 // Buckets defines model for Buckets.
 type Buckets = []struct {
 	CreationDate *CreationDate `json:"creationDate,omitempty"`
 	Name         *BucketName   `json:"name,omitempty"`
 }
 
+// CORSConfiguration defines model for CORSConfiguration.
+type CORSConfiguration struct {
+	CorsRules []CORSRule `json:"corsRules" xml:"CORSRule"`
+}
+
+// CORSRule defines model for CORSRule.
+type CORSRule struct {
+	AllowedHeaders *[]string `json:"allowedHeaders,omitempty" xml:"AllowedHeader,omitempty"`
+	AllowedMethods []string  `json:"allowedMethods" xml:"AllowedMethod"`
+	AllowedOrigins []string  `json:"allowedOrigins" xml:"AllowedOrigin"`
+	ExposeHeaders  *[]string `json:"exposeHeaders,omitempty" xml:"ExposeHeader,omitempty"`
+	Id             *string   `json:"id,omitempty" xml:"ID,omitempty"`
+	MaxAgeSeconds  *int      `json:"maxAgeSeconds,omitempty" xml:"MaxAgeSeconds,omitempty"`
+}
+
+// ChecksumAlgorithm defines model for ChecksumAlgorithm.
+type ChecksumAlgorithm string
+
+// ChecksumAlgorithmList defines model for ChecksumAlgorithmList.
+type ChecksumAlgorithmList = []ChecksumAlgorithm
+
+// CommonPrefix defines model for CommonPrefix.
+type CommonPrefix struct {
+	Prefix *Prefix `json:"prefix,omitempty"`
+}
+
+// CommonPrefixList defines model for CommonPrefixList.
+type CommonPrefixList = []CommonPrefix
+
 // CreateBucketOutput defines model for CreateBucketOutput.
 type CreateBucketOutput = map[string]interface{}
 
 // CreationDate defines model for CreationDate.
 type CreationDate = time.Time
 
+// Delimiter defines model for Delimiter.
+type Delimiter = string
+
+// ETag defines model for ETag.
+type ETag = string
+
+// EncodingType defines model for EncodingType.
+type EncodingType string
+
+// ExpirationStatus defines model for ExpirationStatus.
+type ExpirationStatus string
+
+// IsRestoreInProgress defines model for IsRestoreInProgress.
+type IsRestoreInProgress = bool
+
+// IsTruncated defines model for IsTruncated.
+type IsTruncated = bool
+
+// LastModified defines model for LastModified.
+type LastModified = time.Time
+
+// LifecycleConfiguration defines model for LifecycleConfiguration.
+type LifecycleConfiguration struct {
+	Rules []LifecycleRule `json:"rules" xml:"Rule"`
+}
+
+// LifecycleExpiration defines model for LifecycleExpiration.
+type LifecycleExpiration struct {
+	Date                      *string `json:"date,omitempty" xml:"Date,omitempty"`
+	Days                      *int    `json:"days,omitempty" xml:"Days,omitempty"`
+	ExpiredObjectDeleteMarker *bool   `json:"expiredObjectDeleteMarker,omitempty" xml:"ExpiredObjectDeleteMarker,omitempty"`
+}
+
+// LifecycleRule defines model for LifecycleRule.
+type LifecycleRule struct {
+	AbortIncompleteMultipartUpload *AbortIncompleteMultipartUpload `json:"abortIncompleteMultipartUpload,omitempty" xml:"AbortIncompleteMultipartUpload,omitempty"`
+	Expiration                     *LifecycleExpiration            `json:"expiration,omitempty" xml:"Expiration,omitempty"`
+	Filter                         *LifecycleRuleFilter            `json:"filter,omitempty" xml:"Filter"`
+	Id                             *string                         `json:"id,omitempty" xml:"ID,omitempty"`
+	NoncurrentVersionExpiration    *NoncurrentVersionExpiration    `json:"noncurrentVersionExpiration,omitempty" xml:"NoncurrentVersionExpiration,omitempty"`
+	Status                         ExpirationStatus                `json:"status" xml:"Status"`
+}
+
+// LifecycleRuleFilter defines model for LifecycleRuleFilter.
+type LifecycleRuleFilter struct {
+	Prefix *Prefix `json:"prefix,omitempty" xml:"Prefix"`
+}
+
 // ListBucketsOutput defines model for ListBucketsOutput.
 type ListBucketsOutput struct {
 	Buckets *Buckets `json:"buckets,omitempty"`
 }
 
+// ListObjectsOutput defines model for ListObjectsOutput.
+type ListObjectsOutput struct {
+	CommonPrefixes *CommonPrefixList `json:"commonPrefixes,omitempty"`
+	Contents       *ObjectList       `json:"contents,omitempty"`
+	Delimiter      *Delimiter        `json:"delimiter,omitempty"`
+	EncodingType   *EncodingType     `json:"encodingType,omitempty"`
+	IsTruncated    *IsTruncated      `json:"isTruncated,omitempty"`
+	Marker         *Marker           `json:"marker,omitempty"`
+	MaxKeys        *MaxKeys          `json:"maxKeys,omitempty"`
+	Name           *BucketName       `json:"name,omitempty"`
+	NextMarker     *NextMarker       `json:"nextMarker,omitempty"`
+	Prefix         *Prefix           `json:"prefix,omitempty"`
+}
+
+// Marker defines model for Marker.
+type Marker = string
+
+// MaxKeys defines model for MaxKeys.
+type MaxKeys = int
+
+// NextMarker defines model for NextMarker.
+type NextMarker = string
+
+// NoSuchBucket defines model for NoSuchBucket.
+type NoSuchBucket = interface{}
+
+// NoSuchBucketPolicy defines model for NoSuchBucketPolicy.
+type NoSuchBucketPolicy = interface{}
+
+// NoSuchCORSConfiguration defines model for NoSuchCORSConfiguration.
+type NoSuchCORSConfiguration = interface{}
+
+// NoSuchKey defines model for NoSuchKey.
+type NoSuchKey = interface{}
+
+// NoSuchLifecycleConfiguration defines model for NoSuchLifecycleConfiguration.
+type NoSuchLifecycleConfiguration = interface{}
+
+// NoSuchTagSet defines model for NoSuchTagSet.
+type NoSuchTagSet = interface{}
+
+// NoncurrentVersionExpiration defines model for NoncurrentVersionExpiration.
+type NoncurrentVersionExpiration struct {
+	NoncurrentDays *int `json:"noncurrentDays,omitempty" xml:"NoncurrentDays,omitempty"`
+}
+
+// Object defines model for Object.
+type Object struct {
+	ChecksumAlgorithm *ChecksumAlgorithmList `json:"checksumAlgorithm,omitempty"`
+	ETag              *ETag                  `json:"eTag,omitempty"`
+	Key               *ObjectKey             `json:"key,omitempty"`
+	LastModified      *LastModified          `json:"lastModified,omitempty"`
+	RestoreStatus     *RestoreStatus         `json:"restoreStatus,omitempty"`
+	Size              *Size                  `json:"size,omitempty"`
+	StorageClass      *ObjectStorageClass    `json:"storageClass,omitempty"`
+}
+
+// ObjectKey defines model for ObjectKey.
+type ObjectKey = string
+
+// ObjectList defines model for ObjectList.
+type ObjectList = []Object
+
+// ObjectStorageClass defines model for ObjectStorageClass.
+type ObjectStorageClass string
+
+// Prefix defines model for Prefix.
+type Prefix = string
+
+// RestoreExpiryDate defines model for RestoreExpiryDate.
+type RestoreExpiryDate = time.Time
+
+// RestoreStatus defines model for RestoreStatus.
+type RestoreStatus struct {
+	IsRestoreInProgress *IsRestoreInProgress `json:"isRestoreInProgress,omitempty"`
+	RestoreExpiryDate   *RestoreExpiryDate   `json:"restoreExpiryDate,omitempty"`
+}
+
+// Size defines model for Size.
+type Size = int
+
+// Tag defines model for Tag.
+type Tag struct {
+	Key   string `json:"key" xml:"Key"`
+	Value string `json:"value" xml:"Value"`
+}
+
+// Tagging defines model for Tagging.
+type Tagging struct {
+	TagSet []Tag `json:"tagSet" xml:"TagSet>Tag"`
+}
+
+// VersioningConfiguration defines model for VersioningConfiguration.
+type VersioningConfiguration struct {
+	Status *BucketVersioningStatus `json:"status,omitempty" xml:"Status,omitempty"`
+}
+
 // SpaceId defines model for SpaceId.
 type SpaceId = openapi_types.UUID
 
+// GetBucketPolicy200Response defines model for GetBucketPolicy200Response.
+type GetBucketPolicy200Response = BucketPolicy
+
+// GetObjectParams defines parameters for GetObject.
+type GetObjectParams struct {
+	// Expires Optional parameter to generate a presigned URL
+	Expires *string `form:"expires,omitempty" json:"expires,omitempty"`
+}
+
+// PutBucketPolicyJSONRequestBody defines body for PutBucketPolicy for application/json ContentType.
+type PutBucketPolicyJSONRequestBody = BucketPolicy
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -135,8 +376,67 @@ type ClientInterface interface {
 	// DeleteBucket request
 	DeleteBucket(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListObjects request
+	ListObjects(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateBucket request
 	CreateBucket(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteObject request
+	DeleteObject(ctx context.Context, spaceId SpaceId, bucket string, key string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetObject request
+	GetObject(ctx context.Context, spaceId SpaceId, bucket string, key string, params *GetObjectParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HeadObject request
+	HeadObject(ctx context.Context, spaceId SpaceId, bucket string, key string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutObjectWithBody request with any body
+	PutObjectWithBody(ctx context.Context, spaceId SpaceId, bucket string, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteBucketCors request
+	DeleteBucketCors(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBucketCors request
+	GetBucketCors(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutBucketCorsWithBody request with any body
+	PutBucketCorsWithBody(ctx context.Context, spaceId SpaceId, bucket string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteBucketLifecycle request
+	DeleteBucketLifecycle(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBucketLifecycleConfiguration request
+	GetBucketLifecycleConfiguration(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutBucketLifecycleConfigurationWithBody request with any body
+	PutBucketLifecycleConfigurationWithBody(ctx context.Context, spaceId SpaceId, bucket string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteBucketPolicy request
+	DeleteBucketPolicy(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBucketPolicy request
+	GetBucketPolicy(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutBucketPolicyWithBody request with any body
+	PutBucketPolicyWithBody(ctx context.Context, spaceId SpaceId, bucket string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutBucketPolicy(ctx context.Context, spaceId SpaceId, bucket string, body PutBucketPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteBucketTagging request
+	DeleteBucketTagging(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBucketTagging request
+	GetBucketTagging(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutBucketTaggingWithBody request with any body
+	PutBucketTaggingWithBody(ctx context.Context, spaceId SpaceId, bucket string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBucketVersioning request
+	GetBucketVersioning(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutBucketVersioningWithBody request with any body
+	PutBucketVersioningWithBody(ctx context.Context, spaceId SpaceId, bucket string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) ListBuckets(ctx context.Context, spaceId SpaceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -163,6 +463,18 @@ func (c *Client) DeleteBucket(ctx context.Context, spaceId SpaceId, bucket strin
 	return c.Client.Do(req)
 }
 
+func (c *Client) ListObjects(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListObjectsRequest(c.Server, spaceId, bucket)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) CreateBucket(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewCreateBucketRequest(c.Server, spaceId, bucket)
 	if err != nil {
@@ -175,302 +487,2425 @@ func (c *Client) CreateBucket(ctx context.Context, spaceId SpaceId, bucket strin
 	return c.Client.Do(req)
 }
 
-// NewListBucketsRequest generates requests for ListBuckets
-func NewListBucketsRequest(server string, spaceId SpaceId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+func (c *Client) DeleteObject(ctx context.Context, spaceId SpaceId, bucket string, key string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteObjectRequest(c.Server, spaceId, bucket, key)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetObject(ctx context.Context, spaceId SpaceId, bucket string, key string, params *GetObjectParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetObjectRequest(c.Server, spaceId, bucket, key, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/spaces/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) HeadObject(ctx context.Context, spaceId SpaceId, bucket string, key string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHeadObjectRequest(c.Server, spaceId, bucket, key)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PutObjectWithBody(ctx context.Context, spaceId SpaceId, bucket string, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutObjectRequestWithBody(c.Server, spaceId, bucket, key, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteBucketRequest generates requests for DeleteBucket
-func NewDeleteBucketRequest(server string, spaceId SpaceId, bucket string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+func (c *Client) DeleteBucketCors(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBucketCorsRequest(c.Server, spaceId, bucket)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+func (c *Client) GetBucketCors(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBucketCorsRequest(c.Server, spaceId, bucket)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PutBucketCorsWithBody(ctx context.Context, spaceId SpaceId, bucket string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutBucketCorsRequestWithBody(c.Server, spaceId, bucket, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/spaces/%s/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteBucketLifecycle(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBucketLifecycleRequest(c.Server, spaceId, bucket)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) GetBucketLifecycleConfiguration(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBucketLifecycleConfigurationRequest(c.Server, spaceId, bucket)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateBucketRequest generates requests for CreateBucket
-func NewCreateBucketRequest(server string, spaceId SpaceId, bucket string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+func (c *Client) PutBucketLifecycleConfigurationWithBody(ctx context.Context, spaceId SpaceId, bucket string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutBucketLifecycleConfigurationRequestWithBody(c.Server, spaceId, bucket, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+func (c *Client) DeleteBucketPolicy(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBucketPolicyRequest(c.Server, spaceId, bucket)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetBucketPolicy(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBucketPolicyRequest(c.Server, spaceId, bucket)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/spaces/%s/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutBucketPolicyWithBody(ctx context.Context, spaceId SpaceId, bucket string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutBucketPolicyRequestWithBody(c.Server, spaceId, bucket, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+func (c *Client) PutBucketPolicy(ctx context.Context, spaceId SpaceId, bucket string, body PutBucketPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutBucketPolicyRequest(c.Server, spaceId, bucket, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) DeleteBucketTagging(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBucketTaggingRequest(c.Server, spaceId, bucket)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
+func (c *Client) GetBucketTagging(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBucketTaggingRequest(c.Server, spaceId, bucket)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutBucketTaggingWithBody(ctx context.Context, spaceId SpaceId, bucket string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutBucketTaggingRequestWithBody(c.Server, spaceId, bucket, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBucketVersioning(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBucketVersioningRequest(c.Server, spaceId, bucket)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutBucketVersioningWithBody(ctx context.Context, spaceId SpaceId, bucket string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutBucketVersioningRequestWithBody(c.Server, spaceId, bucket, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListBucketsRequest generates requests for ListBuckets
+func NewListBucketsRequest(server string, spaceId SpaceId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteBucketRequest generates requests for DeleteBucket
+func NewDeleteBucketRequest(server string, spaceId SpaceId, bucket string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListObjectsRequest generates requests for ListObjects
+func NewListObjectsRequest(server string, spaceId SpaceId, bucket string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateBucketRequest generates requests for CreateBucket
+func NewCreateBucketRequest(server string, spaceId SpaceId, bucket string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteObjectRequest generates requests for DeleteObject
+func NewDeleteObjectRequest(server string, spaceId SpaceId, bucket string, key string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/%s/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetObjectRequest generates requests for GetObject
+func NewGetObjectRequest(server string, spaceId SpaceId, bucket string, key string, params *GetObjectParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/%s/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Expires != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expires", runtime.ParamLocationQuery, *params.Expires); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHeadObjectRequest generates requests for HeadObject
+func NewHeadObjectRequest(server string, spaceId SpaceId, bucket string, key string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/%s/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("HEAD", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutObjectRequestWithBody generates requests for PutObject with any type of body
+func NewPutObjectRequestWithBody(server string, spaceId SpaceId, bucket string, key string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/%s/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteBucketCorsRequest generates requests for DeleteBucketCors
+func NewDeleteBucketCorsRequest(server string, spaceId SpaceId, bucket string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/%s?cors", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBucketCorsRequest generates requests for GetBucketCors
+func NewGetBucketCorsRequest(server string, spaceId SpaceId, bucket string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/%s?cors", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutBucketCorsRequestWithBody generates requests for PutBucketCors with any type of body
+func NewPutBucketCorsRequestWithBody(server string, spaceId SpaceId, bucket string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/%s?cors", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteBucketLifecycleRequest generates requests for DeleteBucketLifecycle
+func NewDeleteBucketLifecycleRequest(server string, spaceId SpaceId, bucket string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/%s?lifecycle", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBucketLifecycleConfigurationRequest generates requests for GetBucketLifecycleConfiguration
+func NewGetBucketLifecycleConfigurationRequest(server string, spaceId SpaceId, bucket string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/%s?lifecycle", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutBucketLifecycleConfigurationRequestWithBody generates requests for PutBucketLifecycleConfiguration with any type of body
+func NewPutBucketLifecycleConfigurationRequestWithBody(server string, spaceId SpaceId, bucket string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/%s?lifecycle", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteBucketPolicyRequest generates requests for DeleteBucketPolicy
+func NewDeleteBucketPolicyRequest(server string, spaceId SpaceId, bucket string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/%s?policy", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBucketPolicyRequest generates requests for GetBucketPolicy
+func NewGetBucketPolicyRequest(server string, spaceId SpaceId, bucket string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/%s?policy", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutBucketPolicyRequest calls the generic PutBucketPolicy builder with application/json body
+func NewPutBucketPolicyRequest(server string, spaceId SpaceId, bucket string, body PutBucketPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutBucketPolicyRequestWithBody(server, spaceId, bucket, "application/json", bodyReader)
+}
+
+// NewPutBucketPolicyRequestWithBody generates requests for PutBucketPolicy with any type of body
+func NewPutBucketPolicyRequestWithBody(server string, spaceId SpaceId, bucket string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/%s?policy", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteBucketTaggingRequest generates requests for DeleteBucketTagging
+func NewDeleteBucketTaggingRequest(server string, spaceId SpaceId, bucket string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/%s?tagging", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBucketTaggingRequest generates requests for GetBucketTagging
+func NewGetBucketTaggingRequest(server string, spaceId SpaceId, bucket string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/%s?tagging", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutBucketTaggingRequestWithBody generates requests for PutBucketTagging with any type of body
+func NewPutBucketTaggingRequestWithBody(server string, spaceId SpaceId, bucket string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/%s?tagging", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetBucketVersioningRequest generates requests for GetBucketVersioning
+func NewGetBucketVersioningRequest(server string, spaceId SpaceId, bucket string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/%s?versioning", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutBucketVersioningRequestWithBody generates requests for PutBucketVersioning with any type of body
+func NewPutBucketVersioningRequestWithBody(server string, spaceId SpaceId, bucket string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bucket", runtime.ParamLocationPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/%s?versioning", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
 type ClientWithResponses struct {
 	ClientInterface
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListBucketsWithResponse request
+	ListBucketsWithResponse(ctx context.Context, spaceId SpaceId, reqEditors ...RequestEditorFn) (*ListBucketsResponse, error)
+
+	// DeleteBucketWithResponse request
+	DeleteBucketWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*DeleteBucketResponse, error)
+
+	// ListObjectsWithResponse request
+	ListObjectsWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*ListObjectsResponse, error)
+
+	// CreateBucketWithResponse request
+	CreateBucketWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*CreateBucketResponse, error)
+
+	// DeleteObjectWithResponse request
+	DeleteObjectWithResponse(ctx context.Context, spaceId SpaceId, bucket string, key string, reqEditors ...RequestEditorFn) (*DeleteObjectResponse, error)
+
+	// GetObjectWithResponse request
+	GetObjectWithResponse(ctx context.Context, spaceId SpaceId, bucket string, key string, params *GetObjectParams, reqEditors ...RequestEditorFn) (*GetObjectResponse, error)
+
+	// HeadObjectWithResponse request
+	HeadObjectWithResponse(ctx context.Context, spaceId SpaceId, bucket string, key string, reqEditors ...RequestEditorFn) (*HeadObjectResponse, error)
+
+	// PutObjectWithBodyWithResponse request with any body
+	PutObjectWithBodyWithResponse(ctx context.Context, spaceId SpaceId, bucket string, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutObjectResponse, error)
+
+	// DeleteBucketCorsWithResponse request
+	DeleteBucketCorsWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*DeleteBucketCorsResponse, error)
+
+	// GetBucketCorsWithResponse request
+	GetBucketCorsWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*GetBucketCorsResponse, error)
+
+	// PutBucketCorsWithBodyWithResponse request with any body
+	PutBucketCorsWithBodyWithResponse(ctx context.Context, spaceId SpaceId, bucket string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBucketCorsResponse, error)
+
+	// DeleteBucketLifecycleWithResponse request
+	DeleteBucketLifecycleWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*DeleteBucketLifecycleResponse, error)
+
+	// GetBucketLifecycleConfigurationWithResponse request
+	GetBucketLifecycleConfigurationWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*GetBucketLifecycleConfigurationResponse, error)
+
+	// PutBucketLifecycleConfigurationWithBodyWithResponse request with any body
+	PutBucketLifecycleConfigurationWithBodyWithResponse(ctx context.Context, spaceId SpaceId, bucket string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBucketLifecycleConfigurationResponse, error)
+
+	// DeleteBucketPolicyWithResponse request
+	DeleteBucketPolicyWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*DeleteBucketPolicyResponse, error)
+
+	// GetBucketPolicyWithResponse request
+	GetBucketPolicyWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*GetBucketPolicyResponse, error)
+
+	// PutBucketPolicyWithBodyWithResponse request with any body
+	PutBucketPolicyWithBodyWithResponse(ctx context.Context, spaceId SpaceId, bucket string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBucketPolicyResponse, error)
+
+	PutBucketPolicyWithResponse(ctx context.Context, spaceId SpaceId, bucket string, body PutBucketPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*PutBucketPolicyResponse, error)
+
+	// DeleteBucketTaggingWithResponse request
+	DeleteBucketTaggingWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*DeleteBucketTaggingResponse, error)
+
+	// GetBucketTaggingWithResponse request
+	GetBucketTaggingWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*GetBucketTaggingResponse, error)
+
+	// PutBucketTaggingWithBodyWithResponse request with any body
+	PutBucketTaggingWithBodyWithResponse(ctx context.Context, spaceId SpaceId, bucket string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBucketTaggingResponse, error)
+
+	// GetBucketVersioningWithResponse request
+	GetBucketVersioningWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*GetBucketVersioningResponse, error)
+
+	// PutBucketVersioningWithBodyWithResponse request with any body
+	PutBucketVersioningWithBodyWithResponse(ctx context.Context, spaceId SpaceId, bucket string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBucketVersioningResponse, error)
+}
+
+type ListBucketsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	XML200       *ListBuckets200Response
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBucketsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBucketsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBucketResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBucketResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBucketResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListObjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	XML200       *ListObjects200Response
+	XML404       *NoSuchBucket
+}
+
+// Status returns HTTPResponse.Status
+func (r ListObjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListObjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBucketResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	XML200       *CreateBucket200Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBucketResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBucketResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteObjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteObjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteObjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetObjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	XML404       *NoSuchKey
+}
+
+// Status returns HTTPResponse.Status
+func (r GetObjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetObjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HeadObjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r HeadObjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HeadObjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutObjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PutObjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutObjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBucketCorsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBucketCorsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBucketCorsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBucketCorsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	XML200       *GetBucketCors200Response
+	XML404       *NoSuchCORSConfiguration
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBucketCorsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBucketCorsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutBucketCorsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PutBucketCorsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutBucketCorsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBucketLifecycleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBucketLifecycleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBucketLifecycleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBucketLifecycleConfigurationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	XML200       *GetBucketLifecycleConfiguration200Response
+	XML404       *NoSuchLifecycleConfiguration
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBucketLifecycleConfigurationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBucketLifecycleConfigurationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutBucketLifecycleConfigurationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PutBucketLifecycleConfigurationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutBucketLifecycleConfigurationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBucketPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBucketPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBucketPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBucketPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetBucketPolicy200Response
+	XML404       *NoSuchBucketPolicy
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBucketPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBucketPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutBucketPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PutBucketPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutBucketPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBucketTaggingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBucketTaggingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBucketTaggingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBucketTaggingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	XML200       *GetBucketTagging200Response
+	XML404       *NoSuchTagSet
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBucketTaggingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBucketTaggingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutBucketTaggingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PutBucketTaggingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutBucketTaggingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBucketVersioningResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	XML200       *GetBucketVersioning200Response
+	XML404       *NoSuchBucket
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBucketVersioningResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBucketVersioningResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutBucketVersioningResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PutBucketVersioningResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutBucketVersioningResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListBucketsWithResponse request returning *ListBucketsResponse
+func (c *ClientWithResponses) ListBucketsWithResponse(ctx context.Context, spaceId SpaceId, reqEditors ...RequestEditorFn) (*ListBucketsResponse, error) {
+	rsp, err := c.ListBuckets(ctx, spaceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBucketsResponse(rsp)
+}
+
+// DeleteBucketWithResponse request returning *DeleteBucketResponse
+func (c *ClientWithResponses) DeleteBucketWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*DeleteBucketResponse, error) {
+	rsp, err := c.DeleteBucket(ctx, spaceId, bucket, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBucketResponse(rsp)
+}
+
+// ListObjectsWithResponse request returning *ListObjectsResponse
+func (c *ClientWithResponses) ListObjectsWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*ListObjectsResponse, error) {
+	rsp, err := c.ListObjects(ctx, spaceId, bucket, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListObjectsResponse(rsp)
+}
+
+// CreateBucketWithResponse request returning *CreateBucketResponse
+func (c *ClientWithResponses) CreateBucketWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*CreateBucketResponse, error) {
+	rsp, err := c.CreateBucket(ctx, spaceId, bucket, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBucketResponse(rsp)
+}
+
+// DeleteObjectWithResponse request returning *DeleteObjectResponse
+func (c *ClientWithResponses) DeleteObjectWithResponse(ctx context.Context, spaceId SpaceId, bucket string, key string, reqEditors ...RequestEditorFn) (*DeleteObjectResponse, error) {
+	rsp, err := c.DeleteObject(ctx, spaceId, bucket, key, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteObjectResponse(rsp)
+}
+
+// GetObjectWithResponse request returning *GetObjectResponse
+func (c *ClientWithResponses) GetObjectWithResponse(ctx context.Context, spaceId SpaceId, bucket string, key string, params *GetObjectParams, reqEditors ...RequestEditorFn) (*GetObjectResponse, error) {
+	rsp, err := c.GetObject(ctx, spaceId, bucket, key, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetObjectResponse(rsp)
+}
+
+// HeadObjectWithResponse request returning *HeadObjectResponse
+func (c *ClientWithResponses) HeadObjectWithResponse(ctx context.Context, spaceId SpaceId, bucket string, key string, reqEditors ...RequestEditorFn) (*HeadObjectResponse, error) {
+	rsp, err := c.HeadObject(ctx, spaceId, bucket, key, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHeadObjectResponse(rsp)
+}
+
+// PutObjectWithBodyWithResponse request with arbitrary body returning *PutObjectResponse
+func (c *ClientWithResponses) PutObjectWithBodyWithResponse(ctx context.Context, spaceId SpaceId, bucket string, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutObjectResponse, error) {
+	rsp, err := c.PutObjectWithBody(ctx, spaceId, bucket, key, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutObjectResponse(rsp)
+}
+
+// DeleteBucketCorsWithResponse request returning *DeleteBucketCorsResponse
+func (c *ClientWithResponses) DeleteBucketCorsWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*DeleteBucketCorsResponse, error) {
+	rsp, err := c.DeleteBucketCors(ctx, spaceId, bucket, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBucketCorsResponse(rsp)
+}
+
+// GetBucketCorsWithResponse request returning *GetBucketCorsResponse
+func (c *ClientWithResponses) GetBucketCorsWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*GetBucketCorsResponse, error) {
+	rsp, err := c.GetBucketCors(ctx, spaceId, bucket, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBucketCorsResponse(rsp)
+}
+
+// PutBucketCorsWithBodyWithResponse request with arbitrary body returning *PutBucketCorsResponse
+func (c *ClientWithResponses) PutBucketCorsWithBodyWithResponse(ctx context.Context, spaceId SpaceId, bucket string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBucketCorsResponse, error) {
+	rsp, err := c.PutBucketCorsWithBody(ctx, spaceId, bucket, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutBucketCorsResponse(rsp)
+}
+
+// DeleteBucketLifecycleWithResponse request returning *DeleteBucketLifecycleResponse
+func (c *ClientWithResponses) DeleteBucketLifecycleWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*DeleteBucketLifecycleResponse, error) {
+	rsp, err := c.DeleteBucketLifecycle(ctx, spaceId, bucket, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBucketLifecycleResponse(rsp)
+}
+
+// GetBucketLifecycleConfigurationWithResponse request returning *GetBucketLifecycleConfigurationResponse
+func (c *ClientWithResponses) GetBucketLifecycleConfigurationWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*GetBucketLifecycleConfigurationResponse, error) {
+	rsp, err := c.GetBucketLifecycleConfiguration(ctx, spaceId, bucket, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBucketLifecycleConfigurationResponse(rsp)
+}
+
+// PutBucketLifecycleConfigurationWithBodyWithResponse request with arbitrary body returning *PutBucketLifecycleConfigurationResponse
+func (c *ClientWithResponses) PutBucketLifecycleConfigurationWithBodyWithResponse(ctx context.Context, spaceId SpaceId, bucket string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBucketLifecycleConfigurationResponse, error) {
+	rsp, err := c.PutBucketLifecycleConfigurationWithBody(ctx, spaceId, bucket, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutBucketLifecycleConfigurationResponse(rsp)
+}
+
+// DeleteBucketPolicyWithResponse request returning *DeleteBucketPolicyResponse
+func (c *ClientWithResponses) DeleteBucketPolicyWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*DeleteBucketPolicyResponse, error) {
+	rsp, err := c.DeleteBucketPolicy(ctx, spaceId, bucket, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBucketPolicyResponse(rsp)
+}
+
+// GetBucketPolicyWithResponse request returning *GetBucketPolicyResponse
+func (c *ClientWithResponses) GetBucketPolicyWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*GetBucketPolicyResponse, error) {
+	rsp, err := c.GetBucketPolicy(ctx, spaceId, bucket, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBucketPolicyResponse(rsp)
+}
+
+// PutBucketPolicyWithBodyWithResponse request with arbitrary body returning *PutBucketPolicyResponse
+func (c *ClientWithResponses) PutBucketPolicyWithBodyWithResponse(ctx context.Context, spaceId SpaceId, bucket string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBucketPolicyResponse, error) {
+	rsp, err := c.PutBucketPolicyWithBody(ctx, spaceId, bucket, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutBucketPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutBucketPolicyWithResponse(ctx context.Context, spaceId SpaceId, bucket string, body PutBucketPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*PutBucketPolicyResponse, error) {
+	rsp, err := c.PutBucketPolicy(ctx, spaceId, bucket, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutBucketPolicyResponse(rsp)
+}
+
+// DeleteBucketTaggingWithResponse request returning *DeleteBucketTaggingResponse
+func (c *ClientWithResponses) DeleteBucketTaggingWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*DeleteBucketTaggingResponse, error) {
+	rsp, err := c.DeleteBucketTagging(ctx, spaceId, bucket, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBucketTaggingResponse(rsp)
+}
+
+// GetBucketTaggingWithResponse request returning *GetBucketTaggingResponse
+func (c *ClientWithResponses) GetBucketTaggingWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*GetBucketTaggingResponse, error) {
+	rsp, err := c.GetBucketTagging(ctx, spaceId, bucket, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBucketTaggingResponse(rsp)
+}
+
+// PutBucketTaggingWithBodyWithResponse request with arbitrary body returning *PutBucketTaggingResponse
+func (c *ClientWithResponses) PutBucketTaggingWithBodyWithResponse(ctx context.Context, spaceId SpaceId, bucket string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBucketTaggingResponse, error) {
+	rsp, err := c.PutBucketTaggingWithBody(ctx, spaceId, bucket, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutBucketTaggingResponse(rsp)
+}
+
+// GetBucketVersioningWithResponse request returning *GetBucketVersioningResponse
+func (c *ClientWithResponses) GetBucketVersioningWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*GetBucketVersioningResponse, error) {
+	rsp, err := c.GetBucketVersioning(ctx, spaceId, bucket, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBucketVersioningResponse(rsp)
+}
+
+// PutBucketVersioningWithBodyWithResponse request with arbitrary body returning *PutBucketVersioningResponse
+func (c *ClientWithResponses) PutBucketVersioningWithBodyWithResponse(ctx context.Context, spaceId SpaceId, bucket string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBucketVersioningResponse, error) {
+	rsp, err := c.PutBucketVersioningWithBody(ctx, spaceId, bucket, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutBucketVersioningResponse(rsp)
+}
+
+// ParseListBucketsResponse parses an HTTP response from a ListBucketsWithResponse call
+func ParseListBucketsResponse(rsp *http.Response) (*ListBucketsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBucketsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 200:
+		var dest ListBuckets200Response
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteBucketResponse parses an HTTP response from a DeleteBucketWithResponse call
+func ParseDeleteBucketResponse(rsp *http.Response) (*DeleteBucketResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBucketResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListObjectsResponse parses an HTTP response from a ListObjectsWithResponse call
+func ParseListObjectsResponse(rsp *http.Response) (*ListObjectsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListObjectsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 200:
+		var dest ListObjects200Response
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 404:
+		var dest NoSuchBucket
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBucketResponse parses an HTTP response from a CreateBucketWithResponse call
+func ParseCreateBucketResponse(rsp *http.Response) (*CreateBucketResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBucketResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 200:
+		var dest CreateBucket200Response
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteObjectResponse parses an HTTP response from a DeleteObjectWithResponse call
+func ParseDeleteObjectResponse(rsp *http.Response) (*DeleteObjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteObjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetObjectResponse parses an HTTP response from a GetObjectWithResponse call
+func ParseGetObjectResponse(rsp *http.Response) (*GetObjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetObjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 404:
+		var dest NoSuchKey
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHeadObjectResponse parses an HTTP response from a HeadObjectWithResponse call
+func ParseHeadObjectResponse(rsp *http.Response) (*HeadObjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	response := &HeadObjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
+
+	return response, nil
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// ListBucketsWithResponse request
-	ListBucketsWithResponse(ctx context.Context, spaceId SpaceId, reqEditors ...RequestEditorFn) (*ListBucketsResponse, error)
+// ParsePutObjectResponse parses an HTTP response from a PutObjectWithResponse call
+func ParsePutObjectResponse(rsp *http.Response) (*PutObjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteBucketWithResponse request
-	DeleteBucketWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*DeleteBucketResponse, error)
+	response := &PutObjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	// CreateBucketWithResponse request
-	CreateBucketWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*CreateBucketResponse, error)
+	return response, nil
 }
 
-type ListBucketsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	XML200       *ListBuckets200Response
-}
+// ParseDeleteBucketCorsResponse parses an HTTP response from a DeleteBucketCorsWithResponse call
+func ParseDeleteBucketCorsResponse(rsp *http.Response) (*DeleteBucketCorsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r ListBucketsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &DeleteBucketCorsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListBucketsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseGetBucketCorsResponse parses an HTTP response from a GetBucketCorsWithResponse call
+func ParseGetBucketCorsResponse(rsp *http.Response) (*GetBucketCorsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteBucketResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	response := &GetBucketCorsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 200:
+		var dest GetBucketCors200Response
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 404:
+		var dest NoSuchCORSConfiguration
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML404 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteBucketResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParsePutBucketCorsResponse parses an HTTP response from a PutBucketCorsWithResponse call
+func ParsePutBucketCorsResponse(rsp *http.Response) (*PutBucketCorsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &PutBucketCorsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteBucketResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseDeleteBucketLifecycleResponse parses an HTTP response from a DeleteBucketLifecycleWithResponse call
+func ParseDeleteBucketLifecycleResponse(rsp *http.Response) (*DeleteBucketLifecycleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	response := &DeleteBucketLifecycleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-type CreateBucketResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	XML200       *CreateBucket200Response
+// ParseGetBucketLifecycleConfigurationResponse parses an HTTP response from a GetBucketLifecycleConfigurationWithResponse call
+func ParseGetBucketLifecycleConfigurationResponse(rsp *http.Response) (*GetBucketLifecycleConfigurationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBucketLifecycleConfigurationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 200:
+		var dest GetBucketLifecycleConfiguration200Response
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 404:
+		var dest NoSuchLifecycleConfiguration
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML404 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateBucketResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParsePutBucketLifecycleConfigurationResponse parses an HTTP response from a PutBucketLifecycleConfigurationWithResponse call
+func ParsePutBucketLifecycleConfigurationResponse(rsp *http.Response) (*PutBucketLifecycleConfigurationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &PutBucketLifecycleConfigurationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateBucketResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseDeleteBucketPolicyResponse parses an HTTP response from a DeleteBucketPolicyWithResponse call
+func ParseDeleteBucketPolicyResponse(rsp *http.Response) (*DeleteBucketPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	response := &DeleteBucketPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// ListBucketsWithResponse request returning *ListBucketsResponse
-func (c *ClientWithResponses) ListBucketsWithResponse(ctx context.Context, spaceId SpaceId, reqEditors ...RequestEditorFn) (*ListBucketsResponse, error) {
-	rsp, err := c.ListBuckets(ctx, spaceId, reqEditors...)
+// ParseGetBucketPolicyResponse parses an HTTP response from a GetBucketPolicyWithResponse call
+func ParseGetBucketPolicyResponse(rsp *http.Response) (*GetBucketPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListBucketsResponse(rsp)
+
+	response := &GetBucketPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetBucketPolicy200Response
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 404:
+		var dest NoSuchBucketPolicy
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML404 = &dest
+
+	}
+
+	return response, nil
 }
 
-// DeleteBucketWithResponse request returning *DeleteBucketResponse
-func (c *ClientWithResponses) DeleteBucketWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*DeleteBucketResponse, error) {
-	rsp, err := c.DeleteBucket(ctx, spaceId, bucket, reqEditors...)
+// ParsePutBucketPolicyResponse parses an HTTP response from a PutBucketPolicyWithResponse call
+func ParsePutBucketPolicyResponse(rsp *http.Response) (*PutBucketPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteBucketResponse(rsp)
+
+	response := &PutBucketPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// CreateBucketWithResponse request returning *CreateBucketResponse
-func (c *ClientWithResponses) CreateBucketWithResponse(ctx context.Context, spaceId SpaceId, bucket string, reqEditors ...RequestEditorFn) (*CreateBucketResponse, error) {
-	rsp, err := c.CreateBucket(ctx, spaceId, bucket, reqEditors...)
+// ParseDeleteBucketTaggingResponse parses an HTTP response from a DeleteBucketTaggingWithResponse call
+func ParseDeleteBucketTaggingResponse(rsp *http.Response) (*DeleteBucketTaggingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateBucketResponse(rsp)
+
+	response := &DeleteBucketTaggingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// ParseListBucketsResponse parses an HTTP response from a ListBucketsWithResponse call
-func ParseListBucketsResponse(rsp *http.Response) (*ListBucketsResponse, error) {
+// ParseGetBucketTaggingResponse parses an HTTP response from a GetBucketTaggingWithResponse call
+func ParseGetBucketTaggingResponse(rsp *http.Response) (*GetBucketTaggingResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListBucketsResponse{
+	response := &GetBucketTaggingResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 200:
-		var dest ListBuckets200Response
+		var dest GetBucketTagging200Response
 		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.XML200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 404:
+		var dest NoSuchTagSet
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDeleteBucketResponse parses an HTTP response from a DeleteBucketWithResponse call
-func ParseDeleteBucketResponse(rsp *http.Response) (*DeleteBucketResponse, error) {
+// ParsePutBucketTaggingResponse parses an HTTP response from a PutBucketTaggingWithResponse call
+func ParsePutBucketTaggingResponse(rsp *http.Response) (*PutBucketTaggingResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteBucketResponse{
+	response := &PutBucketTaggingResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -478,27 +2913,50 @@ func ParseDeleteBucketResponse(rsp *http.Response) (*DeleteBucketResponse, error
 	return response, nil
 }
 
-// ParseCreateBucketResponse parses an HTTP response from a CreateBucketWithResponse call
-func ParseCreateBucketResponse(rsp *http.Response) (*CreateBucketResponse, error) {
+// ParseGetBucketVersioningResponse parses an HTTP response from a GetBucketVersioningWithResponse call
+func ParseGetBucketVersioningResponse(rsp *http.Response) (*GetBucketVersioningResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateBucketResponse{
+	response := &GetBucketVersioningResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 200:
-		var dest CreateBucket200Response
+		var dest GetBucketVersioning200Response
 		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.XML200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 404:
+		var dest NoSuchBucket
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutBucketVersioningResponse parses an HTTP response from a PutBucketVersioningWithResponse call
+func ParsePutBucketVersioningResponse(rsp *http.Response) (*PutBucketVersioningResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutBucketVersioningResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
 	return response, nil
@@ -5,3 +5,18 @@ type ListBuckets200Response = ListBucketsOutput
 
 // CreateBucket200Response defines model for CreateBucket200Response.
 type CreateBucket200Response = CreateBucketOutput
+
+// GetBucketVersioning200Response defines model for GetBucketVersioning200Response.
+type GetBucketVersioning200Response = VersioningConfiguration
+
+// GetBucketLifecycleConfiguration200Response defines model for GetBucketLifecycleConfiguration200Response.
+type GetBucketLifecycleConfiguration200Response = LifecycleConfiguration
+
+// GetBucketCors200Response defines model for GetBucketCors200Response.
+type GetBucketCors200Response = CORSConfiguration
+
+// GetBucketTagging200Response defines model for GetBucketTagging200Response.
+type GetBucketTagging200Response = Tagging
+
+// ListObjects200Response defines model for ListObjects200Response.
+type ListObjects200Response = ListObjectsOutput
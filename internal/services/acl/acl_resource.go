@@ -0,0 +1,230 @@
+package acl
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/acl/resource_acl"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &aclResource{}
+	_ resource.ResourceWithConfigure   = &aclResource{}
+	_ resource.ResourceWithImportState = &aclResource{}
+)
+
+type aclResource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewACLResource() resource.Resource {
+	return &aclResource{}
+}
+
+func (r *aclResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.provider = services.ConfigureProviderResource(request, response)
+}
+
+// ImportState accepts the ID of a space ACL, or organisation_id/ followed by the ID for an organisation ACL.
+// The resource ID comes last as it may contain slashes
+func (r *aclResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
+
+	id := request.ID
+	if subjectType, _, _ := strings.Cut(id, "/"); subjectType != string(api.Users) && subjectType != string(api.ServiceAccounts) {
+		var organisationID string
+		organisationID, id, _ = strings.Cut(id, "/")
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("organisation_id"), organisationID)...)
+	}
+
+	parts := strings.SplitN(id, "/", 6)
+	if len(parts) != 6 || slices.Contains(parts, "") {
+		response.Diagnostics.AddError("unexpected import identifier", fmt.Sprintf("Expected import identifier with format: subject_type/subject_id/permission_id/service/resource/resource_id or organisation_id/subject_type/subject_id/permission_id/service/resource/resource_id. Got: %q", request.ID))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), id)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("subject_type"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("subject_id"), parts[1])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("permission_id"), parts[2])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("service"), parts[3])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("resource"), parts[4])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("resource_id"), parts[5])...)
+}
+
+func (r *aclResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_acl"
+}
+
+func (r *aclResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resource_acl.AclResourceSchema(ctx)
+}
+
+func (r *aclResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan resource_acl.AclModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	organisationID, subjectType, subjectID, body, err := deserializeACL(plan)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse acl", err.Error())
+		return
+	}
+
+	provider := services.ScopeProvider(r.provider, organisationID, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err = core.CreateACL(ctx, provider, organisationID, subjectType, subjectID, body); err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create acl", err, request.Plan)...)
+		return
+	}
+
+	acl, err := core.ReadACL(ctx, provider, organisationID, subjectType, subjectID, body)
+	if err != nil {
+		response.Diagnostics.AddError("unable to read acl", err.Error())
+		return
+	}
+
+	state := plan
+	state.Id = types.StringValue(strings.Join([]string{plan.SubjectType.ValueString(), plan.SubjectId.ValueString(), acl.PermissionId.String(), acl.Service, acl.Resource, acl.ResourceId}, "/"))
+	state.PermissionName = types.StringValue(acl.Name)
+	state.SpaceId = services.ScopeSpaceID(provider, organisationID)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *aclResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state resource_acl.AclModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	organisationID, subjectType, subjectID, body, err := deserializeACL(state)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	provider := services.ScopeProvider(r.provider, organisationID, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	acl, err := core.ReadACL(ctx, provider, organisationID, subjectType, subjectID, body)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError("unable to read acl", err.Error())
+		return
+	}
+
+	state.PermissionName = types.StringValue(acl.Name)
+	state.SpaceId = services.ScopeSpaceID(provider, organisationID)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *aclResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state, plan resource_acl.AclModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// ACLs cannot be updated, every attribute requires a replacement and only the timeouts are persisted
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *aclResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state resource_acl.AclModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	organisationID, subjectType, subjectID, body, err := deserializeACL(state)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	provider := services.ScopeProvider(r.provider, organisationID, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err = core.DeleteACL(ctx, provider, organisationID, subjectType, subjectID, body); err != nil {
+		response.Diagnostics.AddError("unable to delete acl", err.Error())
+		return
+	}
+}
+
+func deserializeACL(tf resource_acl.AclModel) (*api.OrganisationId, api.SubjectType, api.SubjectId, api.ACL, error) {
+	organisationID, err := services.ParseOrganisationID(tf.OrganisationId)
+	if err != nil {
+		return nil, "", uuid.Nil, api.ACL{}, err
+	}
+
+	subjectID, err := uuid.Parse(tf.SubjectId.ValueString())
+	if err != nil {
+		return nil, "", uuid.Nil, api.ACL{}, err
+	}
+
+	permissionID, err := uuid.Parse(tf.PermissionId.ValueString())
+	if err != nil {
+		return nil, "", uuid.Nil, api.ACL{}, err
+	}
+
+	return organisationID, api.SubjectType(tf.SubjectType.ValueString()), subjectID, api.ACL{
+		PermissionId: permissionID,
+		Resource:     tf.Resource.ValueString(),
+		ResourceId:   tf.ResourceId.ValueString(),
+		Service:      tf.Service.ValueString(),
+	}, nil
+}
@@ -49,7 +49,12 @@ func (d *bucketsDataSource) Read(ctx context.Context, request datasource.ReadReq
 		return
 	}
 
-	buckets, err := core.ReadBuckets(ctx, d.provider)
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	buckets, err := core.ReadBuckets(ctx, provider)
 	if err != nil {
 		response.Diagnostics.AddError("unable to read buckets", err.Error())
 		return
@@ -62,6 +67,7 @@ func (d *bucketsDataSource) Read(ctx context.Context, request datasource.ReadReq
 
 	state = plan
 	state.Items = bucketItems.Items
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
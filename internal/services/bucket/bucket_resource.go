@@ -4,13 +4,17 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-numspot/internal/client"
 	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/objectstorage"
 	"terraform-provider-numspot/internal/services"
 	"terraform-provider-numspot/internal/services/bucket/resource_bucket"
+	"terraform-provider-numspot/internal/utils"
 )
 
 var (
@@ -36,6 +40,7 @@ func (r *bucketResource) Configure(_ context.Context, request resource.Configure
 }
 
 func (r *bucketResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), request, response)
 }
 
@@ -63,16 +68,43 @@ func (r *bucketResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	bucketName := plan.Name.ValueString()
 
-	err := core.CreateBucket(ctx, r.provider, bucketName)
+	err := core.CreateBucket(ctx, provider, bucketName)
 	if err != nil {
-		response.Diagnostics.AddError("unable to create bucket", err.Error())
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create bucket", err, req.Plan)...)
 		return
 	}
 
-	state := serializeNumSpotCreateBucket(bucketName)
+	bucketTags := deserializeBucketTags(ctx, plan.Tags, &response.Diagnostics)
+	if len(bucketTags) > 0 {
+		bucketTags, err = core.UpdateBucketTags(ctx, provider, bucketName, bucketTags)
+		if err != nil {
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create bucket tags", err, req.Plan)...)
+			return
+		}
+	}
 
+	state := serializeNumSpotCreateBucket(ctx, bucketName, bucketTags, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
@@ -84,19 +116,84 @@ func (r *bucketResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	bucketName := state.Name.ValueString()
 
-	bucket, err := core.ReadBucket(ctx, r.provider, bucketName)
+	bucket, err := core.ReadBucket(ctx, provider, bucketName)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError("unable to read bucket", err.Error())
 		return
 	}
 
-	newState := serializeNumSpotBucket(*bucket)
+	bucketTags, err := core.ReadBucketTags(ctx, provider, bucketName)
+	if err != nil {
+		response.Diagnostics.AddError("unable to read bucket tags", err.Error())
+		return
+	}
+
+	newState := serializeNumSpotBucket(ctx, *bucket, bucketTags, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 }
 
 func (r *bucketResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state, plan resource_bucket.BucketModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Tags.IsUnknown() && !plan.Tags.Equal(state.Tags) {
+		bucketTags, err := core.UpdateBucketTags(ctx, provider, state.Name.ValueString(), deserializeBucketTags(ctx, plan.Tags, &response.Diagnostics))
+		if err != nil {
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update bucket tags", err, request.Plan)...)
+			return
+		}
+
+		state.Tags = serializeBucketTags(ctx, bucketTags, &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
 func (r *bucketResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
@@ -107,20 +204,58 @@ func (r *bucketResource) Delete(ctx context.Context, request resource.DeleteRequ
 		return
 	}
 
-	if err := core.DeleteBucket(ctx, r.provider, state.Name.ValueString()); err != nil {
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := core.DeleteBucket(ctx, provider, state.Name.ValueString()); err != nil {
 		response.Diagnostics.AddError("unable to delete bucket", err.Error())
 		return
 	}
 }
 
-func serializeNumSpotCreateBucket(bucketName string) resource_bucket.BucketModel {
+func serializeNumSpotCreateBucket(ctx context.Context, bucketName string, bucketTags []objectstorage.Tag, diags *diag.Diagnostics) resource_bucket.BucketModel {
 	return resource_bucket.BucketModel{
 		Name: types.StringPointerValue(&bucketName),
+		Tags: serializeBucketTags(ctx, bucketTags, diags),
 	}
 }
 
-func serializeNumSpotBucket(bucket core.Bucket) resource_bucket.BucketModel {
+func serializeNumSpotBucket(ctx context.Context, bucket core.Bucket, bucketTags []objectstorage.Tag, diags *diag.Diagnostics) resource_bucket.BucketModel {
 	return resource_bucket.BucketModel{
 		Name: types.StringValue(bucket.Name),
+		Tags: serializeBucketTags(ctx, bucketTags, diags),
 	}
 }
+
+func serializeBucketTags(ctx context.Context, bucketTags []objectstorage.Tag, diags *diag.Diagnostics) types.Set {
+	return utils.GenericSetToTfSetValue(ctx, func(ctx context.Context, tag objectstorage.Tag, diags *diag.Diagnostics) resource_bucket.TagsValue {
+		value, diagnostics := resource_bucket.NewTagsValue(
+			resource_bucket.TagsValue{}.AttributeTypes(ctx),
+			map[string]attr.Value{
+				"key":   types.StringValue(tag.Key),
+				"value": types.StringValue(tag.Value),
+			},
+		)
+		diags.Append(diagnostics...)
+		return value
+	}, bucketTags, diags)
+}
+
+func deserializeBucketTags(ctx context.Context, tags types.Set, diags *diag.Diagnostics) []objectstorage.Tag {
+	return utils.TfSetToGenericList(func(tag resource_bucket.TagsValue) objectstorage.Tag {
+		return objectstorage.Tag{
+			Key:   tag.Key.ValueString(),
+			Value: tag.Value.ValueString(),
+		}
+	}, ctx, tags, diags)
+}
@@ -42,12 +42,19 @@ func BucketDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Information about one or more Bucket.",
 				MarkdownDescription: "Information about one or more Bucket.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
 		},
 	}
 }
 
 type BucketModel struct {
-	Items types.List `tfsdk:"items"`
+	Items   types.List   `tfsdk:"items"`
+	SpaceId types.String `tfsdk:"space_id"`
 }
 
 var _ basetypes.ObjectTypable = ItemsType{}
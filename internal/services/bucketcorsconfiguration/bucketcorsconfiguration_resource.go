@@ -0,0 +1,250 @@
+package bucketcorsconfiguration
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/objectstorage"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/bucketcorsconfiguration/resource_bucket_cors_configuration"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &bucketCorsConfigurationResource{}
+	_ resource.ResourceWithConfigure   = &bucketCorsConfigurationResource{}
+	_ resource.ResourceWithImportState = &bucketCorsConfigurationResource{}
+)
+
+type bucketCorsConfigurationResource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewBucketCorsConfigurationResource() resource.Resource {
+	return &bucketCorsConfigurationResource{}
+}
+
+func (r *bucketCorsConfigurationResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.provider = services.ConfigureProviderResource(request, response)
+}
+
+func (r *bucketCorsConfigurationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
+	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), request, response)
+}
+
+func (r *bucketCorsConfigurationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_bucket_cors_configuration"
+}
+
+func (r *bucketCorsConfigurationResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resource_bucket_cors_configuration.BucketCorsConfigurationResourceSchema(ctx)
+}
+
+func (r *bucketCorsConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan resource_bucket_cors_configuration.BucketCorsConfigurationModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	body := deserializeCorsConfiguration(ctx, plan, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.Bucket.ValueString()
+	corsConfiguration, err := core.PutBucketCorsConfiguration(ctx, provider, bucketName, body)
+	if err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create bucket CORS configuration", err, request.Plan)...)
+		return
+	}
+
+	state := serializeCorsConfiguration(ctx, bucketName, corsConfiguration, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *bucketCorsConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state resource_bucket_cors_configuration.BucketCorsConfigurationModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.Bucket.ValueString()
+	corsConfiguration, err := core.ReadBucketCorsConfiguration(ctx, provider, bucketName)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError("unable to read bucket CORS configuration", err.Error())
+		return
+	}
+
+	newState := serializeCorsConfiguration(ctx, bucketName, corsConfiguration, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *bucketCorsConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan resource_bucket_cors_configuration.BucketCorsConfigurationModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	body := deserializeCorsConfiguration(ctx, plan, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.Bucket.ValueString()
+	corsConfiguration, err := core.PutBucketCorsConfiguration(ctx, provider, bucketName, body)
+	if err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update bucket CORS configuration", err, request.Plan)...)
+		return
+	}
+
+	newState := serializeCorsConfiguration(ctx, bucketName, corsConfiguration, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *bucketCorsConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state resource_bucket_cors_configuration.BucketCorsConfigurationModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := core.DeleteBucketCorsConfiguration(ctx, provider, state.Bucket.ValueString()); err != nil && !utils.IsNotFound(err) {
+		response.Diagnostics.AddError("unable to delete bucket CORS configuration", err.Error())
+		return
+	}
+}
+
+func deserializeCorsConfiguration(ctx context.Context, tf resource_bucket_cors_configuration.BucketCorsConfigurationModel, diags *diag.Diagnostics) objectstorage.CORSConfiguration {
+	corsRules := utils.TfListToGenericList(func(rule resource_bucket_cors_configuration.CorsRulesValue) objectstorage.CORSRule {
+		return objectstorage.CORSRule{
+			AllowedHeaders: utils.TfStringListToStringPtrList(ctx, rule.AllowedHeaders, diags),
+			AllowedMethods: utils.TfStringListToStringList(ctx, rule.AllowedMethods, diags),
+			AllowedOrigins: utils.TfStringListToStringList(ctx, rule.AllowedOrigins, diags),
+			ExposeHeaders:  utils.TfStringListToStringPtrList(ctx, rule.ExposeHeaders, diags),
+			Id:             rule.Id.ValueStringPointer(),
+			MaxAgeSeconds:  utils.FromTfInt64ToIntPtr(rule.MaxAgeSeconds),
+		}
+	}, ctx, tf.CorsRules, diags)
+
+	return objectstorage.CORSConfiguration{CorsRules: corsRules}
+}
+
+func serializeCorsConfiguration(ctx context.Context, bucketName string, http *objectstorage.CORSConfiguration, diags *diag.Diagnostics) resource_bucket_cors_configuration.BucketCorsConfigurationModel {
+	return resource_bucket_cors_configuration.BucketCorsConfigurationModel{
+		Bucket:    types.StringValue(bucketName),
+		CorsRules: utils.GenericListToTfListValue(ctx, serializeCorsRule, http.CorsRules, diags),
+	}
+}
+
+func serializeCorsRule(ctx context.Context, http objectstorage.CORSRule, diags *diag.Diagnostics) resource_bucket_cors_configuration.CorsRulesValue {
+	value, diagnostics := resource_bucket_cors_configuration.NewCorsRulesValue(
+		resource_bucket_cors_configuration.CorsRulesValue{}.AttributeTypes(ctx),
+		map[string]attr.Value{
+			"allowed_headers": optionalStringList(ctx, http.AllowedHeaders, diags),
+			"allowed_methods": utils.FromStringListToTfStringList(ctx, http.AllowedMethods, diags),
+			"allowed_origins": utils.FromStringListToTfStringList(ctx, http.AllowedOrigins, diags),
+			"expose_headers":  optionalStringList(ctx, http.ExposeHeaders, diags),
+			"id":              types.StringPointerValue(http.Id),
+			"max_age_seconds": utils.FromIntPtrToTfInt64(http.MaxAgeSeconds),
+		},
+	)
+	diags.Append(diagnostics...)
+	return value
+}
+
+// optionalStringList keeps the optional lists which are not returned by the object storage null
+func optionalStringList(ctx context.Context, arr *[]string, diags *diag.Diagnostics) types.List {
+	if arr == nil || len(*arr) == 0 {
+		return types.ListNull(types.StringType)
+	}
+
+	return utils.FromStringListToTfStringList(ctx, *arr, diags)
+}
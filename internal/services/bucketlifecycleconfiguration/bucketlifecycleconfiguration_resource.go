@@ -0,0 +1,276 @@
+package bucketlifecycleconfiguration
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/objectstorage"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/bucketlifecycleconfiguration/resource_bucket_lifecycle_configuration"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &bucketLifecycleConfigurationResource{}
+	_ resource.ResourceWithConfigure   = &bucketLifecycleConfigurationResource{}
+	_ resource.ResourceWithImportState = &bucketLifecycleConfigurationResource{}
+)
+
+type bucketLifecycleConfigurationResource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewBucketLifecycleConfigurationResource() resource.Resource {
+	return &bucketLifecycleConfigurationResource{}
+}
+
+func (r *bucketLifecycleConfigurationResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.provider = services.ConfigureProviderResource(request, response)
+}
+
+func (r *bucketLifecycleConfigurationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
+	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), request, response)
+}
+
+func (r *bucketLifecycleConfigurationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_bucket_lifecycle_configuration"
+}
+
+func (r *bucketLifecycleConfigurationResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resource_bucket_lifecycle_configuration.BucketLifecycleConfigurationResourceSchema(ctx)
+}
+
+func (r *bucketLifecycleConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan resource_bucket_lifecycle_configuration.BucketLifecycleConfigurationModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	body := deserializeLifecycleConfiguration(ctx, plan, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.Bucket.ValueString()
+	lifecycleConfiguration, err := core.PutBucketLifecycleConfiguration(ctx, provider, bucketName, body)
+	if err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create bucket lifecycle configuration", err, request.Plan)...)
+		return
+	}
+
+	state := serializeLifecycleConfiguration(ctx, bucketName, lifecycleConfiguration, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *bucketLifecycleConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state resource_bucket_lifecycle_configuration.BucketLifecycleConfigurationModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.Bucket.ValueString()
+	lifecycleConfiguration, err := core.ReadBucketLifecycleConfiguration(ctx, provider, bucketName)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError("unable to read bucket lifecycle configuration", err.Error())
+		return
+	}
+
+	newState := serializeLifecycleConfiguration(ctx, bucketName, lifecycleConfiguration, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *bucketLifecycleConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan resource_bucket_lifecycle_configuration.BucketLifecycleConfigurationModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	body := deserializeLifecycleConfiguration(ctx, plan, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.Bucket.ValueString()
+	lifecycleConfiguration, err := core.PutBucketLifecycleConfiguration(ctx, provider, bucketName, body)
+	if err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update bucket lifecycle configuration", err, request.Plan)...)
+		return
+	}
+
+	newState := serializeLifecycleConfiguration(ctx, bucketName, lifecycleConfiguration, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *bucketLifecycleConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state resource_bucket_lifecycle_configuration.BucketLifecycleConfigurationModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := core.DeleteBucketLifecycleConfiguration(ctx, provider, state.Bucket.ValueString()); err != nil && !utils.IsNotFound(err) {
+		response.Diagnostics.AddError("unable to delete bucket lifecycle configuration", err.Error())
+		return
+	}
+}
+
+func deserializeLifecycleConfiguration(ctx context.Context, tf resource_bucket_lifecycle_configuration.BucketLifecycleConfigurationModel, diags *diag.Diagnostics) objectstorage.LifecycleConfiguration {
+	rules := utils.TfListToGenericList(func(rule resource_bucket_lifecycle_configuration.RulesValue) objectstorage.LifecycleRule {
+		lifecycleRule := objectstorage.LifecycleRule{
+			Id:     rule.Id.ValueStringPointer(),
+			Status: objectstorage.ExpirationStatus(rule.Status.ValueString()),
+			Filter: &objectstorage.LifecycleRuleFilter{Prefix: utils.PointerOf(rule.Prefix.ValueString())},
+		}
+
+		if !utils.IsTfValueNull(rule.ExpirationDays) || !utils.IsTfValueNull(rule.ExpirationDate) {
+			lifecycleRule.Expiration = &objectstorage.LifecycleExpiration{
+				Days: utils.FromTfInt64ToIntPtr(rule.ExpirationDays),
+				Date: rule.ExpirationDate.ValueStringPointer(),
+			}
+		}
+		if !utils.IsTfValueNull(rule.NoncurrentVersionExpirationDays) {
+			lifecycleRule.NoncurrentVersionExpiration = &objectstorage.NoncurrentVersionExpiration{
+				NoncurrentDays: utils.FromTfInt64ToIntPtr(rule.NoncurrentVersionExpirationDays),
+			}
+		}
+		if !utils.IsTfValueNull(rule.AbortIncompleteMultipartUploadDays) {
+			lifecycleRule.AbortIncompleteMultipartUpload = &objectstorage.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: utils.FromTfInt64ToIntPtr(rule.AbortIncompleteMultipartUploadDays),
+			}
+		}
+
+		return lifecycleRule
+	}, ctx, tf.Rules, diags)
+
+	return objectstorage.LifecycleConfiguration{Rules: rules}
+}
+
+func serializeLifecycleConfiguration(ctx context.Context, bucketName string, http *objectstorage.LifecycleConfiguration, diags *diag.Diagnostics) resource_bucket_lifecycle_configuration.BucketLifecycleConfigurationModel {
+	return resource_bucket_lifecycle_configuration.BucketLifecycleConfigurationModel{
+		Bucket: types.StringValue(bucketName),
+		Rules:  utils.GenericListToTfListValue(ctx, serializeLifecycleRule, http.Rules, diags),
+	}
+}
+
+func serializeLifecycleRule(ctx context.Context, http objectstorage.LifecycleRule, diags *diag.Diagnostics) resource_bucket_lifecycle_configuration.RulesValue {
+	prefix := ""
+	if http.Filter != nil && http.Filter.Prefix != nil {
+		prefix = *http.Filter.Prefix
+	}
+
+	var expirationDays, noncurrentVersionExpirationDays, abortIncompleteMultipartUploadDays *int
+	var expirationDate *string
+	if http.Expiration != nil {
+		expirationDays = http.Expiration.Days
+		expirationDate = http.Expiration.Date
+	}
+	if http.NoncurrentVersionExpiration != nil {
+		noncurrentVersionExpirationDays = http.NoncurrentVersionExpiration.NoncurrentDays
+	}
+	if http.AbortIncompleteMultipartUpload != nil {
+		abortIncompleteMultipartUploadDays = http.AbortIncompleteMultipartUpload.DaysAfterInitiation
+	}
+
+	value, diagnostics := resource_bucket_lifecycle_configuration.NewRulesValue(
+		resource_bucket_lifecycle_configuration.RulesValue{}.AttributeTypes(ctx),
+		map[string]attr.Value{
+			"abort_incomplete_multipart_upload_days": utils.FromIntPtrToTfInt64(abortIncompleteMultipartUploadDays),
+			"expiration_date":                        types.StringPointerValue(expirationDate),
+			"expiration_days":                        utils.FromIntPtrToTfInt64(expirationDays),
+			"id":                                     types.StringPointerValue(http.Id),
+			"noncurrent_version_expiration_days":     utils.FromIntPtrToTfInt64(noncurrentVersionExpirationDays),
+			"prefix":                                 types.StringValue(prefix),
+			"status":                                 types.StringValue(string(http.Status)),
+		},
+	)
+	diags.Append(diagnostics...)
+	return value
+}
@@ -0,0 +1,90 @@
+package bucketobject
+
+import (
+	"context"
+	"encoding/base64"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/bucketobject/datasource_bucket_object"
+)
+
+var _ datasource.DataSource = &bucketObjectDataSource{}
+
+type bucketObjectDataSource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewBucketObjectDataSource() datasource.DataSource {
+	return &bucketObjectDataSource{}
+}
+
+func (d *bucketObjectDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if request.ProviderData == nil {
+		return
+	}
+
+	d.provider = services.ConfigureProviderDatasource(request, response)
+}
+
+func (d *bucketObjectDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_bucket_object"
+}
+
+func (d *bucketObjectDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = datasource_bucket_object.BucketObjectDataSourceSchema(ctx)
+}
+
+func (d *bucketObjectDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var plan datasource_bucket_object.BucketObjectModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	object, err := core.ReadBucketObject(ctx, provider, plan.Bucket.ValueString(), plan.Key.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("unable to read bucket object", err.Error())
+		return
+	}
+
+	metadata, diags := types.MapValueFrom(ctx, types.StringType, object.Metadata)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Binary objects are only exposed in base64
+	body := types.StringNull()
+	if utf8.Valid(object.Body) {
+		body = types.StringValue(string(object.Body))
+	}
+
+	versionId := types.StringNull()
+	if object.VersionId != "" {
+		versionId = types.StringValue(object.VersionId)
+	}
+
+	state := plan
+	state.Body = body
+	state.BodyBase64 = types.StringValue(base64.StdEncoding.EncodeToString(object.Body))
+	state.ContentLength = types.Int64Value(object.ContentLength)
+	state.ContentType = types.StringValue(object.ContentType)
+	state.Etag = types.StringValue(object.ETag)
+	state.LastModified = types.StringValue(object.LastModified)
+	state.Metadata = metadata
+	state.VersionId = versionId
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
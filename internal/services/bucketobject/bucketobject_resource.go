@@ -0,0 +1,294 @@
+package bucketobject
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/bucketobject/resource_bucket_object"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &bucketObjectResource{}
+	_ resource.ResourceWithConfigure   = &bucketObjectResource{}
+	_ resource.ResourceWithImportState = &bucketObjectResource{}
+	_ resource.ResourceWithModifyPlan  = &bucketObjectResource{}
+)
+
+type bucketObjectResource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewBucketObjectResource() resource.Resource {
+	return &bucketObjectResource{}
+}
+
+func (r *bucketObjectResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.provider = services.ConfigureProviderResource(request, response)
+}
+
+func (r *bucketObjectResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
+	bucketName, key, found := strings.Cut(request.ID, "/")
+	if !found || bucketName == "" || key == "" {
+		response.Diagnostics.AddError("unexpected import identifier", fmt.Sprintf("Expected import identifier with format: bucket/key. Got: %q", request.ID))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("bucket"), bucketName)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("key"), key)...)
+}
+
+func (r *bucketObjectResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_bucket_object"
+}
+
+func (r *bucketObjectResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resource_bucket_object.BucketObjectResourceSchema(ctx)
+}
+
+// ModifyPlan uploads the object again when the MD5 digest of its content no longer matches the etag of the stored
+// object, unless the etag is set in the configuration
+func (r *bucketObjectResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() || request.State.Raw.IsNull() {
+		return
+	}
+
+	var config, plan, state resource_bucket_object.BucketObjectModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() || !config.Etag.IsNull() || plan.Content.IsUnknown() || plan.Source.IsUnknown() {
+		return
+	}
+
+	content, diags := objectContent(plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	checksum := md5.Sum(content)
+	if hex.EncodeToString(checksum[:]) == state.Etag.ValueString() {
+		return
+	}
+
+	plan.Etag = types.StringUnknown()
+	plan.VersionId = types.StringUnknown()
+	response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+}
+
+func (r *bucketObjectResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan resource_bucket_object.BucketObjectModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	object := putBucketObject(ctx, provider, plan, "unable to create bucket object", &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state := serializeBucketObject(ctx, plan, object, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *bucketObjectResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state resource_bucket_object.BucketObjectModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	object, err := core.HeadBucketObject(ctx, provider, state.Bucket.ValueString(), state.Key.ValueString())
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError("unable to read bucket object", err.Error())
+		return
+	}
+
+	newState := serializeBucketObject(ctx, state, object, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *bucketObjectResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan resource_bucket_object.BucketObjectModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	object := putBucketObject(ctx, provider, plan, "unable to update bucket object", &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	newState := serializeBucketObject(ctx, plan, object, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *bucketObjectResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state resource_bucket_object.BucketObjectModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := core.DeleteBucketObject(ctx, provider, state.Bucket.ValueString(), state.Key.ValueString()); err != nil && !utils.IsNotFound(err) {
+		response.Diagnostics.AddError("unable to delete bucket object", err.Error())
+		return
+	}
+}
+
+func putBucketObject(ctx context.Context, provider *client.NumSpotSDK, plan resource_bucket_object.BucketObjectModel, summary string, diags *diag.Diagnostics) *core.BucketObject {
+	content, contentDiags := objectContent(plan)
+	diags.Append(contentDiags...)
+	if diags.HasError() {
+		return nil
+	}
+
+	metadata := map[string]string{}
+	if !plan.Metadata.IsNull() && !plan.Metadata.IsUnknown() {
+		diags.Append(plan.Metadata.ElementsAs(ctx, &metadata, false)...)
+		if diags.HasError() {
+			return nil
+		}
+	}
+
+	object, err := core.PutBucketObject(ctx, provider, plan.Bucket.ValueString(), plan.Key.ValueString(), content, plan.ContentType.ValueString(), metadata)
+	if err != nil {
+		diags.Append(utils.ErrorDiagnostics(ctx, summary, err, nil)...)
+		return nil
+	}
+
+	return object
+}
+
+// objectContent returns the content of the object, from the content attribute or the source file
+func objectContent(plan resource_bucket_object.BucketObjectModel) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if plan.Source.IsNull() {
+		return []byte(plan.Content.ValueString()), diags
+	}
+
+	content, err := os.ReadFile(plan.Source.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("source"), "unable to read object source", err.Error())
+		return nil, diags
+	}
+
+	return content, diags
+}
+
+// serializeBucketObject keeps the content and source of the known model, which the object storage does not return
+func serializeBucketObject(ctx context.Context, known resource_bucket_object.BucketObjectModel, object *core.BucketObject, diags *diag.Diagnostics) resource_bucket_object.BucketObjectModel {
+	metadata := types.MapNull(types.StringType)
+	if len(object.Metadata) > 0 || !known.Metadata.IsNull() {
+		var metadataDiags diag.Diagnostics
+		metadata, metadataDiags = types.MapValueFrom(ctx, types.StringType, object.Metadata)
+		diags.Append(metadataDiags...)
+	}
+
+	versionId := types.StringNull()
+	if object.VersionId != "" {
+		versionId = types.StringValue(object.VersionId)
+	}
+
+	return resource_bucket_object.BucketObjectModel{
+		Bucket:      known.Bucket,
+		Content:     known.Content,
+		ContentType: types.StringValue(object.ContentType),
+		Etag:        types.StringValue(object.ETag),
+		Key:         known.Key,
+		Metadata:    metadata,
+		Source:      known.Source,
+		VersionId:   versionId,
+	}
+}
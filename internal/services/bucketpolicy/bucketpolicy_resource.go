@@ -0,0 +1,219 @@
+package bucketpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/bucketpolicy/resource_bucket_policy"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = &bucketPolicyResource{}
+	_ resource.ResourceWithConfigure      = &bucketPolicyResource{}
+	_ resource.ResourceWithImportState    = &bucketPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &bucketPolicyResource{}
+)
+
+type bucketPolicyResource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewBucketPolicyResource() resource.Resource {
+	return &bucketPolicyResource{}
+}
+
+func (r *bucketPolicyResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.provider = services.ConfigureProviderResource(request, response)
+}
+
+func (r *bucketPolicyResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
+	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), request, response)
+}
+
+func (r *bucketPolicyResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_bucket_policy"
+}
+
+func (r *bucketPolicyResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resource_bucket_policy.BucketPolicyResourceSchema(ctx)
+}
+
+func (r *bucketPolicyResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config resource_bucket_policy.BucketPolicyModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() || utils.IsTfValueNull(config.Policy) {
+		return
+	}
+
+	if !json.Valid([]byte(config.Policy.ValueString())) {
+		response.Diagnostics.AddAttributeError(path.Root("policy"), "invalid bucket policy", "The policy must be a valid JSON document.")
+	}
+}
+
+func (r *bucketPolicyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan resource_bucket_policy.BucketPolicyModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.Bucket.ValueString()
+	policy, err := core.PutBucketPolicy(ctx, provider, bucketName, plan.Policy.ValueString())
+	if err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create bucket policy", err, request.Plan)...)
+		return
+	}
+
+	state := serializeBucketPolicy(bucketName, policy, plan.Policy)
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *bucketPolicyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state resource_bucket_policy.BucketPolicyModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.Bucket.ValueString()
+	policy, err := core.ReadBucketPolicy(ctx, provider, bucketName)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError("unable to read bucket policy", err.Error())
+		return
+	}
+
+	newState := serializeBucketPolicy(bucketName, policy, state.Policy)
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *bucketPolicyResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan resource_bucket_policy.BucketPolicyModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.Bucket.ValueString()
+	policy, err := core.PutBucketPolicy(ctx, provider, bucketName, plan.Policy.ValueString())
+	if err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update bucket policy", err, request.Plan)...)
+		return
+	}
+
+	newState := serializeBucketPolicy(bucketName, policy, plan.Policy)
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *bucketPolicyResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state resource_bucket_policy.BucketPolicyModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := core.DeleteBucketPolicy(ctx, provider, state.Bucket.ValueString()); err != nil && !utils.IsNotFound(err) {
+		response.Diagnostics.AddError("unable to delete bucket policy", err.Error())
+		return
+	}
+}
+
+// serializeBucketPolicy keeps the known policy when the object storage returns an equivalent document, which it may
+// reformat
+func serializeBucketPolicy(bucketName, policy string, knownPolicy types.String) resource_bucket_policy.BucketPolicyModel {
+	policyTf := types.StringValue(policy)
+	if !knownPolicy.IsNull() && !knownPolicy.IsUnknown() && equivalentPolicies(policy, knownPolicy.ValueString()) {
+		policyTf = knownPolicy
+	}
+
+	return resource_bucket_policy.BucketPolicyModel{
+		Bucket: types.StringValue(bucketName),
+		Policy: policyTf,
+	}
+}
+
+func equivalentPolicies(policy, otherPolicy string) bool {
+	var policyDocument, otherPolicyDocument any
+	if err := json.Unmarshal([]byte(policy), &policyDocument); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(otherPolicy), &otherPolicyDocument); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(policyDocument, otherPolicyDocument)
+}
@@ -0,0 +1,199 @@
+package bucketversioning
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/objectstorage"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/bucketversioning/resource_bucket_versioning"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &bucketVersioningResource{}
+	_ resource.ResourceWithConfigure   = &bucketVersioningResource{}
+	_ resource.ResourceWithImportState = &bucketVersioningResource{}
+)
+
+type bucketVersioningResource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewBucketVersioningResource() resource.Resource {
+	return &bucketVersioningResource{}
+}
+
+func (r *bucketVersioningResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.provider = services.ConfigureProviderResource(request, response)
+}
+
+func (r *bucketVersioningResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
+	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), request, response)
+}
+
+func (r *bucketVersioningResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_bucket_versioning"
+}
+
+func (r *bucketVersioningResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resource_bucket_versioning.BucketVersioningResourceSchema(ctx)
+}
+
+func (r *bucketVersioningResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan resource_bucket_versioning.BucketVersioningModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.Bucket.ValueString()
+	versioning, err := core.UpdateBucketVersioning(ctx, provider, bucketName, objectstorage.BucketVersioningStatus(plan.Status.ValueString()))
+	if err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create bucket versioning", err, request.Plan)...)
+		return
+	}
+
+	state := serializeBucketVersioning(bucketName, versioning)
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *bucketVersioningResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state resource_bucket_versioning.BucketVersioningModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.Bucket.ValueString()
+	versioning, err := core.ReadBucketVersioning(ctx, provider, bucketName)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError("unable to read bucket versioning", err.Error())
+		return
+	}
+
+	// A bucket on which versioning has never been enabled has no versioning status
+	if versioning.Status == nil {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	newState := serializeBucketVersioning(bucketName, versioning)
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *bucketVersioningResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan resource_bucket_versioning.BucketVersioningModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.Bucket.ValueString()
+	versioning, err := core.UpdateBucketVersioning(ctx, provider, bucketName, objectstorage.BucketVersioningStatus(plan.Status.ValueString()))
+	if err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update bucket versioning", err, request.Plan)...)
+		return
+	}
+
+	newState := serializeBucketVersioning(bucketName, versioning)
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *bucketVersioningResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state resource_bucket_versioning.BucketVersioningModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Versioning cannot be disabled once enabled, it is suspended instead
+	_, err := core.UpdateBucketVersioning(ctx, provider, state.Bucket.ValueString(), objectstorage.BucketVersioningStatusSuspended)
+	if err != nil && !utils.IsNotFound(err) {
+		response.Diagnostics.AddError("unable to delete bucket versioning", err.Error())
+		return
+	}
+}
+
+func serializeBucketVersioning(bucketName string, versioning *objectstorage.VersioningConfiguration) resource_bucket_versioning.BucketVersioningModel {
+	status := types.StringNull()
+	if versioning.Status != nil {
+		status = types.StringValue(string(*versioning.Status))
+	}
+
+	return resource_bucket_versioning.BucketVersioningModel{
+		Bucket: types.StringValue(bucketName),
+		Status: status,
+	}
+}
@@ -48,7 +48,12 @@ func (d *clientGatewaysDataSource) Read(ctx context.Context, request datasource.
 		return
 	}
 
-	clientGateways, err := core.ReadClientGateways(ctx, d.provider)
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	clientGateways, err := core.ReadClientGateways(ctx, provider)
 	if err != nil {
 		response.Diagnostics.AddError("unable to read client gateways", err.Error())
 		return
@@ -61,6 +66,7 @@ func (d *clientGatewaysDataSource) Read(ctx context.Context, request datasource.
 
 	state = plan
 	state.Items = clientGatewayItems.Items
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
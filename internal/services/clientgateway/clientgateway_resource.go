@@ -40,6 +40,7 @@ func (r *clientGatewayResource) Configure(_ context.Context, request resource.Co
 }
 
 func (r *clientGatewayResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
@@ -58,9 +59,22 @@ func (r *clientGatewayResource) Create(ctx context.Context, request resource.Cre
 		return
 	}
 
-	clientGateway, err := core.CreateClientGateway(ctx, r.provider, deserializeCreateClientGateway(plan))
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	clientGateway, err := core.CreateClientGateway(ctx, provider, deserializeCreateClientGateway(plan))
 	if err != nil {
-		response.Diagnostics.AddError("unable to create client gateway", err.Error())
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create client gateway", err, request.Plan)...)
 		return
 	}
 
@@ -69,6 +83,8 @@ func (r *clientGatewayResource) Create(ctx context.Context, request resource.Cre
 		return
 	}
 
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
@@ -79,13 +95,30 @@ func (r *clientGatewayResource) Read(ctx context.Context, request resource.ReadR
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	clientGatewayID, err := uuid.Parse(state.Id.ValueString())
 	if err != nil {
 		response.Diagnostics.AddError("unable to parse id from state", err.Error())
 		return
 	}
 
-	numSpotClientGateway, err := core.ReadClientGateway(ctx, r.provider, clientGatewayID)
+	numSpotClientGateway, err := core.ReadClientGateway(ctx, provider, clientGatewayID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError("unable to read client gateway", err.Error())
 		return
@@ -96,10 +129,22 @@ func (r *clientGatewayResource) Read(ctx context.Context, request resource.ReadR
 		return
 	}
 
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 }
 
 func (r *clientGatewayResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state, plan resource_client_gateway.ClientGatewayModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// No implementation at this time, only the timeouts are persisted
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
 func (r *clientGatewayResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
@@ -110,13 +155,26 @@ func (r *clientGatewayResource) Delete(ctx context.Context, request resource.Del
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	clientGatewayID, err := uuid.Parse(state.Id.ValueString())
 	if err != nil {
 		response.Diagnostics.AddError("unable to parse id from state", err.Error())
 		return
 	}
 
-	err = core.DeleteClientGateway(ctx, r.provider, clientGatewayID)
+	err = core.DeleteClientGateway(ctx, provider, clientGatewayID)
 	if err != nil {
 		response.Diagnostics.AddError("unable to delete client gateway", err.Error())
 		return
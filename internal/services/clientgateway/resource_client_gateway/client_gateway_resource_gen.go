@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -44,19 +45,39 @@ func ClientGatewayResourceSchema(ctx context.Context) schema.Schema {
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"state": schema.StringAttribute{
 				Computed:            true,
 				Description:         "The state of the client gateway (`pending` \\| `available` \\| `deleting` \\| `deleted`).",
 				MarkdownDescription: "The state of the client gateway (`pending` \\| `available` \\| `deleting` \\| `deleted`).",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type ClientGatewayModel struct {
-	BgpAsn         types.Int64  `tfsdk:"bgp_asn"`
-	ConnectionType types.String `tfsdk:"connection_type"`
-	Id             types.String `tfsdk:"id"`
-	PublicIp       types.String `tfsdk:"public_ip"`
-	State          types.String `tfsdk:"state"`
+	BgpAsn         types.Int64    `tfsdk:"bgp_asn"`
+	ConnectionType types.String   `tfsdk:"connection_type"`
+	Id             types.String   `tfsdk:"id"`
+	PublicIp       types.String   `tfsdk:"public_ip"`
+	SpaceId        types.String   `tfsdk:"space_id"`
+	State          types.String   `tfsdk:"state"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
@@ -47,7 +47,12 @@ func (d *computeBridgeDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	read, err := core.ReadComputeBridges(ctx, d.provider)
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := core.ReadComputeBridges(ctx, provider)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading compute bridges", err.Error())
 		return
@@ -60,6 +65,7 @@ func (d *computeBridgeDataSource) Read(ctx context.Context, req datasource.ReadR
 
 	state = plan
 	state.Items = computeBridgeItems.Items
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -12,6 +12,7 @@ import (
 	"terraform-provider-numspot/internal/sdk/api"
 	"terraform-provider-numspot/internal/services"
 	"terraform-provider-numspot/internal/services/computebridge/resource_compute_bridge"
+	"terraform-provider-numspot/internal/utils"
 )
 
 var _ resource.Resource = &computeBridgeResource{}
@@ -34,6 +35,7 @@ func (r *computeBridgeResource) Configure(_ context.Context, request resource.Co
 }
 
 func (r *computeBridgeResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
@@ -52,14 +54,27 @@ func (r *computeBridgeResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	VpcA := plan.SourceVpcId.ValueString()
 	VpcB := plan.DestinationVpcId.ValueString()
 
 	body := deserializeComputeBridge(plan)
 
-	numSpot, err := core.CreateComputeBridge(ctx, r.provider, body)
+	numSpot, err := core.CreateComputeBridge(ctx, provider, body)
 	if err != nil {
-		resp.Diagnostics.AddError("unable to create compute bridge", err.Error())
+		resp.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create compute bridge", err, req.Plan)...)
 		return
 	}
 
@@ -68,6 +83,8 @@ func (r *computeBridgeResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	data.SpaceId = types.StringValue(provider.SpaceID.String())
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -79,20 +96,49 @@ func (r *computeBridgeResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	readTimeout, diags := plan.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	VpcA := plan.SourceVpcId.ValueString()
 	VpcB := plan.DestinationVpcId.ValueString()
 	id := uuid.MustParse(plan.Id.ValueString())
-	computeBridge, err := core.ReadComputeBridge(ctx, r.provider, id)
+	computeBridge, err := core.ReadComputeBridge(ctx, provider, id)
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("unable to read compute bridge", err.Error())
 		return
 	}
 
 	newPlan := serializeComputeBridge(computeBridge, VpcA, VpcB)
+	newPlan.SpaceId = types.StringValue(provider.SpaceID.String())
+	newPlan.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newPlan)...)
 }
 
 func (r *computeBridgeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan resource_compute_bridge.ComputeBridgeModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// No implementation at this time, only the timeouts are persisted
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *computeBridgeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -102,8 +148,21 @@ func (r *computeBridgeResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := uuid.MustParse(plan.Id.ValueString())
-	if err := core.DeleteComputeBridge(ctx, r.provider, id); err != nil {
+	if err := core.DeleteComputeBridge(ctx, provider, id); err != nil {
 		resp.Diagnostics.AddError("unable to delete Compute bridge", err.Error())
 		return
 	}
@@ -46,12 +46,19 @@ func ComputeBridgeDataSourceSchema(ctx context.Context) schema.Schema {
 				},
 				Computed: true,
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
 		},
 	}
 }
 
 type ComputeBridgeModel struct {
-	Items types.List `tfsdk:"items"`
+	Items   types.List   `tfsdk:"items"`
+	SpaceId types.String `tfsdk:"space_id"`
 }
 
 var _ basetypes.ObjectTypable = ItemsType{}
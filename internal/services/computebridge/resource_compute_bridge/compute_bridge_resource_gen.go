@@ -4,8 +4,11 @@ package resource_compute_bridge
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -38,15 +41,35 @@ func ComputeBridgeResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Source VPC identifier.",
 				MarkdownDescription: "Source VPC identifier.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
 type ComputeBridgeModel struct {
-	DestinationIpRange types.String `tfsdk:"destination_ip_range"`
-	DestinationVpcId   types.String `tfsdk:"destination_vpc_id"`
-	GatewayId          types.String `tfsdk:"gateway_id"`
-	Id                 types.String `tfsdk:"id"`
-	SourceIpRange      types.String `tfsdk:"source_ip_range"`
-	SourceVpcId        types.String `tfsdk:"source_vpc_id"`
+	DestinationIpRange types.String   `tfsdk:"destination_ip_range"`
+	DestinationVpcId   types.String   `tfsdk:"destination_vpc_id"`
+	GatewayId          types.String   `tfsdk:"gateway_id"`
+	Id                 types.String   `tfsdk:"id"`
+	SourceIpRange      types.String   `tfsdk:"source_ip_range"`
+	SourceVpcId        types.String   `tfsdk:"source_vpc_id"`
+	SpaceId            types.String   `tfsdk:"space_id"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
@@ -49,12 +49,17 @@ func (d *dhcpOptionsDataSource) Read(ctx context.Context, request datasource.Rea
 		return
 	}
 
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	dhcpOptionParams := deserializeReadDHCPOptions(ctx, plan, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	dhcpOptions, err := core.ReadDHCPOptions(ctx, d.provider, dhcpOptionParams)
+	dhcpOptions, err := core.ReadDHCPOptions(ctx, provider, dhcpOptionParams)
 	if err != nil {
 		response.Diagnostics.AddError("unable to read dhcp options", err.Error())
 		return
@@ -72,6 +77,7 @@ func (d *dhcpOptionsDataSource) Read(ctx context.Context, request datasource.Rea
 
 	state = plan
 	state.Items = listValueItems
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
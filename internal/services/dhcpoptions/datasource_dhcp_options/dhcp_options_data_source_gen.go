@@ -130,6 +130,12 @@ func DhcpOptionsDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The IPs of the Network Time Protocol (NTP) servers used for the DHCP options sets.",
 				MarkdownDescription: "The IPs of the Network Time Protocol (NTP) servers used for the DHCP options sets.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
 			"tag_keys": schema.ListAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
@@ -156,16 +162,17 @@ func DhcpOptionsDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type DhcpOptionsModel struct {
-	Default           types.Bool `tfsdk:"default"`
-	DomainNameServers types.List `tfsdk:"domain_name_servers"`
-	DomainNames       types.List `tfsdk:"domain_names"`
-	Ids               types.List `tfsdk:"ids"`
-	Items             types.List `tfsdk:"items"`
-	LogServers        types.List `tfsdk:"log_servers"`
-	NtpServers        types.List `tfsdk:"ntp_servers"`
-	TagKeys           types.List `tfsdk:"tag_keys"`
-	TagValues         types.List `tfsdk:"tag_values"`
-	Tags              types.List `tfsdk:"tags"`
+	Default           types.Bool   `tfsdk:"default"`
+	DomainNameServers types.List   `tfsdk:"domain_name_servers"`
+	DomainNames       types.List   `tfsdk:"domain_names"`
+	Ids               types.List   `tfsdk:"ids"`
+	Items             types.List   `tfsdk:"items"`
+	LogServers        types.List   `tfsdk:"log_servers"`
+	NtpServers        types.List   `tfsdk:"ntp_servers"`
+	SpaceId           types.String `tfsdk:"space_id"`
+	TagKeys           types.List   `tfsdk:"tag_keys"`
+	TagValues         types.List   `tfsdk:"tag_values"`
+	Tags              types.List   `tfsdk:"tags"`
 }
 
 var _ basetypes.ObjectTypable = ItemsType{}
@@ -41,6 +41,7 @@ func (r *dhcpOptionsResource) Configure(_ context.Context, request resource.Conf
 }
 
 func (r *dhcpOptionsResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
@@ -70,11 +71,24 @@ func (r *dhcpOptionsResource) Create(ctx context.Context, request resource.Creat
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	apiTags := dhcpTags(ctx, plan.Tags)
 
-	numSpotDHCPOptions, err := core.CreateDHCPOptions(ctx, r.provider, deserializeDHCPOption(ctx, plan), apiTags)
+	numSpotDHCPOptions, err := core.CreateDHCPOptions(ctx, provider, deserializeDHCPOption(ctx, plan), apiTags)
 	if err != nil {
-		response.Diagnostics.AddError("unable to create dhcp options", err.Error())
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create dhcp options", err, request.Plan)...)
 		return
 	}
 
@@ -83,6 +97,8 @@ func (r *dhcpOptionsResource) Create(ctx context.Context, request resource.Creat
 		return
 	}
 
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
@@ -93,9 +109,26 @@ func (r *dhcpOptionsResource) Read(ctx context.Context, request resource.ReadReq
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	dhcpOptionsID := state.Id.ValueString()
 
-	dhcpOptions, err := core.ReadDHCPOption(ctx, r.provider, dhcpOptionsID)
+	dhcpOptions, err := core.ReadDHCPOption(ctx, provider, dhcpOptionsID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError("unable to read dhcp options", err.Error())
 		return
@@ -106,6 +139,8 @@ func (r *dhcpOptionsResource) Read(ctx context.Context, request resource.ReadReq
 		return
 	}
 
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 }
 
@@ -126,14 +161,27 @@ func (r *dhcpOptionsResource) Update(ctx context.Context, request resource.Updat
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	dhcpOptionsID := state.Id.ValueString()
 	stateTags := dhcpTags(ctx, state.Tags)
 	planTags := dhcpTags(ctx, plan.Tags)
 
 	if !plan.Tags.Equal(state.Tags) {
-		numSpotDHCPOptions, err = core.UpdateDHCPOptionsTags(ctx, r.provider, dhcpOptionsID, stateTags, planTags)
+		numSpotDHCPOptions, err = core.UpdateDHCPOptionsTags(ctx, provider, dhcpOptionsID, stateTags, planTags)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update dhcp options tags", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update dhcp options tags", err, request.Plan)...)
 			return
 		}
 
@@ -142,6 +190,8 @@ func (r *dhcpOptionsResource) Update(ctx context.Context, request resource.Updat
 			return
 		}
 
+		newState.SpaceId = types.StringValue(provider.SpaceID.String())
+		newState.Timeouts = plan.Timeouts
 		response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 	}
 }
@@ -153,9 +203,22 @@ func (r *dhcpOptionsResource) Delete(ctx context.Context, request resource.Delet
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	dhcpOptionsID := state.Id.ValueString()
 
-	if err := core.DeleteDHCPOptions(ctx, r.provider, dhcpOptionsID); err != nil {
+	if err := core.DeleteDHCPOptions(ctx, provider, dhcpOptionsID); err != nil {
 		response.Diagnostics.AddError("unable to delete dhcp options", err.Error())
 		return
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -70,6 +71,16 @@ func DhcpOptionsResourceSchema(ctx context.Context) schema.Schema {
 					listplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"tags": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -96,17 +107,27 @@ func DhcpOptionsResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "One or more tags associated with the DHCP options set.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type DhcpOptionsModel struct {
-	Default           types.Bool   `tfsdk:"default"`
-	DomainName        types.String `tfsdk:"domain_name"`
-	DomainNameServers types.List   `tfsdk:"domain_name_servers"`
-	Id                types.String `tfsdk:"id"`
-	LogServers        types.List   `tfsdk:"log_servers"`
-	NtpServers        types.List   `tfsdk:"ntp_servers"`
-	Tags              types.Set    `tfsdk:"tags"`
+	Default           types.Bool     `tfsdk:"default"`
+	DomainName        types.String   `tfsdk:"domain_name"`
+	DomainNameServers types.List     `tfsdk:"domain_name_servers"`
+	Id                types.String   `tfsdk:"id"`
+	LogServers        types.List     `tfsdk:"log_servers"`
+	NtpServers        types.List     `tfsdk:"ntp_servers"`
+	SpaceId           types.String   `tfsdk:"space_id"`
+	Tags              types.Set      `tfsdk:"tags"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 var _ basetypes.ObjectTypable = TagsType{}
@@ -0,0 +1,96 @@
+package directlink
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/directlink/datasource_direct_link"
+)
+
+var _ datasource.DataSource = &directLinksDataSource{}
+
+type directLinksDataSource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewDirectLinksDataSource() datasource.DataSource {
+	return &directLinksDataSource{}
+}
+
+func (d *directLinksDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	d.provider = services.ConfigureProviderDatasource(request, response)
+}
+
+func (d *directLinksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_direct_links"
+}
+
+func (d *directLinksDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_direct_link.DirectLinkDataSourceSchema(ctx)
+}
+
+func (d *directLinksDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state, plan datasource_direct_link.DirectLinkModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	directLinks, err := core.ReadDirectLinks(ctx, provider)
+	if err != nil {
+		response.Diagnostics.AddError("unable to read direct links", err.Error())
+		return
+	}
+
+	items := serializeDirectLinksDatasource(ctx, directLinks, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state = plan
+	state.Items = items
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func serializeDirectLinksDatasource(ctx context.Context, directLinks []api.DirectLink, diags *diag.Diagnostics) types.List {
+	itemsValue := make([]datasource_direct_link.ItemsValue, 0, len(directLinks))
+
+	for _, directLink := range directLinks {
+		item, serializeDiags := datasource_direct_link.NewItemsValue(datasource_direct_link.ItemsValue{}.AttributeTypes(ctx), map[string]attr.Value{
+			"bandwidth":   types.StringValue(directLink.Bandwidth),
+			"id":          types.StringValue(directLink.Id.String()),
+			"location":    types.StringValue(directLink.Location),
+			"name":        types.StringValue(directLink.Name),
+			"region_name": types.StringValue(directLink.RegionName),
+			"state":       types.StringValue(directLink.State),
+		})
+		if serializeDiags.HasError() {
+			diags.Append(serializeDiags...)
+			continue
+		}
+		itemsValue = append(itemsValue, item)
+	}
+
+	list, serializeDiags := types.ListValueFrom(ctx, new(datasource_direct_link.ItemsValue).Type(ctx), itemsValue)
+	diags.Append(serializeDiags...)
+
+	return list
+}
@@ -0,0 +1,191 @@
+package directlink
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/directlink/resource_direct_link"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &directLinkResource{}
+	_ resource.ResourceWithConfigure   = &directLinkResource{}
+	_ resource.ResourceWithImportState = &directLinkResource{}
+)
+
+type directLinkResource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewDirectLinkResource() resource.Resource {
+	return &directLinkResource{}
+}
+
+func (r *directLinkResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.provider = services.ConfigureProviderResource(request, response)
+}
+
+func (r *directLinkResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+func (r *directLinkResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_direct_link"
+}
+
+func (r *directLinkResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resource_direct_link.DirectLinkResourceSchema(ctx)
+}
+
+// Create waits until the DirectLink is available, which happens once its request is validated and the physical link
+// is established
+func (r *directLinkResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan resource_direct_link.DirectLinkModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	directLink, err := core.CreateDirectLink(ctx, provider, deserializeCreateDirectLink(plan))
+	if err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create direct link", err, request.Plan)...)
+		return
+	}
+
+	state := serializeDirectLink(directLink)
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *directLinkResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state resource_direct_link.DirectLinkModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	directLinkID, err := uuid.Parse(state.Id.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	directLink, err := core.ReadDirectLink(ctx, provider, directLinkID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError("unable to read direct link", err.Error())
+		return
+	}
+
+	newState := serializeDirectLink(directLink)
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *directLinkResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state, plan resource_direct_link.DirectLinkModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The API cannot update direct links, every other attribute requires a replacement
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *directLinkResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state resource_direct_link.DirectLinkModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	directLinkID, err := uuid.Parse(state.Id.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	if err = core.DeleteDirectLink(ctx, provider, directLinkID); err != nil && !utils.IsNotFound(err) {
+		response.Diagnostics.AddError("unable to delete direct link", err.Error())
+		return
+	}
+}
+
+func deserializeCreateDirectLink(tf resource_direct_link.DirectLinkModel) api.CreateDirectLinkJSONRequestBody {
+	return api.CreateDirectLinkJSONRequestBody{
+		Bandwidth: tf.Bandwidth.ValueString(),
+		Location:  tf.Location.ValueString(),
+		Name:      tf.Name.ValueString(),
+	}
+}
+
+func serializeDirectLink(http *api.DirectLink) resource_direct_link.DirectLinkModel {
+	return resource_direct_link.DirectLinkModel{
+		Bandwidth:  types.StringValue(http.Bandwidth),
+		Id:         types.StringValue(http.Id.String()),
+		Location:   types.StringValue(http.Location),
+		Name:       types.StringValue(http.Name),
+		RegionName: types.StringValue(http.RegionName),
+		State:      types.StringValue(http.State),
+	}
+}
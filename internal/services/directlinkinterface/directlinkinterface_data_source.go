@@ -0,0 +1,102 @@
+package directlinkinterface
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/directlinkinterface/datasource_direct_link_interface"
+)
+
+var _ datasource.DataSource = &directLinkInterfacesDataSource{}
+
+type directLinkInterfacesDataSource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewDirectLinkInterfacesDataSource() datasource.DataSource {
+	return &directLinkInterfacesDataSource{}
+}
+
+func (d *directLinkInterfacesDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	d.provider = services.ConfigureProviderDatasource(request, response)
+}
+
+func (d *directLinkInterfacesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_direct_link_interfaces"
+}
+
+func (d *directLinkInterfacesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_direct_link_interface.DirectLinkInterfaceDataSourceSchema(ctx)
+}
+
+func (d *directLinkInterfacesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state, plan datasource_direct_link_interface.DirectLinkInterfaceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	directLinkInterfaces, err := core.ReadDirectLinkInterfaces(ctx, provider)
+	if err != nil {
+		response.Diagnostics.AddError("unable to read direct link interfaces", err.Error())
+		return
+	}
+
+	items := serializeDirectLinkInterfacesDatasource(ctx, directLinkInterfaces, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state = plan
+	state.Items = items
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func serializeDirectLinkInterfacesDatasource(ctx context.Context, directLinkInterfaces []api.DirectLinkInterface, diags *diag.Diagnostics) types.List {
+	itemsValue := make([]datasource_direct_link_interface.ItemsValue, 0, len(directLinkInterfaces))
+
+	for _, directLinkInterface := range directLinkInterfaces {
+		item, serializeDiags := datasource_direct_link_interface.NewItemsValue(datasource_direct_link_interface.ItemsValue{}.AttributeTypes(ctx), map[string]attr.Value{
+			"bgp_asn":            types.Int64Value(int64(directLinkInterface.BgpAsn)),
+			"client_private_ip":  types.StringValue(directLinkInterface.ClientPrivateIp),
+			"direct_link_id":     types.StringValue(directLinkInterface.DirectLinkId),
+			"id":                 types.StringValue(directLinkInterface.Id.String()),
+			"interface_type":     types.StringValue(directLinkInterface.InterfaceType),
+			"location":           types.StringValue(directLinkInterface.Location),
+			"mtu":                types.Int64Value(int64(directLinkInterface.Mtu)),
+			"name":               types.StringValue(directLinkInterface.DirectLinkInterfaceName),
+			"numspot_private_ip": types.StringValue(directLinkInterface.NumspotPrivateIp),
+			"state":              types.StringValue(directLinkInterface.State),
+			"virtual_gateway_id": types.StringValue(directLinkInterface.VirtualGatewayId),
+			"vlan":               types.Int64Value(int64(directLinkInterface.Vlan)),
+		})
+		if serializeDiags.HasError() {
+			diags.Append(serializeDiags...)
+			continue
+		}
+		itemsValue = append(itemsValue, item)
+	}
+
+	list, serializeDiags := types.ListValueFrom(ctx, new(datasource_direct_link_interface.ItemsValue).Type(ctx), itemsValue)
+	diags.Append(serializeDiags...)
+
+	return list
+}
@@ -0,0 +1,202 @@
+package directlinkinterface
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/directlinkinterface/resource_direct_link_interface"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &directLinkInterfaceResource{}
+	_ resource.ResourceWithConfigure   = &directLinkInterfaceResource{}
+	_ resource.ResourceWithImportState = &directLinkInterfaceResource{}
+)
+
+type directLinkInterfaceResource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewDirectLinkInterfaceResource() resource.Resource {
+	return &directLinkInterfaceResource{}
+}
+
+func (r *directLinkInterfaceResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.provider = services.ConfigureProviderResource(request, response)
+}
+
+func (r *directLinkInterfaceResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+func (r *directLinkInterfaceResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_direct_link_interface"
+}
+
+func (r *directLinkInterfaceResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resource_direct_link_interface.DirectLinkInterfaceResourceSchema(ctx)
+}
+
+func (r *directLinkInterfaceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan resource_direct_link_interface.DirectLinkInterfaceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	directLinkInterface, err := core.CreateDirectLinkInterface(ctx, provider, deserializeCreateDirectLinkInterface(plan))
+	if err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create direct link interface", err, request.Plan)...)
+		return
+	}
+
+	state := serializeDirectLinkInterface(directLinkInterface, plan)
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *directLinkInterfaceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state resource_direct_link_interface.DirectLinkInterfaceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	directLinkInterfaceID, err := uuid.Parse(state.Id.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	directLinkInterface, err := core.ReadDirectLinkInterface(ctx, provider, directLinkInterfaceID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError("unable to read direct link interface", err.Error())
+		return
+	}
+
+	newState := serializeDirectLinkInterface(directLinkInterface, state)
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *directLinkInterfaceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state, plan resource_direct_link_interface.DirectLinkInterfaceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The API cannot update direct link interfaces, every other attribute requires a replacement
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *directLinkInterfaceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state resource_direct_link_interface.DirectLinkInterfaceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	directLinkInterfaceID, err := uuid.Parse(state.Id.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	if err = core.DeleteDirectLinkInterface(ctx, provider, directLinkInterfaceID); err != nil && !utils.IsNotFound(err) {
+		response.Diagnostics.AddError("unable to delete direct link interface", err.Error())
+		return
+	}
+}
+
+func deserializeCreateDirectLinkInterface(tf resource_direct_link_interface.DirectLinkInterfaceModel) api.CreateDirectLinkInterfaceJSONRequestBody {
+	return api.CreateDirectLinkInterfaceJSONRequestBody{
+		BgpAsn:           utils.FromTfInt64ToInt(tf.BgpAsn),
+		BgpKey:           tf.BgpKey.ValueStringPointer(),
+		ClientPrivateIp:  utils.FromTfStringToStringPtr(tf.ClientPrivateIp),
+		DirectLinkId:     tf.DirectLinkId.ValueString(),
+		Name:             tf.Name.ValueString(),
+		NumspotPrivateIp: utils.FromTfStringToStringPtr(tf.NumspotPrivateIp),
+		VirtualGatewayId: tf.VirtualGatewayId.ValueString(),
+		Vlan:             utils.FromTfInt64ToInt(tf.Vlan),
+	}
+}
+
+// serializeDirectLinkInterface keeps the BGP key of the model, the API never returns it
+func serializeDirectLinkInterface(http *api.DirectLinkInterface, tf resource_direct_link_interface.DirectLinkInterfaceModel) resource_direct_link_interface.DirectLinkInterfaceModel {
+	return resource_direct_link_interface.DirectLinkInterfaceModel{
+		BgpAsn:           types.Int64Value(int64(http.BgpAsn)),
+		BgpKey:           tf.BgpKey,
+		ClientPrivateIp:  types.StringValue(http.ClientPrivateIp),
+		DirectLinkId:     types.StringValue(http.DirectLinkId),
+		Id:               types.StringValue(http.Id.String()),
+		InterfaceType:    types.StringValue(http.InterfaceType),
+		Location:         types.StringValue(http.Location),
+		Mtu:              types.Int64Value(int64(http.Mtu)),
+		Name:             types.StringValue(http.DirectLinkInterfaceName),
+		NumspotPrivateIp: types.StringValue(http.NumspotPrivateIp),
+		State:            types.StringValue(http.State),
+		VirtualGatewayId: types.StringValue(http.VirtualGatewayId),
+		Vlan:             types.Int64Value(int64(http.Vlan)),
+	}
+}
@@ -101,6 +101,12 @@ func FlexibleGpuDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "One or more models of fGPUs.",
 				MarkdownDescription: "One or more models of fGPUs.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
 			"states": schema.ListAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
@@ -120,14 +126,15 @@ func FlexibleGpuDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type FlexibleGpuModel struct {
-	AvailabilityZoneNames types.List `tfsdk:"availability_zone_names"`
-	DeleteOnVmDeletion    types.Bool `tfsdk:"delete_on_vm_deletion"`
-	Generations           types.List `tfsdk:"generations"`
-	Ids                   types.List `tfsdk:"ids"`
-	Items                 types.List `tfsdk:"items"`
-	ModelNames            types.List `tfsdk:"model_names"`
-	States                types.List `tfsdk:"states"`
-	VmIds                 types.List `tfsdk:"vm_ids"`
+	AvailabilityZoneNames types.List   `tfsdk:"availability_zone_names"`
+	DeleteOnVmDeletion    types.Bool   `tfsdk:"delete_on_vm_deletion"`
+	Generations           types.List   `tfsdk:"generations"`
+	Ids                   types.List   `tfsdk:"ids"`
+	Items                 types.List   `tfsdk:"items"`
+	ModelNames            types.List   `tfsdk:"model_names"`
+	SpaceId               types.String `tfsdk:"space_id"`
+	States                types.List   `tfsdk:"states"`
+	VmIds                 types.List   `tfsdk:"vm_ids"`
 }
 
 var _ basetypes.ObjectTypable = ItemsType{}
@@ -48,7 +48,12 @@ func (d *flexibleGpusDataSource) Read(ctx context.Context, request datasource.Re
 		return
 	}
 
-	numspotClient, err := d.provider.GetClient(ctx)
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	numspotClient, err := provider.GetClient(ctx)
 	if err != nil {
 		response.Diagnostics.AddError("Error while initiating numspotClient", err.Error())
 		return
@@ -56,13 +61,13 @@ func (d *flexibleGpusDataSource) Read(ctx context.Context, request datasource.Re
 
 	params := deserializeFlexibleGPUDataSource(ctx, plan, &response.Diagnostics)
 
-	res, err := numspotClient.ReadFlexibleGpusWithResponse(ctx, d.provider.SpaceID, &params)
+	res, err := numspotClient.ReadFlexibleGpusWithResponse(ctx, provider.SpaceID, &params)
 	if err != nil {
 		response.Diagnostics.AddError("unable to read flexible gpus", err.Error())
 		return
 	}
 
-	if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
 		response.Diagnostics.AddError("unable to read flexible gpus", err.Error())
 		return
 	}
@@ -80,6 +85,7 @@ func (d *flexibleGpusDataSource) Read(ctx context.Context, request datasource.Re
 
 	state = plan
 	state.Items = listValueItems
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -38,6 +38,7 @@ func (r *Resource) Configure(_ context.Context, request resource.ConfigureReques
 }
 
 func (r *Resource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
@@ -49,8 +50,8 @@ func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, respons
 	response.Schema = resource_flexible_gpu.FlexibleGpuResourceSchema(ctx)
 }
 
-func (r *Resource) linkVm(ctx context.Context, gpuId string, data resource_flexible_gpu.FlexibleGpuModel, diags *diag.Diagnostics) {
-	numspotClient, err := r.provider.GetClient(ctx)
+func linkVm(ctx context.Context, provider *client.NumSpotSDK, gpuId string, data resource_flexible_gpu.FlexibleGpuModel, diags *diag.Diagnostics) {
+	numspotClient, err := provider.GetClient(ctx)
 	if err != nil {
 		diags.AddError("Error while initiating numspotClient", err.Error())
 		return
@@ -58,13 +59,13 @@ func (r *Resource) linkVm(ctx context.Context, gpuId string, data resource_flexi
 	// Link GPU to VM
 	body := deserializeLinkFlexibleGPU(&data)
 
-	res, err := numspotClient.LinkFlexibleGpuWithResponse(ctx, r.provider.SpaceID, gpuId, body)
+	res, err := numspotClient.LinkFlexibleGpuWithResponse(ctx, provider.SpaceID, gpuId, body)
 	if err != nil {
 		diags.AddError("Error while linking Flexible Gpu", err.Error())
 		return
 	}
 
-	if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
 		diags.AddError("Error while parsing Flexible Gpu response", err.Error())
 		return
 	}
@@ -83,21 +84,21 @@ func (r *Resource) linkVm(ctx context.Context, gpuId string, data resource_flexi
 	//}
 }
 
-func (r *Resource) unlinkVm(ctx context.Context, gpuId string, _ resource_flexible_gpu.FlexibleGpuModel, diags *diag.Diagnostics) {
-	numspotClient, err := r.provider.GetClient(ctx)
+func unlinkVm(ctx context.Context, provider *client.NumSpotSDK, gpuId string, _ resource_flexible_gpu.FlexibleGpuModel, diags *diag.Diagnostics) {
+	numspotClient, err := provider.GetClient(ctx)
 	if err != nil {
 		diags.AddError("Error while initiating numspotClient", err.Error())
 		return
 	}
 	// Unlink GPU from any VM
 
-	res, err := numspotClient.UnlinkFlexibleGpuWithResponse(ctx, r.provider.SpaceID, gpuId)
+	res, err := numspotClient.UnlinkFlexibleGpuWithResponse(ctx, provider.SpaceID, gpuId)
 	if err != nil {
 		diags.AddError("Error while unlinking Flexible Gpu", err.Error())
 		return
 	}
 
-	if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
 		diags.AddError("Error while parsing Flexible Gpu response", err.Error())
 		return
 	}
@@ -120,7 +121,20 @@ func (r *Resource) Create(ctx context.Context, request resource.CreateRequest, r
 		return
 	}
 
-	numspotClient, err := r.provider.GetClient(ctx)
+	createTimeout, diags := data.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, data.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	numspotClient, err := provider.GetClient(ctx)
 	if err != nil {
 		response.Diagnostics.AddError("Error while initiating numspotClient", err.Error())
 		return
@@ -128,18 +142,18 @@ func (r *Resource) Create(ctx context.Context, request resource.CreateRequest, r
 
 	res, err := utils.RetryCreateUntilResourceAvailableWithBody(
 		ctx,
-		r.provider.SpaceID,
+		provider.SpaceID,
 		deserializeCreateFlexibleGPU(&data),
 		numspotClient.CreateFlexibleGpuWithResponse)
 	if err != nil {
-		response.Diagnostics.AddError("Failed to create Flexible GPU", err.Error())
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "Failed to create Flexible GPU", err, request.Plan)...)
 		return
 	}
 
 	createdId := *res.JSON201.Id
 
 	if !(data.VmId.IsNull() || data.VmId.IsUnknown()) {
-		r.linkVm(ctx, createdId, data, &response.Diagnostics)
+		linkVm(ctx, provider, createdId, data, &response.Diagnostics)
 		if response.Diagnostics.HasError() {
 			return
 		}
@@ -148,7 +162,7 @@ func (r *Resource) Create(ctx context.Context, request resource.CreateRequest, r
 	read, err := utils.RetryReadUntilStateValid(
 		ctx,
 		createdId,
-		r.provider.SpaceID,
+		provider.SpaceID,
 		[]string{"attaching", "detaching"},
 		[]string{"allocated", "attached"},
 		numspotClient.ReadFlexibleGpusByIdWithResponse,
@@ -165,27 +179,26 @@ func (r *Resource) Create(ctx context.Context, request resource.CreateRequest, r
 	}
 	tf := serializeFlexibleGPU(flexGPU)
 
+	tf.SpaceId = types.StringValue(provider.SpaceID.String())
+	tf.Timeouts = data.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &tf)...)
 }
 
-func (r *Resource) read(ctx context.Context, id string, diags *diag.Diagnostics) *api.FlexibleGpu {
-	numspotClient, err := r.provider.GetClient(ctx)
+func read(ctx context.Context, provider *client.NumSpotSDK, id string) (*api.FlexibleGpu, error) {
+	numspotClient, err := provider.GetClient(ctx)
 	if err != nil {
-		diags.AddError("Error while initiating numspotClient", err.Error())
-		return nil
+		return nil, err
 	}
-	res, err := numspotClient.ReadFlexibleGpusByIdWithResponse(ctx, r.provider.SpaceID, id)
+	res, err := numspotClient.ReadFlexibleGpusByIdWithResponse(ctx, provider.SpaceID, id)
 	if err != nil {
-		diags.AddError("Failed to read RouteTable", err.Error())
-		return nil
+		return nil, err
 	}
 
-	if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
-		diags.AddError("Failed to read RouteTable", err.Error())
-		return nil
+	if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+		return nil, err
 	}
 
-	return res.JSON200
+	return res.JSON200, nil
 }
 
 func (r *Resource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -195,12 +208,32 @@ func (r *Resource) Read(ctx context.Context, request resource.ReadRequest, respo
 		return
 	}
 
-	gpu := r.read(ctx, data.Id.ValueString(), &response.Diagnostics)
-	if gpu == nil {
+	readTimeout, diags := data.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, data.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	gpu, err := read(ctx, provider, data.Id.ValueString())
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError("Failed to read Flexible GPU", err.Error())
 		return
 	}
 
 	tf := serializeFlexibleGPU(gpu)
+	tf.SpaceId = types.StringValue(provider.SpaceID.String())
+	tf.Timeouts = data.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &tf)...)
 }
 
@@ -212,7 +245,20 @@ func (r *Resource) Update(ctx context.Context, request resource.UpdateRequest, r
 		return
 	}
 
-	numspotClient, err := r.provider.GetClient(ctx)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	numspotClient, err := provider.GetClient(ctx)
 	if err != nil {
 		response.Diagnostics.AddError("Error while initiating numspotClient", err.Error())
 		return
@@ -221,19 +267,19 @@ func (r *Resource) Update(ctx context.Context, request resource.UpdateRequest, r
 	// Handle changes in VM association
 	if plan.VmId.ValueString() != state.VmId.ValueString() {
 		if state.VmId.IsNull() || state.VmId.IsUnknown() { // If GPU is not linked to any VM, we want to link it
-			r.linkVm(ctx, state.Id.ValueString(), plan, &response.Diagnostics)
+			linkVm(ctx, provider, state.Id.ValueString(), plan, &response.Diagnostics)
 			if response.Diagnostics.HasError() {
 				return
 			}
 
 		} else if plan.VmId.IsNull() || plan.VmId.IsUnknown() { // If GPU is linked to a VM, we want to unlink it
 			var diagnostics diag.Diagnostics // Use a temporary diag because some errors might be ok here
-			r.unlinkVm(ctx, state.Id.ValueString(), state, &diagnostics)
+			unlinkVm(ctx, provider, state.Id.ValueString(), state, &diagnostics)
 			if diagnostics.HasError() {
 				_, err = utils.RetryReadUntilStateValid(
 					ctx,
 					state.Id.ValueString(),
-					r.provider.SpaceID,
+					provider.SpaceID,
 					[]string{"detaching"},
 					[]string{"allocated"},
 					numspotClient.ReadFlexibleGpusByIdWithResponse,
@@ -245,12 +291,12 @@ func (r *Resource) Update(ctx context.Context, request resource.UpdateRequest, r
 			}
 		} else { // Gpu is linked to a VM, we want to link it to another
 			var diagnostics diag.Diagnostics // Use a temporary diag because some errors might be ok here
-			r.unlinkVm(ctx, state.Id.ValueString(), state, &diagnostics)
+			unlinkVm(ctx, provider, state.Id.ValueString(), state, &diagnostics)
 			if diagnostics.HasError() {
 				_, err = utils.RetryReadUntilStateValid(
 					ctx,
 					state.Id.ValueString(),
-					r.provider.SpaceID,
+					provider.SpaceID,
 					[]string{"detaching"},
 					[]string{"allocated"},
 					numspotClient.ReadFlexibleGpusByIdWithResponse,
@@ -260,7 +306,7 @@ func (r *Resource) Update(ctx context.Context, request resource.UpdateRequest, r
 					response.Diagnostics.AddError("Failed while waiting for GPU to get unlinked", err.Error())
 				}
 			}
-			r.linkVm(ctx, state.Id.ValueString(), plan, &response.Diagnostics)
+			linkVm(ctx, provider, state.Id.ValueString(), plan, &response.Diagnostics)
 			if response.Diagnostics.HasError() {
 				return
 			}
@@ -271,24 +317,27 @@ func (r *Resource) Update(ctx context.Context, request resource.UpdateRequest, r
 	if plan.DeleteOnVmDeletion != state.DeleteOnVmDeletion {
 		body := deserializeUpdateFlexibleGPU(&plan)
 
-		res, err := numspotClient.UpdateFlexibleGpuWithResponse(ctx, r.provider.SpaceID, state.Id.ValueString(), body)
+		res, err := numspotClient.UpdateFlexibleGpuWithResponse(ctx, provider.SpaceID, state.Id.ValueString(), body)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update flexible gpu", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update flexible gpu", err, request.Plan)...)
 			return
 		}
 
-		if err = utils.ParseHTTPError(res.Body, res.StatusCode()); err != nil {
-			response.Diagnostics.AddError("unable to update flexible gpu", err.Error())
+		if err = utils.ParseHTTPError(res.Body, res.StatusCode(), res.HTTPResponse.Header); err != nil {
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update flexible gpu", err, request.Plan)...)
 			return
 		}
 	}
 
-	gpu := r.read(ctx, state.Id.ValueString(), &response.Diagnostics)
-	if gpu == nil {
+	gpu, err := read(ctx, provider, state.Id.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("Failed to read Flexible GPU", err.Error())
 		return
 	}
 
 	tf := serializeFlexibleGPU(gpu)
+	tf.SpaceId = types.StringValue(provider.SpaceID.String())
+	tf.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &tf)...)
 }
 
@@ -299,7 +348,20 @@ func (r *Resource) Delete(ctx context.Context, request resource.DeleteRequest, r
 		return
 	}
 
-	numspotClient, err := r.provider.GetClient(ctx)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, data.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	numspotClient, err := provider.GetClient(ctx)
 	if err != nil {
 		response.Diagnostics.AddError("Error while initiating numspotClient", err.Error())
 		return
@@ -308,12 +370,12 @@ func (r *Resource) Delete(ctx context.Context, request resource.DeleteRequest, r
 	// Unlink GPU from VM if it's attached
 	if !(data.VmId.IsNull() || data.VmId.IsUnknown()) {
 		var diagnostics diag.Diagnostics // Use a temporary diag because some errors might be ok here
-		r.unlinkVm(ctx, data.Id.ValueString(), data, &diagnostics)
+		unlinkVm(ctx, provider, data.Id.ValueString(), data, &diagnostics)
 		if diagnostics.HasError() {
 			_, err = utils.RetryReadUntilStateValid(
 				ctx,
 				data.Id.ValueString(),
-				r.provider.SpaceID,
+				provider.SpaceID,
 				[]string{"detaching"},
 				[]string{"allocated"},
 				numspotClient.ReadFlexibleGpusByIdWithResponse,
@@ -326,7 +388,7 @@ func (r *Resource) Delete(ctx context.Context, request resource.DeleteRequest, r
 		}
 	}
 
-	err = utils.RetryDeleteUntilResourceAvailable(ctx, r.provider.SpaceID, data.Id.ValueString(), numspotClient.DeleteFlexibleGpuWithResponse)
+	err = utils.RetryDeleteUntilResourceAvailable(ctx, provider.SpaceID, data.Id.ValueString(), numspotClient.DeleteFlexibleGpuWithResponse)
 	if err != nil {
 		response.Diagnostics.AddError("Failed to delete Flexible GPU", err.Error())
 		return
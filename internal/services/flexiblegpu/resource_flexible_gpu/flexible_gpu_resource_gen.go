@@ -5,8 +5,11 @@ package resource_flexible_gpu
 import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -40,6 +43,16 @@ func FlexibleGpuResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The model of fGPU you want to allocate.",
 				MarkdownDescription: "The model of fGPU you want to allocate.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"state": schema.StringAttribute{
 				Computed:            true,
 				Description:         "The state of the fGPU (`allocated` \\| `attaching` \\| `attached` \\| `detaching`).",
@@ -52,15 +65,25 @@ func FlexibleGpuResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "The ID of the VM the fGPU is attached to, if any.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type FlexibleGpuModel struct {
-	AvailabilityZoneName types.String `tfsdk:"availability_zone_name"`
-	DeleteOnVmDeletion   types.Bool   `tfsdk:"delete_on_vm_deletion"`
-	Generation           types.String `tfsdk:"generation"`
-	Id                   types.String `tfsdk:"id"`
-	ModelName            types.String `tfsdk:"model_name"`
-	State                types.String `tfsdk:"state"`
-	VmId                 types.String `tfsdk:"vm_id"`
+	AvailabilityZoneName types.String   `tfsdk:"availability_zone_name"`
+	DeleteOnVmDeletion   types.Bool     `tfsdk:"delete_on_vm_deletion"`
+	Generation           types.String   `tfsdk:"generation"`
+	Id                   types.String   `tfsdk:"id"`
+	ModelName            types.String   `tfsdk:"model_name"`
+	SpaceId              types.String   `tfsdk:"space_id"`
+	State                types.String   `tfsdk:"state"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+	VmId                 types.String   `tfsdk:"vm_id"`
 }
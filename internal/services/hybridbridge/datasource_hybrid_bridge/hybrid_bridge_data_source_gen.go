@@ -53,12 +53,19 @@ func HybridBridgeDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "List of bridges.",
 				MarkdownDescription: "List of bridges.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
 		},
 	}
 }
 
 type HybridBridgeModel struct {
-	Items types.List `tfsdk:"items"`
+	Items   types.List   `tfsdk:"items"`
+	SpaceId types.String `tfsdk:"space_id"`
 }
 
 var _ basetypes.ObjectTypable = ItemsType{}
@@ -47,7 +47,12 @@ func (d *hybridBridgeDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	read, err := core.ReadHybridBridges(ctx, d.provider)
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := core.ReadHybridBridges(ctx, provider)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading hybrid bridges", err.Error())
 		return
@@ -60,6 +65,7 @@ func (d *hybridBridgeDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 	state = plan
 	state.Items = serverCertificateItems.Items
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -12,6 +12,7 @@ import (
 	"terraform-provider-numspot/internal/sdk/api"
 	"terraform-provider-numspot/internal/services"
 	"terraform-provider-numspot/internal/services/hybridbridge/resource_hybrid_bridge"
+	"terraform-provider-numspot/internal/utils"
 )
 
 var _ resource.Resource = &hybridBridgeResource{}
@@ -33,6 +34,7 @@ func (r *hybridBridgeResource) Configure(_ context.Context, request resource.Con
 }
 
 func (r *hybridBridgeResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
@@ -51,6 +53,19 @@ func (r *hybridBridgeResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	vpcId := plan.VpcId.ValueString()
 	serviceManagedId := plan.ManagedServiceId.ValueString()
 	body, err := deserializeHybridBridge(plan)
@@ -59,9 +74,9 @@ func (r *hybridBridgeResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	numSpot, err := core.CreateHybridBridge(ctx, r.provider, body)
+	numSpot, err := core.CreateHybridBridge(ctx, provider, body)
 	if err != nil {
-		resp.Diagnostics.AddError("unable to create hybrid bridge", err.Error())
+		resp.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create hybrid bridge", err, req.Plan)...)
 		return
 	}
 
@@ -71,6 +86,8 @@ func (r *hybridBridgeResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Save data into Terraform state
+	data.SpaceId = types.StringValue(provider.SpaceID.String())
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -81,6 +98,19 @@ func (r *hybridBridgeResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	readTimeout, diags := plan.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	vpcId := plan.VpcId.ValueString()
 	serviceManagedId := plan.ManagedServiceId.ValueString()
 	id, err := uuid.Parse(plan.Id.ValueString())
@@ -89,17 +119,33 @@ func (r *hybridBridgeResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	hybridBridge, err := core.ReadHybridBridge(ctx, r.provider, id)
+	hybridBridge, err := core.ReadHybridBridge(ctx, provider, id)
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("unable to read hybrid bridge", err.Error())
 		return
 	}
 
 	newPlan := serializeHybridBridge(hybridBridge, vpcId, serviceManagedId)
+	newPlan.SpaceId = types.StringValue(provider.SpaceID.String())
+	newPlan.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newPlan)...)
 }
 
 func (r *hybridBridgeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan resource_hybrid_bridge.HybridBridgeModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// No implementation at this time, only the timeouts are persisted
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *hybridBridgeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -109,13 +155,26 @@ func (r *hybridBridgeResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := uuid.Parse(plan.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("unable to read managed service bridge", err.Error())
 		return
 	}
 
-	if err := core.DeleteHybridBridge(ctx, r.provider, id); err != nil {
+	if err := core.DeleteHybridBridge(ctx, provider, id); err != nil {
 		resp.Diagnostics.AddError("unable to delete hybrid bridge", err.Error())
 		return
 	}
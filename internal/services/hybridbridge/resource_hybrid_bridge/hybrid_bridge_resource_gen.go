@@ -7,11 +7,14 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -44,18 +47,38 @@ func HybridBridgeResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The route object representation.",
 				MarkdownDescription: "The route object representation.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"vpc_id": schema.StringAttribute{
 				Required: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type HybridBridgeModel struct {
-	Id               types.String `tfsdk:"id"`
-	ManagedServiceId types.String `tfsdk:"managed_service_id"`
-	Route            RouteValue   `tfsdk:"route"`
-	VpcId            types.String `tfsdk:"vpc_id"`
+	Id               types.String   `tfsdk:"id"`
+	ManagedServiceId types.String   `tfsdk:"managed_service_id"`
+	Route            RouteValue     `tfsdk:"route"`
+	SpaceId          types.String   `tfsdk:"space_id"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+	VpcId            types.String   `tfsdk:"vpc_id"`
 }
 
 var _ basetypes.ObjectTypable = RouteType{}
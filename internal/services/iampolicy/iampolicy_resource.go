@@ -0,0 +1,264 @@
+package iampolicy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/iampolicy/resource_iam_policy"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &iamPolicyResource{}
+	_ resource.ResourceWithConfigure   = &iamPolicyResource{}
+	_ resource.ResourceWithImportState = &iamPolicyResource{}
+)
+
+// iamPolicyResource is authoritative, the roles and permissions of the subject not in the configuration are revoked
+type iamPolicyResource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewIAMPolicyResource() resource.Resource {
+	return &iamPolicyResource{}
+}
+
+func (r *iamPolicyResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.provider = services.ConfigureProviderResource(request, response)
+}
+
+// ImportState accepts subject_type/subject_id for the policy of a space, or organisation_id/subject_type/subject_id for the policy of an organisation
+func (r *iamPolicyResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
+
+	parts := strings.Split(request.ID, "/")
+	if len(parts) == 3 {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("organisation_id"), parts[0])...)
+		parts = parts[1:]
+	}
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		response.Diagnostics.AddError("unexpected import identifier", fmt.Sprintf("Expected import identifier with format: subject_type/subject_id or organisation_id/subject_type/subject_id. Got: %q", request.ID))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), parts[0]+"/"+parts[1])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("subject_type"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("subject_id"), parts[1])...)
+}
+
+func (r *iamPolicyResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_iam_policy"
+}
+
+func (r *iamPolicyResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resource_iam_policy.IamPolicyResourceSchema(ctx)
+}
+
+func (r *iamPolicyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan resource_iam_policy.IamPolicyModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	spaceID := r.setIAMPolicy(ctx, plan, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state := plan
+	state.Id = types.StringValue(plan.SubjectType.ValueString() + "/" + plan.SubjectId.ValueString())
+	state.SpaceId = spaceID
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *iamPolicyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state resource_iam_policy.IamPolicyModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	organisationID, subjectType, subjectID, err := parseSubject(state.OrganisationId, state.SubjectType, state.SubjectId)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	provider := services.ScopeProvider(r.provider, organisationID, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := core.ReadIAMPolicy(ctx, provider, organisationID, subjectType, subjectID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError("unable to read iam policy", err.Error())
+		return
+	}
+
+	state.Permissions = serializeIDs(ctx, policy.Permissions, state.Permissions, &response.Diagnostics)
+	state.Roles = serializeIDs(ctx, policy.Roles, state.Roles, &response.Diagnostics)
+	state.SpaceId = services.ScopeSpaceID(provider, organisationID)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *iamPolicyResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan resource_iam_policy.IamPolicyModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	spaceID := r.setIAMPolicy(ctx, plan, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plan.SpaceId = spaceID
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *iamPolicyResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state resource_iam_policy.IamPolicyModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// The policy is authoritative, every role and permission of the subject is revoked
+	state.Permissions = types.SetNull(types.StringType)
+	state.Roles = types.SetNull(types.StringType)
+	r.setIAMPolicy(ctx, state, &response.Diagnostics)
+}
+
+// setIAMPolicy grants the roles and permissions of tf to the subject and revokes the other ones, it returns the space_id of the policy
+func (r *iamPolicyResource) setIAMPolicy(ctx context.Context, tf resource_iam_policy.IamPolicyModel, diags *diag.Diagnostics) types.String {
+	organisationID, subjectType, subjectID, err := parseSubject(tf.OrganisationId, tf.SubjectType, tf.SubjectId)
+	if err != nil {
+		diags.AddError("unable to parse subject", err.Error())
+		return types.StringNull()
+	}
+
+	provider := services.ScopeProvider(r.provider, organisationID, tf.SpaceId, diags)
+	if diags.HasError() {
+		return types.StringNull()
+	}
+
+	desired := deserializeIAMPolicy(ctx, tf.Permissions, tf.Roles, diags)
+	if diags.HasError() {
+		return types.StringNull()
+	}
+
+	current, err := core.ReadIAMPolicy(ctx, provider, organisationID, subjectType, subjectID)
+	if err != nil {
+		diags.AddError("unable to read iam policy", err.Error())
+		return types.StringNull()
+	}
+
+	add, remove := core.IAMPolicyChanges(*current, desired)
+	if err = core.UpdateIAMPolicy(ctx, provider, organisationID, subjectType, subjectID, add, remove); err != nil {
+		diags.Append(utils.ErrorDiagnostics(ctx, "unable to set iam policy", err, nil)...)
+		return types.StringNull()
+	}
+
+	return services.ScopeSpaceID(provider, organisationID)
+}
+
+func parseSubject(organisationID, subjectType, subjectID types.String) (*api.OrganisationId, api.SubjectType, api.SubjectId, error) {
+	numSpotOrganisationID, err := services.ParseOrganisationID(organisationID)
+	if err != nil {
+		return nil, "", uuid.Nil, err
+	}
+
+	numSpotSubjectID, err := uuid.Parse(subjectID.ValueString())
+	if err != nil {
+		return nil, "", uuid.Nil, err
+	}
+
+	return numSpotOrganisationID, api.SubjectType(subjectType.ValueString()), numSpotSubjectID, nil
+}
+
+func deserializeIAMPolicy(ctx context.Context, permissions, roles types.Set, diags *diag.Diagnostics) api.IAMPolicy {
+	return api.IAMPolicy{
+		Permissions: deserializeIDs(ctx, permissions, diags),
+		Roles:       deserializeIDs(ctx, roles, diags),
+	}
+}
+
+func deserializeIDs(ctx context.Context, tf types.Set, diags *diag.Diagnostics) *[]uuid.UUID {
+	if utils.IsTfValueNull(tf) {
+		return nil
+	}
+
+	ids := utils.TfSetToGenericList(func(id types.String) uuid.UUID {
+		numSpotID, err := uuid.Parse(id.ValueString())
+		if err != nil {
+			diags.AddError("unable to parse id", fmt.Sprintf("Expected a UUID. Got: %q", id.ValueString()))
+		}
+		return numSpotID
+	}, ctx, tf, diags)
+	return &ids
+}
+
+// serializeIDs keeps the set null when it is not configured and the subject has none of the IDs
+func serializeIDs(ctx context.Context, http *[]uuid.UUID, tf types.Set, diags *diag.Diagnostics) types.Set {
+	if len(utils.GetPtrValue(http)) == 0 && tf.IsNull() {
+		return tf
+	}
+
+	ids := make([]string, 0, len(utils.GetPtrValue(http)))
+	for _, id := range utils.GetPtrValue(http) {
+		ids = append(ids, id.String())
+	}
+	return utils.FromStringListPointerToTfStringSet(ctx, &ids, diags)
+}
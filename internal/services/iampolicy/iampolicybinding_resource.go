@@ -0,0 +1,238 @@
+package iampolicy
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/iampolicy/resource_iam_policy_binding"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	_ resource.Resource              = &iamPolicyBindingResource{}
+	_ resource.ResourceWithConfigure = &iamPolicyBindingResource{}
+)
+
+// iamPolicyBindingResource is not authoritative, only the roles and permissions in the configuration are managed
+type iamPolicyBindingResource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewIAMPolicyBindingResource() resource.Resource {
+	return &iamPolicyBindingResource{}
+}
+
+func (r *iamPolicyBindingResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.provider = services.ConfigureProviderResource(request, response)
+}
+
+func (r *iamPolicyBindingResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_iam_policy_binding"
+}
+
+func (r *iamPolicyBindingResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resource_iam_policy_binding.IamPolicyBindingResourceSchema(ctx)
+}
+
+func (r *iamPolicyBindingResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan resource_iam_policy_binding.IamPolicyBindingModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	organisationID, subjectType, subjectID, err := parseSubject(plan.OrganisationId, plan.SubjectType, plan.SubjectId)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse subject", err.Error())
+		return
+	}
+
+	provider := services.ScopeProvider(r.provider, organisationID, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	desired := deserializeIAMPolicy(ctx, plan.Permissions, plan.Roles, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := core.ReadIAMPolicy(ctx, provider, organisationID, subjectType, subjectID)
+	if err != nil {
+		response.Diagnostics.AddError("unable to read iam policy", err.Error())
+		return
+	}
+
+	add, _ := core.IAMPolicyChanges(*current, desired)
+	if err = core.UpdateIAMPolicy(ctx, provider, organisationID, subjectType, subjectID, add, api.IAMPolicy{}); err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create iam policy binding", err, request.Plan)...)
+		return
+	}
+
+	state := plan
+	state.Id = types.StringValue(plan.SubjectType.ValueString() + "/" + plan.SubjectId.ValueString())
+	state.SpaceId = services.ScopeSpaceID(provider, organisationID)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *iamPolicyBindingResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state resource_iam_policy_binding.IamPolicyBindingModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	organisationID, subjectType, subjectID, err := parseSubject(state.OrganisationId, state.SubjectType, state.SubjectId)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	provider := services.ScopeProvider(r.provider, organisationID, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bound := deserializeIAMPolicy(ctx, state.Permissions, state.Roles, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := core.ReadIAMPolicy(ctx, provider, organisationID, subjectType, subjectID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError("unable to read iam policy", err.Error())
+		return
+	}
+
+	// Only the roles and permissions of the binding still granted to the subject are kept
+	policy := core.IAMPolicyIntersection(bound, *current)
+	state.Permissions = serializeIDs(ctx, policy.Permissions, state.Permissions, &response.Diagnostics)
+	state.Roles = serializeIDs(ctx, policy.Roles, state.Roles, &response.Diagnostics)
+	state.SpaceId = services.ScopeSpaceID(provider, organisationID)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *iamPolicyBindingResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state, plan resource_iam_policy_binding.IamPolicyBindingModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	organisationID, subjectType, subjectID, err := parseSubject(plan.OrganisationId, plan.SubjectType, plan.SubjectId)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse subject", err.Error())
+		return
+	}
+
+	provider := services.ScopeProvider(r.provider, organisationID, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bound := deserializeIAMPolicy(ctx, state.Permissions, state.Roles, &response.Diagnostics)
+	desired := deserializeIAMPolicy(ctx, plan.Permissions, plan.Roles, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := core.ReadIAMPolicy(ctx, provider, organisationID, subjectType, subjectID)
+	if err != nil {
+		response.Diagnostics.AddError("unable to read iam policy", err.Error())
+		return
+	}
+
+	// Only the roles and permissions removed from the binding are revoked
+	add, _ := core.IAMPolicyChanges(*current, desired)
+	_, unbound := core.IAMPolicyChanges(bound, desired)
+	remove := core.IAMPolicyIntersection(unbound, *current)
+	if err = core.UpdateIAMPolicy(ctx, provider, organisationID, subjectType, subjectID, add, remove); err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update iam policy binding", err, request.Plan)...)
+		return
+	}
+
+	plan.SpaceId = services.ScopeSpaceID(provider, organisationID)
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *iamPolicyBindingResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state resource_iam_policy_binding.IamPolicyBindingModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	organisationID, subjectType, subjectID, err := parseSubject(state.OrganisationId, state.SubjectType, state.SubjectId)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	provider := services.ScopeProvider(r.provider, organisationID, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bound := deserializeIAMPolicy(ctx, state.Permissions, state.Roles, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := core.ReadIAMPolicy(ctx, provider, organisationID, subjectType, subjectID)
+	if err != nil {
+		response.Diagnostics.AddError("unable to read iam policy", err.Error())
+		return
+	}
+
+	remove := core.IAMPolicyIntersection(bound, *current)
+	if err = core.UpdateIAMPolicy(ctx, provider, organisationID, subjectType, subjectID, api.IAMPolicy{}, remove); err != nil {
+		response.Diagnostics.AddError("unable to delete iam policy binding", err.Error())
+		return
+	}
+}
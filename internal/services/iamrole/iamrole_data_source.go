@@ -0,0 +1,110 @@
+package iamrole
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/iamrole/datasource_role"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var _ datasource.DataSource = &rolesDataSource{}
+
+type rolesDataSource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewRolesDataSource() datasource.DataSource {
+	return &rolesDataSource{}
+}
+
+func (d *rolesDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	d.provider = services.ConfigureProviderDatasource(request, response)
+}
+
+func (d *rolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+func (d *rolesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_role.RoleDataSourceSchema(ctx)
+}
+
+func (d *rolesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state, plan datasource_role.RoleModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	organisationID, err := services.ParseOrganisationID(plan.OrganisationId)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse organisation id", err.Error())
+		return
+	}
+
+	provider := services.ScopeProvider(d.provider, organisationID, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	roles, err := core.ReadRoles(ctx, provider, organisationID, utils.FromTfStringToStringPtr(plan.Name))
+	if err != nil {
+		response.Diagnostics.AddError("unable to read roles", err.Error())
+		return
+	}
+
+	items := serializeRolesDatasource(ctx, roles, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state = plan
+	state.Items = items
+	state.SpaceId = services.ScopeSpaceID(provider, organisationID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func serializeRolesDatasource(ctx context.Context, roles []api.RegisteredRole, diags *diag.Diagnostics) types.List {
+	itemsValue := make([]datasource_role.ItemsValue, 0, len(roles))
+
+	for _, role := range roles {
+		tenantTypes := make([]string, 0, len(role.TenantType))
+		for _, tenantType := range role.TenantType {
+			tenantTypes = append(tenantTypes, string(tenantType))
+		}
+
+		item, serializeDiags := datasource_role.NewItemsValue(datasource_role.ItemsValue{}.AttributeTypes(ctx), map[string]attr.Value{
+			"created_on":   types.StringValue(role.CreatedOn.Format(time.RFC3339)),
+			"custom":       types.BoolValue(role.Custom),
+			"description":  types.StringValue(role.Description),
+			"id":           types.StringValue(role.Uuid.String()),
+			"name":         types.StringValue(role.Name),
+			"tenant_types": utils.FromStringListToTfStringList(ctx, tenantTypes, diags),
+			"updated_on":   types.StringValue(role.UpdatedOn.Format(time.RFC3339)),
+		})
+		if serializeDiags.HasError() {
+			diags.Append(serializeDiags...)
+			continue
+		}
+		itemsValue = append(itemsValue, item)
+	}
+
+	list, serializeDiags := types.ListValueFrom(ctx, new(datasource_role.ItemsValue).Type(ctx), itemsValue)
+	diags.Append(serializeDiags...)
+
+	return list
+}
@@ -0,0 +1,256 @@
+package iamrole
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/iamrole/resource_iam_role"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &iamRoleResource{}
+	_ resource.ResourceWithConfigure   = &iamRoleResource{}
+	_ resource.ResourceWithImportState = &iamRoleResource{}
+)
+
+type iamRoleResource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewIAMRoleResource() resource.Resource {
+	return &iamRoleResource{}
+}
+
+func (r *iamRoleResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.provider = services.ConfigureProviderResource(request, response)
+}
+
+// ImportState accepts the ID of a space role, or organisation_id/id for an organisation role
+func (r *iamRoleResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	organisationID, roleID, found := strings.Cut(request.ID, "/")
+	if !found {
+		request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+		return
+	}
+
+	if organisationID == "" || roleID == "" {
+		response.Diagnostics.AddError("unexpected import identifier", fmt.Sprintf("Expected import identifier with format: id or organisation_id/id. Got: %q", request.ID))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("organisation_id"), organisationID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), roleID)...)
+}
+
+func (r *iamRoleResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_iam_role"
+}
+
+func (r *iamRoleResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resource_iam_role.IamRoleResourceSchema(ctx)
+}
+
+func (r *iamRoleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan resource_iam_role.IamRoleModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	organisationID, err := services.ParseOrganisationID(plan.OrganisationId)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse organisation id", err.Error())
+		return
+	}
+
+	provider := services.ScopeProvider(r.provider, organisationID, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	body := deserializeIAMRole(ctx, plan, organisationID, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := core.CreateRole(ctx, provider, organisationID, body)
+	if err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create role", err, request.Plan)...)
+		return
+	}
+
+	state := serializeIAMRole(ctx, role, plan, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state.SpaceId = services.ScopeSpaceID(provider, organisationID)
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *iamRoleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state resource_iam_role.IamRoleModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	organisationID, roleID, err := parseIAMRoleIDs(state)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	provider := services.ScopeProvider(r.provider, organisationID, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := core.ReadRole(ctx, provider, organisationID, roleID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError("unable to read role", err.Error())
+		return
+	}
+
+	newState := serializeIAMRole(ctx, role, state, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	newState.SpaceId = services.ScopeSpaceID(provider, organisationID)
+	newState.Timeouts = state.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *iamRoleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state, plan resource_iam_role.IamRoleModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Roles cannot be updated, every attribute requires a replacement and only the timeouts are persisted
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *iamRoleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state resource_iam_role.IamRoleModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	organisationID, roleID, err := parseIAMRoleIDs(state)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	provider := services.ScopeProvider(r.provider, organisationID, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := core.DeleteRole(ctx, provider, organisationID, roleID); err != nil {
+		response.Diagnostics.AddError("unable to delete role", err.Error())
+		return
+	}
+}
+
+func parseIAMRoleIDs(tf resource_iam_role.IamRoleModel) (*api.OrganisationId, api.RoleUuid, error) {
+	organisationID, err := services.ParseOrganisationID(tf.OrganisationId)
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+
+	roleID, err := uuid.Parse(tf.Id.ValueString())
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+
+	return organisationID, roleID, nil
+}
+
+func serializeIAMRole(ctx context.Context, http *api.RegisteredRole, tf resource_iam_role.IamRoleModel, diags *diag.Diagnostics) resource_iam_role.IamRoleModel {
+	tenantTypes := make([]string, 0, len(http.TenantType))
+	for _, tenantType := range http.TenantType {
+		tenantTypes = append(tenantTypes, string(tenantType))
+	}
+
+	return resource_iam_role.IamRoleModel{
+		CreatedOn:      types.StringValue(http.CreatedOn.Format(time.RFC3339)),
+		Description:    types.StringValue(http.Description),
+		Id:             types.StringValue(http.Uuid.String()),
+		Name:           types.StringValue(http.Name),
+		OrganisationId: tf.OrganisationId,
+		TenantTypes:    utils.FromStringListPointerToTfStringSet(ctx, &tenantTypes, diags),
+		UpdatedOn:      types.StringValue(http.UpdatedOn.Format(time.RFC3339)),
+	}
+}
+
+func deserializeIAMRole(ctx context.Context, tf resource_iam_role.IamRoleModel, organisationID *api.OrganisationId, diags *diag.Diagnostics) api.Role {
+	// Roles can be granted in the type of tenant they belong to unless specified otherwise
+	tenantTypes := []api.TenantType{api.TenantTypeSpace}
+	if organisationID != nil {
+		tenantTypes = []api.TenantType{api.TenantTypeOrganisation}
+	}
+	if !utils.IsTfValueNull(tf.TenantTypes) {
+		tenantTypes = utils.TfSetToGenericList(func(tenantType types.String) api.TenantType {
+			return api.TenantType(tenantType.ValueString())
+		}, ctx, tf.TenantTypes, diags)
+	}
+
+	return api.Role{
+		Description: tf.Description.ValueString(),
+		Name:        tf.Name.ValueString(),
+		TenantType:  tenantTypes,
+	}
+}
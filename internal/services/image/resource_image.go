@@ -41,6 +41,7 @@ func (r *imageResource) Configure(_ context.Context, request resource.ConfigureR
 }
 
 func (r *imageResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
@@ -59,14 +60,27 @@ func (r *imageResource) Create(ctx context.Context, request resource.CreateReque
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	tagsValue := imageTags(ctx, plan.Tags)
 	body := deserializeCreateNumSpotImage(plan, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
-	numSpotImage, err := core.CreateImage(ctx, r.provider, *body, tagsValue, deserializeAccess(plan.Access))
+	numSpotImage, err := core.CreateImage(ctx, provider, *body, tagsValue, deserializeAccess(plan.Access))
 	if err != nil {
-		response.Diagnostics.AddError("unable to create image", err.Error())
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create image", err, request.Plan)...)
 		return
 	}
 
@@ -75,6 +89,8 @@ func (r *imageResource) Create(ctx context.Context, request resource.CreateReque
 		return
 	}
 
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
@@ -86,9 +102,26 @@ func (r *imageResource) Read(ctx context.Context, request resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	imageID := state.Id.ValueString()
 
-	numSpotImage, err := core.ReadImageWithID(ctx, r.provider, imageID)
+	numSpotImage, err := core.ReadImageWithID(ctx, provider, imageID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError("unable to read image", err.Error())
 		return
@@ -99,6 +132,8 @@ func (r *imageResource) Read(ctx context.Context, request resource.ReadRequest,
 		return
 	}
 
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 }
 
@@ -114,27 +149,42 @@ func (r *imageResource) Update(ctx context.Context, request resource.UpdateReque
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	imageID := state.Id.ValueString()
 	planTags := imageTags(ctx, plan.Tags)
 	stateTags := imageTags(ctx, state.Tags)
 
 	if !state.Tags.Equal(plan.Tags) {
-		numSpotImage, err = core.UpdateImageTags(ctx, r.provider, imageID, stateTags, planTags)
+		numSpotImage, err = core.UpdateImageTags(ctx, provider, imageID, stateTags, planTags)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update image tags", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update image tags", err, request.Plan)...)
 			return
 		}
 	}
 
 	if !state.Access.Equal(plan.Access) {
-		numSpotImage, err = core.UpdateImageAccess(ctx, r.provider, imageID, *deserializeAccess(plan.Access))
+		numSpotImage, err = core.UpdateImageAccess(ctx, provider, imageID, *deserializeAccess(plan.Access))
 		if err != nil {
-			response.Diagnostics.AddError("unable to update image access", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update image access", err, request.Plan)...)
 			return
 		}
 	}
 
 	newState := serializeNumSpotImage(ctx, state, numSpotImage, &response.Diagnostics)
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 }
 
@@ -145,7 +195,20 @@ func (r *imageResource) Delete(ctx context.Context, request resource.DeleteReque
 		return
 	}
 
-	if err := core.DeleteImage(ctx, r.provider, state.Id.ValueString()); err != nil {
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := core.DeleteImage(ctx, provider, state.Id.ValueString()); err != nil {
 		response.Diagnostics.AddError("unable to delete image", err.Error())
 		return
 	}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -219,6 +220,16 @@ func ImageResourceSchema(ctx context.Context) schema.Schema {
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"state": schema.StringAttribute{
 				Computed:            true,
 				Description:         "The state of the Image (`pending` \\| `available` \\| `failed`).",
@@ -286,6 +297,14 @@ func ImageResourceSchema(ctx context.Context) schema.Schema {
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -303,9 +322,11 @@ type ImageModel struct {
 	RootDeviceType      types.String      `tfsdk:"root_device_type"`
 	SourceImageId       types.String      `tfsdk:"source_image_id"`
 	SourceRegionName    types.String      `tfsdk:"source_region_name"`
+	SpaceId             types.String      `tfsdk:"space_id"`
 	State               types.String      `tfsdk:"state"`
 	StateComment        StateCommentValue `tfsdk:"state_comment"`
 	Tags                types.Set         `tfsdk:"tags"`
+	Timeouts            timeouts.Value    `tfsdk:"timeouts"`
 	Type                types.String      `tfsdk:"type"`
 	VmId                types.String      `tfsdk:"vm_id"`
 }
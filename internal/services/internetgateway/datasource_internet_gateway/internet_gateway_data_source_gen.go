@@ -92,6 +92,12 @@ func InternetGatewayDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The IDs of the Vpcs the Internet gateways are attached to.",
 				MarkdownDescription: "The IDs of the Vpcs the Internet gateways are attached to.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
 			"tag_keys": schema.ListAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
@@ -118,13 +124,14 @@ func InternetGatewayDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type InternetGatewayModel struct {
-	Ids        types.List `tfsdk:"ids"`
-	Items      types.List `tfsdk:"items"`
-	LinkStates types.List `tfsdk:"link_states"`
-	LinkVpcIds types.List `tfsdk:"link_vpc_ids"`
-	TagKeys    types.List `tfsdk:"tag_keys"`
-	TagValues  types.List `tfsdk:"tag_values"`
-	Tags       types.List `tfsdk:"tags"`
+	Ids        types.List   `tfsdk:"ids"`
+	Items      types.List   `tfsdk:"items"`
+	LinkStates types.List   `tfsdk:"link_states"`
+	LinkVpcIds types.List   `tfsdk:"link_vpc_ids"`
+	SpaceId    types.String `tfsdk:"space_id"`
+	TagKeys    types.List   `tfsdk:"tag_keys"`
+	TagValues  types.List   `tfsdk:"tag_values"`
+	Tags       types.List   `tfsdk:"tags"`
 }
 
 var _ basetypes.ObjectTypable = ItemsType{}
@@ -50,12 +50,17 @@ func (d *internetGatewaysDataSource) Read(ctx context.Context, request datasourc
 		return
 	}
 
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	internetGatewayParams := deserializeReadInternetGateway(ctx, plan, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	internetGateways, err := core.ReadInternetGatewaysWithParams(ctx, d.provider, internetGatewayParams)
+	internetGateways, err := core.ReadInternetGatewaysWithParams(ctx, provider, internetGatewayParams)
 	if err != nil {
 		response.Diagnostics.AddError("unable to read internet gateway", err.Error())
 		return
@@ -73,6 +78,7 @@ func (d *internetGatewaysDataSource) Read(ctx context.Context, request datasourc
 
 	state = plan
 	state.Items = listValueItems
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
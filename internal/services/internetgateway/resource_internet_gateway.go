@@ -40,6 +40,7 @@ func (r *internetGatewayResource) Configure(_ context.Context, request resource.
 }
 
 func (r *internetGatewayResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
@@ -58,12 +59,25 @@ func (r *internetGatewayResource) Create(ctx context.Context, request resource.C
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	tagsValue := internetGatewayTags(ctx, plan.Tags)
 	vpcId := plan.VpcId.ValueString()
 
-	internetGateway, err := core.CreateInternetGateway(ctx, r.provider, tagsValue, vpcId)
+	internetGateway, err := core.CreateInternetGateway(ctx, provider, tagsValue, vpcId)
 	if err != nil {
-		response.Diagnostics.AddError("unable to create internet gateway", err.Error())
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create internet gateway", err, request.Plan)...)
 		return
 	}
 
@@ -72,6 +86,8 @@ func (r *internetGatewayResource) Create(ctx context.Context, request resource.C
 		return
 	}
 
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
@@ -82,9 +98,26 @@ func (r *internetGatewayResource) Read(ctx context.Context, request resource.Rea
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	internetGatewayID := state.Id.ValueString()
 
-	numSpotVolume, err := core.ReadInternetGatewaysWithID(ctx, r.provider, internetGatewayID)
+	numSpotVolume, err := core.ReadInternetGatewaysWithID(ctx, provider, internetGatewayID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError("unable to read internet gateway", err.Error())
 		return
@@ -95,6 +128,8 @@ func (r *internetGatewayResource) Read(ctx context.Context, request resource.Rea
 		return
 	}
 
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 }
 
@@ -111,14 +146,27 @@ func (r *internetGatewayResource) Update(ctx context.Context, request resource.U
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	internetGatewayID := state.Id.ValueString()
 	planTags := internetGatewayTags(ctx, plan.Tags)
 	stateTags := internetGatewayTags(ctx, state.Tags)
 
 	if !plan.Tags.Equal(state.Tags) {
-		numSpotInternetGateway, err = core.UpdateInternetGatewayTags(ctx, r.provider, internetGatewayID, stateTags, planTags)
+		numSpotInternetGateway, err = core.UpdateInternetGatewayTags(ctx, provider, internetGatewayID, stateTags, planTags)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update internet gateway tags", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update internet gateway tags", err, request.Plan)...)
 			return
 		}
 
@@ -127,6 +175,8 @@ func (r *internetGatewayResource) Update(ctx context.Context, request resource.U
 			return
 		}
 
+		newState.SpaceId = types.StringValue(provider.SpaceID.String())
+		newState.Timeouts = plan.Timeouts
 		response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 	}
 }
@@ -138,7 +188,20 @@ func (r *internetGatewayResource) Delete(ctx context.Context, request resource.D
 		return
 	}
 
-	if err := core.DeleteInternetGateway(ctx, r.provider, state.Id.ValueString(), state.VpcId.ValueString()); err != nil {
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := core.DeleteInternetGateway(ctx, provider, state.Id.ValueString(), state.VpcId.ValueString()); err != nil {
 		response.Diagnostics.AddError("unable to delete internet gateway", err.Error())
 		return
 	}
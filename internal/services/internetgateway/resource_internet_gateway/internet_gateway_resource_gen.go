@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -25,6 +26,16 @@ func InternetGatewayResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The ID of the Internet gateway.",
 				MarkdownDescription: "The ID of the Internet gateway.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"state": schema.StringAttribute{
 				Computed:            true,
 				Description:         "The state of the attachment of the Internet gateway to the Vpc (always `available`).",
@@ -64,14 +75,24 @@ func InternetGatewayResourceSchema(ctx context.Context) schema.Schema {
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type InternetGatewayModel struct {
-	Id    types.String `tfsdk:"id"`
-	State types.String `tfsdk:"state"`
-	Tags  types.Set    `tfsdk:"tags"`
-	VpcId types.String `tfsdk:"vpc_id"`
+	Id       types.String   `tfsdk:"id"`
+	SpaceId  types.String   `tfsdk:"space_id"`
+	State    types.String   `tfsdk:"state"`
+	Tags     types.Set      `tfsdk:"tags"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	VpcId    types.String   `tfsdk:"vpc_id"`
 }
 
 var _ basetypes.ObjectTypable = TagsType{}
@@ -68,15 +68,22 @@ func KeypairDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The types of the keypairs (`ssh-rsa`, `ssh-ed25519`, `ecdsa-sha2-nistp256`, `ecdsa-sha2-nistp384`, or `ecdsa-sha2-nistp521`).",
 				MarkdownDescription: "The types of the keypairs (`ssh-rsa`, `ssh-ed25519`, `ecdsa-sha2-nistp256`, `ecdsa-sha2-nistp384`, or `ecdsa-sha2-nistp521`).",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
 		},
 	}
 }
 
 type KeypairModel struct {
-	Items               types.List `tfsdk:"items"`
-	KeypairFingerprints types.List `tfsdk:"keypair_fingerprints"`
-	KeypairNames        types.List `tfsdk:"keypair_names"`
-	KeypairTypes        types.List `tfsdk:"keypair_types"`
+	Items               types.List   `tfsdk:"items"`
+	KeypairFingerprints types.List   `tfsdk:"keypair_fingerprints"`
+	KeypairNames        types.List   `tfsdk:"keypair_names"`
+	KeypairTypes        types.List   `tfsdk:"keypair_types"`
+	SpaceId             types.String `tfsdk:"space_id"`
 }
 
 var _ basetypes.ObjectTypable = ItemsType{}
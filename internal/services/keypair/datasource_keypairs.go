@@ -49,12 +49,17 @@ func (d *keypairsDataSource) Read(ctx context.Context, request datasource.ReadRe
 		return
 	}
 
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	keypairParams := deserializeReadKeypairs(ctx, plan, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	keypair, err := core.ReadKeypairs(ctx, d.provider, keypairParams)
+	keypair, err := core.ReadKeypairs(ctx, provider, keypairParams)
 	if err != nil {
 		response.Diagnostics.AddError("unable to read keypairs", err.Error())
 		return
@@ -73,6 +78,7 @@ func (d *keypairsDataSource) Read(ctx context.Context, request datasource.ReadRe
 
 	state = plan
 	state.Items = listValueItems
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
@@ -37,6 +37,7 @@ func (r *keypairResource) Configure(_ context.Context, request resource.Configur
 }
 
 func (r *keypairResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
@@ -55,9 +56,22 @@ func (r *keypairResource) Create(ctx context.Context, request resource.CreateReq
 		return
 	}
 
-	keypair, err := core.CreateKeypair(ctx, r.provider, deserializeCreateNumSpotKeypair(plan))
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	keypair, err := core.CreateKeypair(ctx, provider, deserializeCreateNumSpotKeypair(plan))
 	if err != nil {
-		response.Diagnostics.AddError("unable to create keypair", err.Error())
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create keypair", err, request.Plan)...)
 		return
 	}
 
@@ -66,6 +80,8 @@ func (r *keypairResource) Create(ctx context.Context, request resource.CreateReq
 		state.PublicKey = plan.PublicKey
 	}
 
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
@@ -76,9 +92,26 @@ func (r *keypairResource) Read(ctx context.Context, request resource.ReadRequest
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	keypairID := state.Id.ValueString()
 
-	numSpotKeypair, err := core.ReadKeypair(ctx, r.provider, keypairID)
+	numSpotKeypair, err := core.ReadKeypair(ctx, provider, keypairID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError("unable to read keypair", err.Error())
 		return
@@ -93,10 +126,22 @@ func (r *keypairResource) Read(ctx context.Context, request resource.ReadRequest
 		newState.PrivateKey = state.PrivateKey
 	}
 
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 }
 
-func (r *keypairResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+func (r *keypairResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state, plan resource_keypair.KeypairModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// No implementation at this time, only the timeouts are persisted
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
 func (r *keypairResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
@@ -105,7 +150,20 @@ func (r *keypairResource) Delete(ctx context.Context, request resource.DeleteReq
 	if response.Diagnostics.HasError() {
 		return
 	}
-	if err := core.DeleteKeypair(ctx, r.provider, state.Id.ValueString()); err != nil {
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if err := core.DeleteKeypair(ctx, provider, state.Id.ValueString()); err != nil {
 		response.Diagnostics.AddError("unable to delete keypair", err.Error())
 		return
 	}
@@ -116,13 +116,20 @@ func KubernetesClusterDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Paginated request",
 				MarkdownDescription: "Paginated request",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
 		},
 	}
 }
 
 type KubernetesClusterModel struct {
-	Items types.List `tfsdk:"items"`
-	Page  PageValue  `tfsdk:"page"`
+	Items   types.List   `tfsdk:"items"`
+	Page    PageValue    `tfsdk:"page"`
+	SpaceId types.String `tfsdk:"space_id"`
 }
 
 var _ basetypes.ObjectTypable = ItemsType{}
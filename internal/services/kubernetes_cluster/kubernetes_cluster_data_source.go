@@ -51,7 +51,12 @@ func (d *kubernetesClusterDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 
-	kubernetesCluster, err := core.ReadKubernetesClusters(ctx, d.provider)
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kubernetesCluster, err := core.ReadKubernetesClusters(ctx, provider)
 	if err != nil {
 		resp.Diagnostics.AddError("unable to read kubernetes clusters", err.Error())
 		return
@@ -69,6 +74,7 @@ func (d *kubernetesClusterDataSource) Read(ctx context.Context, req datasource.R
 
 	state = plan
 	state.Items = listValueItems
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
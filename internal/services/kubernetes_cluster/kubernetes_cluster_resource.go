@@ -2,11 +2,15 @@ package kubernetes_cluster
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-numspot/internal/client"
@@ -14,9 +18,14 @@ import (
 	"terraform-provider-numspot/internal/sdk/api"
 	"terraform-provider-numspot/internal/services"
 	"terraform-provider-numspot/internal/services/kubernetes_cluster/resource_kubernetes_cluster"
+	"terraform-provider-numspot/internal/utils"
 )
 
-var _ resource.Resource = &kubernetesClusterResource{}
+var (
+	_ resource.Resource                = &kubernetesClusterResource{}
+	_ resource.ResourceWithImportState = &kubernetesClusterResource{}
+	_ resource.ResourceWithModifyPlan  = &kubernetesClusterResource{}
+)
 
 type kubernetesClusterResource struct {
 	provider *client.NumSpotSDK
@@ -35,6 +44,16 @@ func (r *kubernetesClusterResource) Configure(_ context.Context, request resourc
 	r.provider = services.ConfigureProviderResource(request, response)
 }
 
+func (r *kubernetesClusterResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
+	if _, err := uuid.Parse(request.ID); err != nil {
+		response.Diagnostics.AddError("unexpected import identifier", fmt.Sprintf("Expected the cluster ID as import identifier. Got: %q", request.ID))
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
 func (r *kubernetesClusterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_kubernetes_cluster"
 }
@@ -51,11 +70,24 @@ func (r *kubernetesClusterResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestStateRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createClusterRequest := deserializeCreateCluster(plan)
 
-	cluster, err := core.CreateKubernetesCluster(ctx, r.provider, createClusterRequest)
+	cluster, err := core.CreateKubernetesCluster(ctx, provider, createClusterRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("unable to create kubernetes cluster", err.Error())
+		resp.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create kubernetes cluster", err, req.Plan)...)
 		return
 	}
 
@@ -64,6 +96,8 @@ func (r *kubernetesClusterResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -84,13 +118,30 @@ func (r *kubernetesClusterResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestStateRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterUuid, err := uuid.Parse(state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("unable to parse cluster id", err.Error())
 		return
 	}
 
-	numSpotCluster, err := core.ReadKubernetesCluster(ctx, r.provider, clusterUuid)
+	numSpotCluster, err := core.ReadKubernetesCluster(ctx, provider, clusterUuid)
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("unable to read kubernetes cluster", err.Error())
 		return
@@ -101,11 +152,58 @@ func (r *kubernetesClusterResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
 
 func (r *kubernetesClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// No implementation at this time
+	var state, plan resource_kubernetes_cluster.KubernetesClusterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// No implementation at this time, only the timeouts are persisted
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ModifyPlan checks the requested version against the versions supported by the API, so that a typo fails at plan
+// time instead of during the cluster creation
+func (r *kubernetesClusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.provider == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan resource_kubernetes_cluster.KubernetesClusterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Version.IsUnknown() || plan.Version.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state resource_kubernetes_cluster.KubernetesClusterModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || state.Version.Equal(plan.Version) {
+			return
+		}
+	}
+
+	versions, err := core.ReadKubernetesVersions(ctx, r.provider)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read kubernetes versions", err.Error())
+		return
+	}
+
+	if !slices.Contains(versions, plan.Version.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("version"),
+			"unsupported kubernetes version",
+			fmt.Sprintf("Kubernetes version %q is not supported, supported versions are: %s.", plan.Version.ValueString(), strings.Join(versions, ", ")),
+		)
+	}
 }
 
 func (r *kubernetesClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -115,13 +213,26 @@ func (r *kubernetesClusterResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestStateRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterUuid, err := uuid.Parse(state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("unable to parse cluster id", err.Error())
 		return
 	}
 
-	if err := core.DeleteKubernetesCluster(ctx, r.provider, clusterUuid); err != nil {
+	if err := core.DeleteKubernetesCluster(ctx, provider, clusterUuid); err != nil {
 		resp.Diagnostics.AddError("unable to delete kubernetes cluster", err.Error())
 		return
 	}
@@ -17,6 +17,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -83,6 +84,16 @@ func KubernetesClusterResourceSchema(ctx context.Context) schema.Schema {
 					),
 				},
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"status": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"message": schema.StringAttribute{
@@ -101,19 +112,11 @@ func KubernetesClusterResourceSchema(ctx context.Context) schema.Schema {
 			},
 			"version": schema.StringAttribute{
 				Required:            true,
-				Description:         "Kubernetes version of the cluster.",
-				MarkdownDescription: "Kubernetes version of the cluster.",
+				Description:         "Kubernetes version of the cluster, one of the versions listed by the `numspot_kubernetes_versions` data source.",
+				MarkdownDescription: "Kubernetes version of the cluster, one of the versions listed by the `numspot_kubernetes_versions` data source.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
-				Validators: []validator.String{
-					stringvalidator.OneOf(
-						"1.31",
-						"1.32",
-						"1.33",
-						"1.34",
-					),
-				},
 			},
 			"visibility": schema.StringAttribute{
 				Required:            true,
@@ -130,20 +133,30 @@ func KubernetesClusterResourceSchema(ctx context.Context) schema.Schema {
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type KubernetesClusterModel struct {
-	Cidr        types.String `tfsdk:"cidr"`
-	ClusterId   types.String `tfsdk:"cluster_id"`
-	CreatedOn   types.String `tfsdk:"created_on"`
-	FullVersion types.String `tfsdk:"full_version"`
-	Id          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Profile     types.String `tfsdk:"profile"`
-	Status      StatusValue  `tfsdk:"status"`
-	Version     types.String `tfsdk:"version"`
-	Visibility  types.String `tfsdk:"visibility"`
+	Cidr        types.String   `tfsdk:"cidr"`
+	ClusterId   types.String   `tfsdk:"cluster_id"`
+	CreatedOn   types.String   `tfsdk:"created_on"`
+	FullVersion types.String   `tfsdk:"full_version"`
+	Id          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Profile     types.String   `tfsdk:"profile"`
+	SpaceId     types.String   `tfsdk:"space_id"`
+	Status      StatusValue    `tfsdk:"status"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+	Version     types.String   `tfsdk:"version"`
+	Visibility  types.String   `tfsdk:"visibility"`
 }
 
 var _ basetypes.ObjectTypable = StatusType{}
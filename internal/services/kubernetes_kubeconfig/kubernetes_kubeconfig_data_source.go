@@ -0,0 +1,94 @@
+package kubernetes_kubeconfig
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/kubernetes_kubeconfig/datasource_kubernetes_kubeconfig"
+)
+
+var _ datasource.DataSource = &kubernetesKubeconfigDataSource{}
+
+func (d *kubernetesKubeconfigDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	d.provider = services.ConfigureProviderDatasource(request, response)
+}
+
+func NewKubernetesKubeconfigDataSource() datasource.DataSource {
+	return &kubernetesKubeconfigDataSource{}
+}
+
+// kubernetesKubeconfigDataSource stores the kubeconfig in the state, the ephemeral resource should be preferred on
+// Terraform 1.10 and later
+type kubernetesKubeconfigDataSource struct {
+	provider *client.NumSpotSDK
+}
+
+func (d *kubernetesKubeconfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubernetes_kubeconfig"
+}
+
+func (d *kubernetesKubeconfigDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_kubernetes_kubeconfig.KubernetesKubeconfigDataSourceSchema(ctx)
+}
+
+func (d *kubernetesKubeconfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state datasource_kubernetes_kubeconfig.KubernetesKubeconfigModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	provider := services.SpaceProvider(d.provider, state.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readKubeconfig(ctx, provider, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// readKubeconfig fills the model with the raw and parsed kubeconfig of the cluster, it is shared by the data source
+// and the ephemeral resource
+func readKubeconfig(ctx context.Context, provider *client.NumSpotSDK, model *datasource_kubernetes_kubeconfig.KubernetesKubeconfigModel, diags *diag.Diagnostics) {
+	clusterId, err := uuid.Parse(model.ClusterId.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("cluster_id"), "invalid cluster ID", err.Error())
+		return
+	}
+
+	rawKubeconfig, err := core.ReadKubernetesKubeconfig(ctx, provider, clusterId)
+	if err != nil {
+		diags.AddError("unable to read kubernetes kubeconfig", err.Error())
+		return
+	}
+
+	kubeconfig, err := core.ParseKubeconfig(rawKubeconfig)
+	if err != nil {
+		diags.AddError("unable to parse kubernetes kubeconfig", err.Error())
+		return
+	}
+
+	model.Kubeconfig = types.StringValue(rawKubeconfig)
+	model.Host = types.StringValue(kubeconfig.Host)
+	model.ClusterCaCertificate = types.StringValue(kubeconfig.ClusterCACertificate)
+	model.ClientCertificate = types.StringValue(kubeconfig.ClientCertificate)
+	model.ClientKey = types.StringValue(kubeconfig.ClientKey)
+	model.Token = types.StringValue(kubeconfig.Token)
+}
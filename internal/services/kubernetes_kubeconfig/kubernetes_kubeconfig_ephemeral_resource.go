@@ -0,0 +1,113 @@
+package kubernetes_kubeconfig
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/kubernetes_kubeconfig/datasource_kubernetes_kubeconfig"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &kubernetesKubeconfigEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &kubernetesKubeconfigEphemeralResource{}
+)
+
+func (r *kubernetesKubeconfigEphemeralResource) Configure(_ context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.provider = services.ConfigureProviderEphemeralResource(request, response)
+}
+
+func NewKubernetesKubeconfigEphemeralResource() ephemeral.EphemeralResource {
+	return &kubernetesKubeconfigEphemeralResource{}
+}
+
+// kubernetesKubeconfigEphemeralResource reads the kubeconfig of a cluster without storing it in the plan or the state,
+// its schema matches the one of the data source
+type kubernetesKubeconfigEphemeralResource struct {
+	provider *client.NumSpotSDK
+}
+
+func (r *kubernetesKubeconfigEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubernetes_kubeconfig"
+}
+
+func (r *kubernetesKubeconfigEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"client_certificate": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The PEM-encoded client certificate used to authenticate to the cluster.",
+				MarkdownDescription: "The PEM-encoded client certificate used to authenticate to the cluster.",
+			},
+			"client_key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				Description:         "The PEM-encoded private key of the client certificate.",
+				MarkdownDescription: "The PEM-encoded private key of the client certificate.",
+			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The PEM-encoded certificate of the authority of the cluster API server.",
+				MarkdownDescription: "The PEM-encoded certificate of the authority of the cluster API server.",
+			},
+			"cluster_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Kubernetes cluster.",
+				MarkdownDescription: "The ID of the Kubernetes cluster.",
+			},
+			"host": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The URL of the cluster API server.",
+				MarkdownDescription: "The URL of the cluster API server.",
+			},
+			"kubeconfig": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				Description:         "The raw kubeconfig of the cluster, in YAML format.",
+				MarkdownDescription: "The raw kubeconfig of the cluster, in YAML format.",
+			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				Description:         "The bearer token used to authenticate to the cluster, if any.",
+				MarkdownDescription: "The bearer token used to authenticate to the cluster, if any.",
+			},
+		},
+	}
+}
+
+func (r *kubernetesKubeconfigEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var result datasource_kubernetes_kubeconfig.KubernetesKubeconfigModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &result)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	provider := services.SpaceProvider(r.provider, result.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readKubeconfig(ctx, provider, &result, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result.SpaceId = types.StringValue(provider.SpaceID.String())
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &result)...)
+}
@@ -131,6 +131,12 @@ func KubernetesNodepoolDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Paginated request",
 				MarkdownDescription: "Paginated request",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
 		},
 	}
 }
@@ -139,6 +145,7 @@ type KubernetesNodepoolModel struct {
 	ClusterId types.String `tfsdk:"cluster_id"`
 	Items     types.List   `tfsdk:"items"`
 	Page      PageValue    `tfsdk:"page"`
+	SpaceId   types.String `tfsdk:"space_id"`
 }
 
 var _ basetypes.ObjectTypable = ItemsType{}
@@ -52,13 +52,18 @@ func (d *kubernetesNodepoolDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterUuid, err := uuid.Parse(plan.ClusterId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("unable to parse cluster id", err.Error())
 		return
 	}
 
-	kubernetesNodePool, err := core.ReadKubernetesNodePools(ctx, d.provider, clusterUuid)
+	kubernetesNodePool, err := core.ReadKubernetesNodePools(ctx, provider, clusterUuid)
 	if err != nil {
 		resp.Diagnostics.AddError("unable to read kubernetes node pools", err.Error())
 		return
@@ -76,6 +81,7 @@ func (d *kubernetesNodepoolDataSource) Read(ctx context.Context, req datasource.
 
 	state = plan
 	state.Items = listValueItems
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
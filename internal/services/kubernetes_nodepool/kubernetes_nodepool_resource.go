@@ -2,10 +2,15 @@ package kubernetes_nodepool
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-numspot/internal/client"
@@ -16,7 +21,12 @@ import (
 	"terraform-provider-numspot/internal/utils"
 )
 
-var _ resource.Resource = (*kubernetesNodepoolResource)(nil)
+const importIDFormat = "cluster_id/nodepool_id or cluster_id/nodepool_id/autoscaling_min/autoscaling_max"
+
+var (
+	_ resource.Resource                = (*kubernetesNodepoolResource)(nil)
+	_ resource.ResourceWithImportState = (*kubernetesNodepoolResource)(nil)
+)
 
 type kubernetesNodepoolResource struct {
 	provider *client.NumSpotSDK
@@ -35,6 +45,44 @@ func (r *kubernetesNodepoolResource) Configure(_ context.Context, request resour
 	r.provider = services.ConfigureProviderResource(request, response)
 }
 
+func (r *kubernetesNodepoolResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
+	parts := strings.Split(request.ID, "/")
+	if (len(parts) != 2 && len(parts) != 4) || slices.Contains(parts, "") {
+		response.Diagnostics.AddError("unexpected import identifier", fmt.Sprintf("Expected import identifier with format: %s. Got: %q", importIDFormat, request.ID))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("cluster_id"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	if len(parts) == 2 {
+		return
+	}
+
+	// The autoscaling bounds are not returned by the API, they would otherwise be null and force a replacement
+	minReplicas, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		response.Diagnostics.AddError("unexpected import identifier", fmt.Sprintf("Expected autoscaling min to be a number in import identifier. Got: %q", parts[2]))
+		return
+	}
+	maxReplicas, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		response.Diagnostics.AddError("unexpected import identifier", fmt.Sprintf("Expected autoscaling max to be a number in import identifier. Got: %q", parts[3]))
+		return
+	}
+
+	autoscaling, diags := resource_kubernetes_nodepool.NewAutoscalingValue(resource_kubernetes_nodepool.AutoscalingValue{}.AttributeTypes(ctx),
+		map[string]attr.Value{
+			"max": types.Int64Value(maxReplicas),
+			"min": types.Int64Value(minReplicas),
+		})
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("autoscaling"), autoscaling)...)
+}
+
 func (r *kubernetesNodepoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_kubernetes_nodepool"
 }
@@ -51,6 +99,19 @@ func (r *kubernetesNodepoolResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterUuid, err := uuid.Parse(plan.ClusterId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("unable to parse cluster id", err.Error())
@@ -59,9 +120,9 @@ func (r *kubernetesNodepoolResource) Create(ctx context.Context, req resource.Cr
 
 	createNodePoolRequest := deserializeCreateNodePool(plan)
 
-	nodePool, err := core.CreateKubernetesNodePool(ctx, r.provider, createNodePoolRequest, clusterUuid)
+	nodePool, err := core.CreateKubernetesNodePool(ctx, provider, createNodePoolRequest, clusterUuid)
 	if err != nil {
-		resp.Diagnostics.AddError("unable to create kubernetes node pool", err.Error())
+		resp.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create kubernetes node pool", err, req.Plan)...)
 		return
 	}
 
@@ -70,6 +131,8 @@ func (r *kubernetesNodepoolResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -87,8 +150,8 @@ func deserializeCreateNodePool(tf resource_kubernetes_nodepool.KubernetesNodepoo
 
 	if !(tf.Autoscaling.IsNull() || tf.Autoscaling.IsUnknown()) {
 		autoscalling = &api.Autoscaling{
-			Max: utils.FromTfInt64ToInt(tf.RootDisk.Iops),
-			Min: utils.FromTfInt64ToInt(tf.RootDisk.Size),
+			Max: utils.FromTfInt64ToInt(tf.Autoscaling.Max),
+			Min: utils.FromTfInt64ToInt(tf.Autoscaling.Min),
 		}
 	}
 
@@ -110,6 +173,19 @@ func (r *kubernetesNodepoolResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterUuid, err := uuid.Parse(state.ClusterId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("unable to parse cluster id", err.Error())
@@ -117,7 +193,11 @@ func (r *kubernetesNodepoolResource) Read(ctx context.Context, req resource.Read
 	}
 	nodePoolId := state.Id.ValueString()
 
-	nodePool, err := core.ReadKubernetesNodePool(ctx, r.provider, clusterUuid, nodePoolId)
+	nodePool, err := core.ReadKubernetesNodePool(ctx, provider, clusterUuid, nodePoolId)
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("unable to read kubernetes node pool", err.Error())
 		return
@@ -128,11 +208,22 @@ func (r *kubernetesNodepoolResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
 
 func (r *kubernetesNodepoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// No implementation at this time
+	var state, plan resource_kubernetes_nodepool.KubernetesNodepoolModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API cannot update node pools, the other attributes require a replacement and only the timeouts are persisted
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *kubernetesNodepoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -143,6 +234,19 @@ func (r *kubernetesNodepoolResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterUuid, err := uuid.Parse(state.ClusterId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("unable to parse cluster id", err.Error())
@@ -150,7 +254,7 @@ func (r *kubernetesNodepoolResource) Delete(ctx context.Context, req resource.De
 	}
 	nodePoolId := state.Id.ValueString()
 
-	if err := core.DeleteKubernetesNodePool(ctx, r.provider, clusterUuid, nodePoolId); err != nil {
+	if err := core.DeleteKubernetesNodePool(ctx, provider, clusterUuid, nodePoolId); err != nil {
 		resp.Diagnostics.AddError("unable to delete kubernetes node pool", err.Error())
 		return
 	}
@@ -179,12 +283,19 @@ func serializeNodePool(ctx context.Context, nodePool *api.CreateKubernetesNodePo
 		diags.Append(diagnostics...)
 	}
 
+	// The autoscaling bounds are not returned by the API
+	autoscaling := plan.Autoscaling
+	if autoscaling.IsUnknown() {
+		autoscaling = resource_kubernetes_nodepool.NewAutoscalingValueNull()
+	}
+
 	return resource_kubernetes_nodepool.KubernetesNodepoolModel{
+		Autoscaling:      autoscaling,
 		AvailabilityZone: types.StringValue(string(nodePool.AvailabilityZone)),
 		ClusterId:        types.StringValue(plan.ClusterId.ValueString()),
 		Id:               types.StringValue(nodePool.Id.String()),
 		Name:             types.StringPointerValue(nodePool.Name),
-		NodePoolId:       types.StringValue(plan.NodePoolId.ValueString()),
+		NodePoolId:       types.StringValue(nodePool.Id.String()),
 		NodeProfile:      types.StringValue(string(nodePool.NodeProfile)),
 		Replicas:         types.Int64Value(int64(nodePool.Replicas)),
 		RootDisk:         rootDisk,
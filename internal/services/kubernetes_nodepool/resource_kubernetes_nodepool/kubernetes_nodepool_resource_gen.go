@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -19,6 +20,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -45,8 +47,13 @@ func KubernetesNodepoolResourceSchema(ctx context.Context) schema.Schema {
 						AttrTypes: AutoscalingValue{}.AttributeTypes(ctx),
 					},
 				},
-				Optional: true,
-				Computed: true,
+				Optional:            true,
+				Computed:            true,
+				Description:         "The bounds of the cluster autoscaler for the node pool. The node pool is replaced when they change, since the API cannot update node pools. They are not returned by the API, import an autoscaled node pool with the identifier `cluster_id/nodepool_id/autoscaling_min/autoscaling_max`.",
+				MarkdownDescription: "The bounds of the cluster autoscaler for the node pool. The node pool is replaced when they change, since the API cannot update node pools. They are not returned by the API, import an autoscaled node pool with the identifier `cluster_id/nodepool_id/autoscaling_min/autoscaling_max`.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIfConfigured(),
+				},
 			},
 			"availability_zone": schema.StringAttribute{
 				Required:            true,
@@ -123,8 +130,8 @@ func KubernetesNodepoolResourceSchema(ctx context.Context) schema.Schema {
 			"replicas": schema.Int64Attribute{
 				Optional:            true,
 				Computed:            true,
-				Description:         "Desired number of this node replicas.",
-				MarkdownDescription: "Desired number of this node replicas.",
+				Description:         "Desired number of this node replicas. The node pool is replaced when it changes, since the API cannot update node pools.",
+				MarkdownDescription: "Desired number of this node replicas. The node pool is replaced when it changes, since the API cannot update node pools.",
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.RequiresReplaceIfConfigured(),
 				},
@@ -173,6 +180,16 @@ func KubernetesNodepoolResourceSchema(ctx context.Context) schema.Schema {
 				},
 				Required: true,
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"status": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"message": schema.StringAttribute{
@@ -190,6 +207,14 @@ func KubernetesNodepoolResourceSchema(ctx context.Context) schema.Schema {
 				Computed: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -203,7 +228,9 @@ type KubernetesNodepoolModel struct {
 	NodeProfile      types.String     `tfsdk:"node_profile"`
 	Replicas         types.Int64      `tfsdk:"replicas"`
 	RootDisk         RootDiskValue    `tfsdk:"root_disk"`
+	SpaceId          types.String     `tfsdk:"space_id"`
 	Status           StatusValue      `tfsdk:"status"`
+	Timeouts         timeouts.Value   `tfsdk:"timeouts"`
 }
 
 var _ basetypes.ObjectTypable = AutoscalingType{}
@@ -0,0 +1,73 @@
+package kubernetes_versions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/kubernetes_versions/datasource_kubernetes_versions"
+)
+
+var _ datasource.DataSource = &kubernetesVersionsDataSource{}
+
+func (d *kubernetesVersionsDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	d.provider = services.ConfigureProviderDatasource(request, response)
+}
+
+func NewKubernetesVersionsDataSource() datasource.DataSource {
+	return &kubernetesVersionsDataSource{}
+}
+
+type kubernetesVersionsDataSource struct {
+	provider *client.NumSpotSDK
+}
+
+func (d *kubernetesVersionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubernetes_versions"
+}
+
+func (d *kubernetesVersionsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_kubernetes_versions.KubernetesVersionsDataSourceSchema(ctx)
+}
+
+func (d *kubernetesVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state datasource_kubernetes_versions.KubernetesVersionsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versions, err := core.ReadKubernetesVersions(ctx, d.provider)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read kubernetes versions", err.Error())
+		return
+	}
+
+	versions, err = core.FilterKubernetesVersions(versions, state.VersionPrefix.ValueString(), state.VersionConstraint.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to filter kubernetes versions", err.Error())
+		return
+	}
+
+	versionsList, diags := types.ListValueFrom(ctx, types.StringType, versions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Versions = versionsList
+	state.LatestVersion = types.StringNull()
+	if len(versions) > 0 {
+		state.LatestVersion = types.StringValue(versions[len(versions)-1])
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -263,13 +263,20 @@ func LoadBalancerDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The names of the load balancers.",
 				MarkdownDescription: "The names of the load balancers.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
 		},
 	}
 }
 
 type LoadBalancerModel struct {
-	Items             types.List `tfsdk:"items"`
-	LoadBalancerNames types.List `tfsdk:"load_balancer_names"`
+	Items             types.List   `tfsdk:"items"`
+	LoadBalancerNames types.List   `tfsdk:"load_balancer_names"`
+	SpaceId           types.String `tfsdk:"space_id"`
 }
 
 var _ basetypes.ObjectTypable = ItemsType{}
@@ -51,12 +51,17 @@ func (d *loadBalancersDataSource) Read(ctx context.Context, request datasource.R
 		return
 	}
 
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	loadBalancerParams := deserializeReadLoadBalancers(ctx, plan, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	loadBalancers, err := core.ReadLoadBalancers(ctx, d.provider, loadBalancerParams)
+	loadBalancers, err := core.ReadLoadBalancers(ctx, provider, loadBalancerParams)
 	if err != nil {
 		response.Diagnostics.AddError("unable to read load balancers", err.Error())
 		return
@@ -74,6 +79,7 @@ func (d *loadBalancersDataSource) Read(ctx context.Context, request datasource.R
 
 	state = plan
 	state.Items = listValueItems
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
@@ -2,6 +2,7 @@ package loadbalancer
 
 import (
 	"context"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -41,6 +42,7 @@ func (r *loadBalancerResource) Configure(_ context.Context, request resource.Con
 }
 
 func (r *loadBalancerResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), request, response)
 }
 
@@ -60,6 +62,19 @@ func (r *loadBalancerResource) Create(ctx context.Context, request resource.Crea
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	tagsValue := loadBalancerTags(ctx, plan.Tags)
 	backendIP := utils.FromTfStringSetToStringList(ctx, plan.BackendIps, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
@@ -80,18 +95,29 @@ func (r *loadBalancerResource) Create(ctx context.Context, request resource.Crea
 		return
 	}
 
-	numSpotLoadBalancer, err := core.CreateLoadBalancer(ctx, r.provider, createNumSpotLoadBalancer, updateNumSpotLoadBalancer, tagsValue, backendVM, backendIP)
+	numSpotLoadBalancer, err := core.CreateLoadBalancer(ctx, provider, createNumSpotLoadBalancer, updateNumSpotLoadBalancer, tagsValue, backendVM, backendIP)
 	if err != nil {
-		response.Diagnostics.AddError("unable to create load balancer", err.Error())
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create load balancer", err, request.Plan)...)
 		return
 	}
 
-	state := serializeNumSpotLoadBalancer(ctx, numSpotLoadBalancer, &response.Diagnostics)
+	state := serializeNumSpotLoadBalancer(ctx, numSpotLoadBalancer, plan.Listeners, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	state.MinHealthyBackends = plan.MinHealthyBackends
+	state.Timeouts = plan.Timeouts
+	state.WaitForHealthy = plan.WaitForHealthy
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err = waitForHealthyBackends(ctx, provider, plan, backendVM); err != nil {
+		response.Diagnostics.AddError("unable to wait for healthy load balancer backends", err.Error())
+	}
 }
 
 func (r *loadBalancerResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -102,19 +128,40 @@ func (r *loadBalancerResource) Read(ctx context.Context, request resource.ReadRe
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	loadBalancerName := state.Name.ValueString()
 
-	numSpotLoadBalancer, err := core.ReadLoadBalancer(ctx, r.provider, loadBalancerName)
+	numSpotLoadBalancer, err := core.ReadLoadBalancer(ctx, provider, loadBalancerName)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError("unable to read load balancer", err.Error())
 		return
 	}
 
-	newState := serializeNumSpotLoadBalancer(ctx, numSpotLoadBalancer, &response.Diagnostics)
+	newState := serializeNumSpotLoadBalancer(ctx, numSpotLoadBalancer, state.Listeners, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	newState.MinHealthyBackends = state.MinHealthyBackends
+	newState.Timeouts = state.Timeouts
+	newState.WaitForHealthy = state.WaitForHealthy
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 }
 
@@ -124,12 +171,21 @@ func (r *loadBalancerResource) Update(ctx context.Context, request resource.Upda
 		numSpotLoadBalancer *api.LoadBalancer
 		err                 error
 	)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
 	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
@@ -164,43 +220,68 @@ func (r *loadBalancerResource) Update(ctx context.Context, request resource.Upda
 	planPublicIP := plan.PublicIp.ValueString()
 
 	if !plan.HealthCheck.Equal(state.HealthCheck) || planPublicIP != statePublicIP {
-		numSpotLoadBalancer, err = core.UpdateLoadBalancerAttributes(ctx, r.provider, loadBalancerName, updateNumSpotLoadBalancer)
+		numSpotLoadBalancer, err = core.UpdateLoadBalancerAttributes(ctx, provider, loadBalancerName, updateNumSpotLoadBalancer)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update load balancer attributes", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update load balancer attributes", err, request.Plan)...)
 			return
 		}
 	}
 
 	if !plan.SecurityGroups.Equal(state.SecurityGroups) {
-		numSpotLoadBalancer, err = core.UpdateLoadBalancerSecurityGroup(ctx, r.provider, loadBalancerName, updateNumSpotLoadBalancer)
+		numSpotLoadBalancer, err = core.UpdateLoadBalancerSecurityGroup(ctx, provider, loadBalancerName, updateNumSpotLoadBalancer)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update load balancer security groups", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update load balancer security groups", err, request.Plan)...)
 			return
 		}
 	}
 
-	if !plan.BackendVmIds.Equal(state.BackendVmIds) || !plan.BackendIps.Equal(state.BackendIps) {
-		numSpotLoadBalancer, err = core.UpdateLoadBalancerBackend(ctx, r.provider, loadBalancerName, stateBackendVM, planBackendVM, stateBackendIP, planBackendIP)
+	backendsUpdated := !plan.BackendVmIds.Equal(state.BackendVmIds) || !plan.BackendIps.Equal(state.BackendIps)
+	if backendsUpdated {
+		numSpotLoadBalancer, err = core.UpdateLoadBalancerBackend(ctx, provider, loadBalancerName, stateBackendVM, planBackendVM, stateBackendIP, planBackendIP)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update load balancer backend", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update load balancer backend", err, request.Plan)...)
 			return
 		}
 	}
 
 	if !plan.Tags.Equal(state.Tags) {
-		numSpotLoadBalancer, err = core.UpdateLoadBalancerTags(ctx, r.provider, loadBalancerName, planTags, stateTags)
+		numSpotLoadBalancer, err = core.UpdateLoadBalancerTags(ctx, provider, loadBalancerName, planTags, stateTags)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update load balancer tags", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update load balancer tags", err, request.Plan)...)
 			return
 		}
 	}
 
-	newState := serializeNumSpotLoadBalancer(ctx, numSpotLoadBalancer, &response.Diagnostics)
+	// Only attributes that are not sent to the API, such as the timeouts, have changed
+	if numSpotLoadBalancer == nil {
+		numSpotLoadBalancer, err = core.ReadLoadBalancer(ctx, provider, loadBalancerName)
+		if err != nil {
+			response.Diagnostics.AddError("unable to read load balancer", err.Error())
+			return
+		}
+	}
+
+	newState := serializeNumSpotLoadBalancer(ctx, numSpotLoadBalancer, plan.Listeners, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	newState.MinHealthyBackends = plan.MinHealthyBackends
+	newState.Timeouts = plan.Timeouts
+	newState.WaitForHealthy = plan.WaitForHealthy
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !backendsUpdated {
+		return
+	}
+
+	if err = waitForHealthyBackends(ctx, provider, plan, planBackendVM); err != nil {
+		response.Diagnostics.AddError("unable to wait for healthy load balancer backends", err.Error())
+	}
 }
 
 func (r *loadBalancerResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
@@ -211,14 +292,42 @@ func (r *loadBalancerResource) Delete(ctx context.Context, request resource.Dele
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	loadBalancerName := state.Name.ValueString()
 
-	if err := core.DeleteLoadBalancer(ctx, r.provider, loadBalancerName); err != nil {
+	if err := core.DeleteLoadBalancer(ctx, provider, loadBalancerName); err != nil {
 		response.Diagnostics.AddError("unable to delete load balancer", err.Error())
 		return
 	}
 }
 
+// waitForHealthyBackends waits for min_healthy_backends back-end VMs, or all of them, to be InService when
+// wait_for_healthy is enabled
+func waitForHealthyBackends(ctx context.Context, provider *client.NumSpotSDK, plan resource_load_balancer.LoadBalancerModel, backendVM []string) error {
+	if !plan.WaitForHealthy.ValueBool() || len(backendVM) == 0 {
+		return nil
+	}
+
+	minHealthyBackends := len(backendVM)
+	if !plan.MinHealthyBackends.IsNull() {
+		minHealthyBackends = utils.FromTfInt64ToInt(plan.MinHealthyBackends)
+	}
+
+	return core.WaitForLoadBalancerBackendsHealthy(ctx, provider, plan.Name.ValueString(), minHealthyBackends)
+}
+
 func deserializeCreateNumSpotLoadBalancer(ctx context.Context, tf resource_load_balancer.LoadBalancerModel, diags *diag.Diagnostics) api.CreateLoadBalancerJSONRequestBody {
 	var securityGroupsPtr *[]string
 	if !(tf.SecurityGroups.IsNull() || tf.SecurityGroups.IsUnknown()) {
@@ -328,7 +437,10 @@ func deserializeUpdateNumSpotLoadBalancer(ctx context.Context, tf resource_load_
 	}
 }
 
-func serializeNumSpotLoadBalancer(ctx context.Context, http *api.LoadBalancer, diags *diag.Diagnostics) resource_load_balancer.LoadBalancerModel {
+// serializeNumSpotLoadBalancer only keeps the listeners of declaredListeners, the listeners added by
+// numspot_load_balancer_listener resources are returned by the API as well. Every listener is kept when
+// declaredListeners is null, as after an import
+func serializeNumSpotLoadBalancer(ctx context.Context, http *api.LoadBalancer, declaredListeners types.Set, diags *diag.Diagnostics) resource_load_balancer.LoadBalancerModel {
 	var tagsTf types.Set
 
 	applicationStickyCookiePoliciesTypes := utils.GenericListToTfListValue(ctx, applicationStickyCookiePoliciesFromHTTP, *http.ApplicationStickyCookiePolicies, diags)
@@ -336,7 +448,7 @@ func serializeNumSpotLoadBalancer(ctx context.Context, http *api.LoadBalancer, d
 		return resource_load_balancer.LoadBalancerModel{}
 	}
 
-	listeners := utils.GenericSetToTfSetValue(ctx, listenersFromHTTP, *http.Listeners, diags)
+	listeners := utils.GenericSetToTfSetValue(ctx, listenersFromHTTP, filterDeclaredListeners(ctx, *http.Listeners, declaredListeners, diags), diags)
 	if diags.HasError() {
 		return resource_load_balancer.LoadBalancerModel{}
 	}
@@ -431,6 +543,20 @@ func applicationStickyCookiePoliciesFromHTTP(ctx context.Context, elt api.Applic
 	return value
 }
 
+func filterDeclaredListeners(ctx context.Context, http []api.Listener, declaredListeners types.Set, diags *diag.Diagnostics) []api.Listener {
+	if declaredListeners.IsNull() || declaredListeners.IsUnknown() {
+		return http
+	}
+
+	ports := utils.TfSetToGenericSet(func(elt resource_load_balancer.ListenersValue) int64 {
+		return elt.LoadBalancerPort.ValueInt64()
+	}, ctx, declaredListeners, diags)
+
+	return slices.DeleteFunc(slices.Clone(http), func(listener api.Listener) bool {
+		return !slices.Contains(ports, int64(utils.GetPtrValue(listener.LoadBalancerPort)))
+	})
+}
+
 func listenersFromHTTP(ctx context.Context, elt api.Listener, diags *diag.Diagnostics) resource_load_balancer.ListenersValue {
 	tfPolicyNames := utils.FromStringListPointerToTfStringList(ctx, elt.PolicyNames, diags)
 	if diags.HasError() {
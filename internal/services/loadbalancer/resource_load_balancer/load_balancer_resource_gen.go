@@ -5,9 +5,11 @@ package resource_load_balancer
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -18,6 +20,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -175,8 +178,17 @@ func LoadBalancerResourceSchema(ctx context.Context) schema.Schema {
 					},
 				},
 				Required:            true,
-				Description:         "One or more listeners to create.",
-				MarkdownDescription: "One or more listeners to create.",
+				Description:         "One or more listeners to create. The listeners added by `numspot_load_balancer_listener` resources are not kept in this attribute.",
+				MarkdownDescription: "One or more listeners to create. The listeners added by `numspot_load_balancer_listener` resources are not kept in this attribute.",
+			},
+			"min_healthy_backends": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The minimum number of back-end VMs that must be `InService` when `wait_for_healthy` is enabled. Defaults to every back-end VM of the load balancer.",
+				MarkdownDescription: "The minimum number of back-end VMs that must be `InService` when `wait_for_healthy` is enabled. Defaults to every back-end VM of the load balancer.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AlsoRequires(path.MatchRoot("wait_for_healthy")),
+				},
 			},
 			"name": schema.StringAttribute{
 				Required:            true,
@@ -205,6 +217,16 @@ func LoadBalancerResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "(Vpc only) One or more IDs of security groups you want to assign to the load balancer. If not specified, the default security group of the Vpc is assigned to the load balancer.",
 				MarkdownDescription: "(Vpc only) One or more IDs of security groups you want to assign to the load balancer. If not specified, the default security group of the Vpc is assigned to the load balancer.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"sticky_cookie_policies": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -277,6 +299,19 @@ func LoadBalancerResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The ID of the Vpc for the load balancer.",
 				MarkdownDescription: "The ID of the Vpc for the load balancer.",
 			},
+			"wait_for_healthy": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether apply waits for the back-end VMs to be `InService` after the load balancer is created or its back-end VMs are updated. The wait is bounded by the create and update timeouts.",
+				MarkdownDescription: "Whether apply waits for the back-end VMs to be `InService` after the load balancer is created or its back-end VMs are updated. The wait is bounded by the create and update timeouts.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -289,15 +324,19 @@ type LoadBalancerModel struct {
 	HealthCheck                     HealthCheckValue `tfsdk:"health_check"`
 	Id                              types.String     `tfsdk:"id"`
 	Listeners                       types.Set        `tfsdk:"listeners"`
+	MinHealthyBackends              types.Int64      `tfsdk:"min_healthy_backends"`
 	Name                            types.String     `tfsdk:"name"`
 	PublicIp                        types.String     `tfsdk:"public_ip"`
 	SecuredCookies                  types.Bool       `tfsdk:"secured_cookies"`
 	SecurityGroups                  types.List       `tfsdk:"security_groups"`
+	SpaceId                         types.String     `tfsdk:"space_id"`
 	StickyCookiePolicies            types.List       `tfsdk:"sticky_cookie_policies"`
 	Subnets                         types.List       `tfsdk:"subnets"`
 	Tags                            types.Set        `tfsdk:"tags"`
+	Timeouts                        timeouts.Value   `tfsdk:"timeouts"`
 	Type                            types.String     `tfsdk:"type"`
 	VpcId                           types.String     `tfsdk:"vpc_id"`
+	WaitForHealthy                  types.Bool       `tfsdk:"wait_for_healthy"`
 }
 
 var _ basetypes.ObjectTypable = ApplicationStickyCookiePoliciesType{}
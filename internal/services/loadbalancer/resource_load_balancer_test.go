@@ -0,0 +1,105 @@
+package loadbalancer
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-numspot/internal/client/clienttest"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services/loadbalancer/resource_load_balancer"
+	"terraform-provider-numspot/internal/utils"
+)
+
+// loadBalancerStub serves the load balancer named "load-balancer" in spaceID only and records the requests
+type loadBalancerStub struct {
+	mu       sync.Mutex
+	spaceID  string
+	requests []string
+}
+
+func (s *loadBalancerStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	s.mu.Unlock()
+
+	if r.URL.Path != "/compute/spaces/"+s.spaceID+"/loadBalancers/load-balancer" {
+		clienttest.WriteJSON(w, http.StatusNotFound, api.Error{Title: "Not Found"})
+		return
+	}
+
+	clienttest.WriteJSON(w, http.StatusOK, api.LoadBalancer{
+		ApplicationStickyCookiePolicies: &[]api.ApplicationStickyCookiePolicy{},
+		HealthCheck:                     &api.HealthCheck{CheckInterval: 30, HealthyThreshold: 10, Port: 80, Protocol: "TCP", Timeout: 5, UnhealthyThreshold: 2},
+		Listeners:                       &[]api.Listener{},
+		Name:                            utils.PointerOf("load-balancer"),
+		SecurityGroups:                  &[]string{"sg-12345678"},
+		StickyCookiePolicies:            &[]api.LoadBalancerStickyCookiePolicy{},
+		Tags:                            &[]api.ResourceTag{},
+	})
+}
+
+// loadBalancerValue returns a load balancer with every attribute null but the given ones
+func loadBalancerValue(ctx context.Context, attributes map[string]tftypes.Value) tftypes.Value {
+	objectType := resource_load_balancer.LoadBalancerResourceSchema(ctx).Type().TerraformType(ctx).(tftypes.Object)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range attributes {
+		values[name] = value
+	}
+
+	return tftypes.NewValue(objectType, values)
+}
+
+func TestLoadBalancerUpdateInOtherSpace(t *testing.T) {
+	ctx := context.Background()
+	spaceID := uuid.NewString()
+	stub := &loadBalancerStub{spaceID: spaceID}
+	r := &loadBalancerResource{provider: clienttest.NewStubSDK(t, stub)}
+	schema := resource_load_balancer.LoadBalancerResourceSchema(ctx)
+
+	securityGroups := func(ids ...string) tftypes.Value {
+		values := make([]tftypes.Value, 0, len(ids))
+		for _, id := range ids {
+			values = append(values, tftypes.NewValue(tftypes.String, id))
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, values)
+	}
+	state := loadBalancerValue(ctx, map[string]tftypes.Value{
+		"name":            tftypes.NewValue(tftypes.String, "load-balancer"),
+		"security_groups": securityGroups("sg-87654321"),
+		"space_id":        tftypes.NewValue(tftypes.String, spaceID),
+	})
+	plan := loadBalancerValue(ctx, map[string]tftypes.Value{
+		"name":            tftypes.NewValue(tftypes.String, "load-balancer"),
+		"security_groups": securityGroups("sg-12345678"),
+		"space_id":        tftypes.NewValue(tftypes.String, spaceID),
+	})
+
+	response := &resource.UpdateResponse{State: tfsdk.State{Schema: schema, Raw: state}}
+	r.Update(ctx, resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schema, Raw: plan},
+		State: tfsdk.State{Schema: schema, Raw: state},
+	}, response)
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics)
+
+	var newState resource_load_balancer.LoadBalancerModel
+	require.False(t, response.State.Get(ctx, &newState).HasError())
+	assert.Equal(t, spaceID, newState.SpaceId.ValueString())
+
+	for _, request := range stub.requests {
+		assert.True(t, strings.Contains(request, "/spaces/"+spaceID+"/"), request)
+	}
+	assert.Contains(t, stub.requests, "PUT /compute/spaces/"+spaceID+"/loadBalancers/load-balancer")
+}
@@ -0,0 +1,100 @@
+package loadbalancerbackendhealth
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/loadbalancerbackendhealth/datasource_load_balancer_backend_health"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var _ datasource.DataSource = &loadBalancerBackendHealthDataSource{}
+
+type loadBalancerBackendHealthDataSource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewLoadBalancerBackendHealthDataSource() datasource.DataSource {
+	return &loadBalancerBackendHealthDataSource{}
+}
+
+func (d *loadBalancerBackendHealthDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	d.provider = services.ConfigureProviderDatasource(request, response)
+}
+
+func (d *loadBalancerBackendHealthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_load_balancer_backend_health"
+}
+
+func (d *loadBalancerBackendHealthDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_load_balancer_backend_health.LoadBalancerBackendHealthDataSourceSchema(ctx)
+}
+
+func (d *loadBalancerBackendHealthDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state, plan datasource_load_balancer_backend_health.LoadBalancerBackendHealthModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	backendVmIds := utils.TfStringListToStringList(ctx, plan.BackendVmIds, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	backendsHealth, err := core.ReadLoadBalancerBackendHealth(ctx, provider, plan.LoadBalancerName.ValueString(), backendVmIds)
+	if err != nil {
+		response.Diagnostics.AddError("unable to read load balancer backend health", err.Error())
+		return
+	}
+
+	items := serializeLoadBalancerBackendHealthDatasource(ctx, backendsHealth, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state = plan
+	state.Items = items
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func serializeLoadBalancerBackendHealthDatasource(ctx context.Context, backendsHealth []api.BackendVmHealth, diags *diag.Diagnostics) types.List {
+	itemsValue := make([]datasource_load_balancer_backend_health.ItemsValue, 0, len(backendsHealth))
+
+	for _, backendHealth := range backendsHealth {
+		item, serializeDiags := datasource_load_balancer_backend_health.NewItemsValue(datasource_load_balancer_backend_health.ItemsValue{}.AttributeTypes(ctx), map[string]attr.Value{
+			"description":  types.StringPointerValue(backendHealth.Description),
+			"state":        types.StringPointerValue(backendHealth.State),
+			"state_reason": types.StringPointerValue(backendHealth.StateReason),
+			"vm_id":        types.StringPointerValue(backendHealth.VmId),
+		})
+		if serializeDiags.HasError() {
+			diags.Append(serializeDiags...)
+			continue
+		}
+		itemsValue = append(itemsValue, item)
+	}
+
+	list, serializeDiags := types.ListValueFrom(ctx, new(datasource_load_balancer_backend_health.ItemsValue).Type(ctx), itemsValue)
+	diags.Append(serializeDiags...)
+
+	return list
+}
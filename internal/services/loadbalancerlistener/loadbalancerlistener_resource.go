@@ -0,0 +1,220 @@
+package loadbalancerlistener
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/loadbalancerlistener/resource_load_balancer_listener"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &loadBalancerListenerResource{}
+	_ resource.ResourceWithConfigure   = &loadBalancerListenerResource{}
+	_ resource.ResourceWithImportState = &loadBalancerListenerResource{}
+)
+
+type loadBalancerListenerResource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewLoadBalancerListenerResource() resource.Resource {
+	return &loadBalancerListenerResource{}
+}
+
+func (r *loadBalancerListenerResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.provider = services.ConfigureProviderResource(request, response)
+}
+
+func (r *loadBalancerListenerResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
+	loadBalancerName, loadBalancerPort, err := parseLoadBalancerListenerID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError("unexpected import identifier", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), request.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("load_balancer_name"), loadBalancerName)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("load_balancer_port"), loadBalancerPort)...)
+}
+
+func (r *loadBalancerListenerResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_load_balancer_listener"
+}
+
+func (r *loadBalancerListenerResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resource_load_balancer_listener.LoadBalancerListenerResourceSchema(ctx)
+}
+
+func (r *loadBalancerListenerResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan resource_load_balancer_listener.LoadBalancerListenerModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	listener, err := core.CreateLoadBalancerListener(ctx, provider, plan.LoadBalancerName.ValueString(), deserializeCreateLoadBalancerListener(plan))
+	if err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create load balancer listener", err, request.Plan)...)
+		return
+	}
+
+	state := serializeLoadBalancerListener(ctx, listener, plan.LoadBalancerName.ValueString(), &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *loadBalancerListenerResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state resource_load_balancer_listener.LoadBalancerListenerModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	listener, err := core.ReadLoadBalancerListener(ctx, provider, state.LoadBalancerName.ValueString(), utils.FromTfInt64ToInt(state.LoadBalancerPort))
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError("unable to read load balancer listener", err.Error())
+		return
+	}
+
+	newState := serializeLoadBalancerListener(ctx, listener, state.LoadBalancerName.ValueString(), &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *loadBalancerListenerResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state, plan resource_load_balancer_listener.LoadBalancerListenerModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The API cannot update listeners, every other attribute requires a replacement
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *loadBalancerListenerResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state resource_load_balancer_listener.LoadBalancerListenerModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := core.DeleteLoadBalancerListener(ctx, provider, state.LoadBalancerName.ValueString(), utils.FromTfInt64ToInt(state.LoadBalancerPort)); err != nil && !utils.IsNotFound(err) {
+		response.Diagnostics.AddError("unable to delete load balancer listener", err.Error())
+		return
+	}
+}
+
+// parseLoadBalancerListenerID splits a load_balancer_name/load_balancer_port identifier
+func parseLoadBalancerListenerID(id string) (string, int64, error) {
+	loadBalancerName, port, found := strings.Cut(id, "/")
+	if !found || loadBalancerName == "" {
+		return "", 0, fmt.Errorf("Expected import identifier with format: load_balancer_name/load_balancer_port. Got: %q", id)
+	}
+
+	loadBalancerPort, err := strconv.ParseInt(port, 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("Expected the load balancer port to be a number. Got: %q", port)
+	}
+
+	return loadBalancerName, loadBalancerPort, nil
+}
+
+func deserializeCreateLoadBalancerListener(tf resource_load_balancer_listener.LoadBalancerListenerModel) api.ListenerForCreation {
+	return api.ListenerForCreation{
+		BackendPort:          utils.FromTfInt64ToInt(tf.BackendPort),
+		BackendProtocol:      utils.FromTfStringToStringPtr(tf.BackendProtocol),
+		LoadBalancerPort:     utils.FromTfInt64ToInt(tf.LoadBalancerPort),
+		LoadBalancerProtocol: tf.LoadBalancerProtocol.ValueString(),
+		ServerCertificateId:  utils.FromTfStringToStringPtr(tf.ServerCertificateId),
+	}
+}
+
+func serializeLoadBalancerListener(ctx context.Context, http *api.Listener, loadBalancerName string, diags *diag.Diagnostics) resource_load_balancer_listener.LoadBalancerListenerModel {
+	serverCertificateId := types.StringNull()
+	if utils.GetPtrValue(http.ServerCertificateId) != "" {
+		serverCertificateId = types.StringPointerValue(http.ServerCertificateId)
+	}
+
+	return resource_load_balancer_listener.LoadBalancerListenerModel{
+		BackendPort:          utils.FromIntPtrToTfInt64(http.BackendPort),
+		BackendProtocol:      types.StringPointerValue(http.BackendProtocol),
+		Id:                   types.StringValue(fmt.Sprintf("%s/%d", loadBalancerName, utils.GetPtrValue(http.LoadBalancerPort))),
+		LoadBalancerName:     types.StringValue(loadBalancerName),
+		LoadBalancerPort:     utils.FromIntPtrToTfInt64(http.LoadBalancerPort),
+		LoadBalancerProtocol: types.StringPointerValue(http.LoadBalancerProtocol),
+		PolicyNames:          utils.FromStringListPointerToTfStringList(ctx, http.PolicyNames, diags),
+		ServerCertificateId:  serverCertificateId,
+	}
+}
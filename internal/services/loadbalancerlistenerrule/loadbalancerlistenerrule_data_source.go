@@ -0,0 +1,107 @@
+package loadbalancerlistenerrule
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/loadbalancerlistenerrule/datasource_load_balancer_listener_rule"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var _ datasource.DataSource = &loadBalancerListenerRulesDataSource{}
+
+type loadBalancerListenerRulesDataSource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewLoadBalancerListenerRulesDataSource() datasource.DataSource {
+	return &loadBalancerListenerRulesDataSource{}
+}
+
+func (d *loadBalancerListenerRulesDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	d.provider = services.ConfigureProviderDatasource(request, response)
+}
+
+func (d *loadBalancerListenerRulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_load_balancer_listener_rules"
+}
+
+func (d *loadBalancerListenerRulesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_load_balancer_listener_rule.LoadBalancerListenerRuleDataSourceSchema(ctx)
+}
+
+func (d *loadBalancerListenerRulesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state, plan datasource_load_balancer_listener_rule.LoadBalancerListenerRuleModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	params := api.ReadListenerRulesParams{
+		ListenerRuleNames: utils.TfStringListToStringPtrList(ctx, plan.Names, &response.Diagnostics),
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	listenerRules, err := core.ReadLoadBalancerListenerRules(ctx, provider, params)
+	if err != nil {
+		response.Diagnostics.AddError("unable to read load balancer listener rules", err.Error())
+		return
+	}
+
+	items := serializeLoadBalancerListenerRulesDatasource(ctx, listenerRules, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state = plan
+	state.Items = items
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func serializeLoadBalancerListenerRulesDatasource(ctx context.Context, listenerRules []api.ListenerRule, diags *diag.Diagnostics) types.List {
+	itemsValue := make([]datasource_load_balancer_listener_rule.ItemsValue, 0, len(listenerRules))
+
+	for _, listenerRule := range listenerRules {
+		item, serializeDiags := datasource_load_balancer_listener_rule.NewItemsValue(datasource_load_balancer_listener_rule.ItemsValue{}.AttributeTypes(ctx), map[string]attr.Value{
+			"action":            types.StringPointerValue(listenerRule.Action),
+			"host_name_pattern": nullIfEmpty(listenerRule.HostNamePattern),
+			"id":                types.StringValue(strconv.Itoa(utils.GetPtrValue(listenerRule.Id))),
+			"listener_id":       utils.FromIntPtrToTfInt64(listenerRule.ListenerId),
+			"name":              types.StringPointerValue(listenerRule.Name),
+			"path_pattern":      nullIfEmpty(listenerRule.PathPattern),
+			"priority":          utils.FromIntPtrToTfInt64(listenerRule.Priority),
+			"vm_ids":            utils.FromStringListPointerToTfStringList(ctx, listenerRule.VmIds, diags),
+		})
+		if serializeDiags.HasError() {
+			diags.Append(serializeDiags...)
+			continue
+		}
+		itemsValue = append(itemsValue, item)
+	}
+
+	list, serializeDiags := types.ListValueFrom(ctx, new(datasource_load_balancer_listener_rule.ItemsValue).Type(ctx), itemsValue)
+	diags.Append(serializeDiags...)
+
+	return list
+}
@@ -0,0 +1,257 @@
+package loadbalancerlistenerrule
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/loadbalancerlistenerrule/resource_load_balancer_listener_rule"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &loadBalancerListenerRuleResource{}
+	_ resource.ResourceWithConfigure   = &loadBalancerListenerRuleResource{}
+	_ resource.ResourceWithImportState = &loadBalancerListenerRuleResource{}
+)
+
+type loadBalancerListenerRuleResource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewLoadBalancerListenerRuleResource() resource.Resource {
+	return &loadBalancerListenerRuleResource{}
+}
+
+func (r *loadBalancerListenerRuleResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.provider = services.ConfigureProviderResource(request, response)
+}
+
+// ImportState expects load_balancer_name/load_balancer_port/id, the API does not return the listener of a rule by name and port
+func (r *loadBalancerListenerRuleResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
+	parts := strings.Split(request.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+		response.Diagnostics.AddError("unexpected import identifier", fmt.Sprintf("Expected import identifier with format: load_balancer_name/load_balancer_port/id. Got: %q", request.ID))
+		return
+	}
+
+	loadBalancerPort, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		response.Diagnostics.AddError("unexpected import identifier", fmt.Sprintf("Expected the load balancer port to be a number. Got: %q", parts[1]))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("load_balancer_name"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("load_balancer_port"), loadBalancerPort)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
+}
+
+func (r *loadBalancerListenerRuleResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_load_balancer_listener_rule"
+}
+
+func (r *loadBalancerListenerRuleResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resource_load_balancer_listener_rule.LoadBalancerListenerRuleResourceSchema(ctx)
+}
+
+func (r *loadBalancerListenerRuleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan resource_load_balancer_listener_rule.LoadBalancerListenerRuleModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	body := deserializeCreateLoadBalancerListenerRule(ctx, plan, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	listenerRule, err := core.CreateLoadBalancerListenerRule(ctx, provider, body)
+	if err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create load balancer listener rule", err, request.Plan)...)
+		return
+	}
+
+	state := serializeLoadBalancerListenerRule(ctx, listenerRule, plan, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *loadBalancerListenerRuleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state resource_load_balancer_listener_rule.LoadBalancerListenerRuleModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	listenerRule, err := core.ReadLoadBalancerListenerRule(ctx, provider, state.Id.ValueString())
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError("unable to read load balancer listener rule", err.Error())
+		return
+	}
+
+	newState := serializeLoadBalancerListenerRule(ctx, listenerRule, state, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *loadBalancerListenerRuleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state, plan resource_load_balancer_listener_rule.LoadBalancerListenerRuleModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The API can only update the patterns of a rule, removing one or changing any other attribute requires a replacement
+	listenerRule, err := core.UpdateLoadBalancerListenerRule(ctx, provider, state.Id.ValueString(), api.UpdateListenerRuleJSONRequestBody{
+		HostPattern: utils.FromTfStringToStringPtr(plan.HostNamePattern),
+		PathPattern: utils.FromTfStringToStringPtr(plan.PathPattern),
+	})
+	if err != nil {
+		response.Diagnostics.AddError("unable to update load balancer listener rule", err.Error())
+		return
+	}
+
+	newState := serializeLoadBalancerListenerRule(ctx, listenerRule, plan, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *loadBalancerListenerRuleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state resource_load_balancer_listener_rule.LoadBalancerListenerRuleModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := core.DeleteLoadBalancerListenerRule(ctx, provider, state.Id.ValueString()); err != nil && !utils.IsNotFound(err) {
+		response.Diagnostics.AddError("unable to delete load balancer listener rule", err.Error())
+		return
+	}
+}
+
+func deserializeCreateLoadBalancerListenerRule(ctx context.Context, tf resource_load_balancer_listener_rule.LoadBalancerListenerRuleModel, diags *diag.Diagnostics) api.CreateListenerRuleJSONRequestBody {
+	return api.CreateListenerRuleJSONRequestBody{
+		Listener: api.LoadBalancerLight{
+			LoadBalancerName: tf.LoadBalancerName.ValueString(),
+			LoadBalancerPort: utils.FromTfInt64ToInt(tf.LoadBalancerPort),
+		},
+		ListenerRule: api.ListenerRuleForCreation{
+			HostNamePattern:  utils.FromTfStringToStringPtr(tf.HostNamePattern),
+			ListenerRuleName: tf.Name.ValueString(),
+			PathPattern:      utils.FromTfStringToStringPtr(tf.PathPattern),
+			Priority:         utils.FromTfInt64ToInt(tf.Priority),
+		},
+		VmIds: utils.TfStringListToStringList(ctx, tf.VmIds, diags),
+	}
+}
+
+// serializeLoadBalancerListenerRule keeps the load balancer name and port of the model, the API only returns the listener ID
+func serializeLoadBalancerListenerRule(ctx context.Context, http *api.ListenerRule, tf resource_load_balancer_listener_rule.LoadBalancerListenerRuleModel, diags *diag.Diagnostics) resource_load_balancer_listener_rule.LoadBalancerListenerRuleModel {
+	return resource_load_balancer_listener_rule.LoadBalancerListenerRuleModel{
+		Action:           types.StringPointerValue(http.Action),
+		HostNamePattern:  nullIfEmpty(http.HostNamePattern),
+		Id:               types.StringValue(strconv.Itoa(utils.GetPtrValue(http.Id))),
+		ListenerId:       utils.FromIntPtrToTfInt64(http.ListenerId),
+		LoadBalancerName: tf.LoadBalancerName,
+		LoadBalancerPort: tf.LoadBalancerPort,
+		Name:             types.StringPointerValue(http.Name),
+		PathPattern:      nullIfEmpty(http.PathPattern),
+		Priority:         utils.FromIntPtrToTfInt64(http.Priority),
+		VmIds:            utils.FromStringListPointerToTfStringList(ctx, http.VmIds, diags),
+	}
+}
+
+func nullIfEmpty(pattern *string) types.String {
+	if pattern == nil || *pattern == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(*pattern)
+}
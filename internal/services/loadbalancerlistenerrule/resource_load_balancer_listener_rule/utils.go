@@ -0,0 +1,13 @@
+package resource_load_balancer_listener_rule
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+// ReplaceClearedPattern requires a replacement when a pattern is removed, the API keeps the patterns it does not receive
+func ReplaceClearedPattern(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull() && req.PlanValue.IsNull()
+}
@@ -0,0 +1,211 @@
+package loadbalancerpolicy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/loadbalancerpolicy/resource_load_balancer_policy"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = &loadBalancerPolicyResource{}
+	_ resource.ResourceWithConfigure      = &loadBalancerPolicyResource{}
+	_ resource.ResourceWithImportState    = &loadBalancerPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &loadBalancerPolicyResource{}
+)
+
+type loadBalancerPolicyResource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewLoadBalancerPolicyResource() resource.Resource {
+	return &loadBalancerPolicyResource{}
+}
+
+func (r *loadBalancerPolicyResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.provider = services.ConfigureProviderResource(request, response)
+}
+
+func (r *loadBalancerPolicyResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
+	loadBalancerName, policyName, found := strings.Cut(request.ID, "/")
+	if !found || loadBalancerName == "" || policyName == "" {
+		response.Diagnostics.AddError("unexpected import identifier", fmt.Sprintf("Expected import identifier with format: load_balancer_name/name. Got: %q", request.ID))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), request.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("load_balancer_name"), loadBalancerName)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("name"), policyName)...)
+}
+
+func (r *loadBalancerPolicyResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_load_balancer_policy"
+}
+
+func (r *loadBalancerPolicyResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resource_load_balancer_policy.LoadBalancerPolicyResourceSchema(ctx)
+}
+
+// ValidateConfig requires a cookie name for application policies, load balancer policies generate their own cookie
+func (r *loadBalancerPolicyResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config resource_load_balancer_policy.LoadBalancerPolicyModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if config.PolicyType.ValueString() == core.LoadBalancerPolicyTypeApp && config.CookieName.IsNull() {
+		response.Diagnostics.AddAttributeError(path.Root("cookie_name"), "missing cookie name", "A cookie name is required for `app` policies.")
+	}
+	if config.PolicyType.ValueString() == core.LoadBalancerPolicyTypeLoadBalancer && !config.CookieName.IsNull() {
+		response.Diagnostics.AddAttributeError(path.Root("cookie_name"), "unexpected cookie name", "A cookie name can only be set on `app` policies.")
+	}
+}
+
+func (r *loadBalancerPolicyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan resource_load_balancer_policy.LoadBalancerPolicyModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := core.CreateLoadBalancerPolicy(ctx, provider, plan.LoadBalancerName.ValueString(), deserializeCreateLoadBalancerPolicy(plan))
+	if err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create load balancer policy", err, request.Plan)...)
+		return
+	}
+
+	state := serializeLoadBalancerPolicy(policy, plan)
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *loadBalancerPolicyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state resource_load_balancer_policy.LoadBalancerPolicyModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := core.ReadLoadBalancerPolicy(ctx, provider, state.LoadBalancerName.ValueString(), state.Name.ValueString())
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError("unable to read load balancer policy", err.Error())
+		return
+	}
+
+	newState := serializeLoadBalancerPolicy(policy, state)
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *loadBalancerPolicyResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state, plan resource_load_balancer_policy.LoadBalancerPolicyModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The API cannot update policies, every other attribute requires a replacement
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *loadBalancerPolicyResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state resource_load_balancer_policy.LoadBalancerPolicyModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := core.DeleteLoadBalancerPolicy(ctx, provider, state.LoadBalancerName.ValueString(), state.Name.ValueString()); err != nil && !utils.IsNotFound(err) {
+		response.Diagnostics.AddError("unable to delete load balancer policy", err.Error())
+		return
+	}
+}
+
+func deserializeCreateLoadBalancerPolicy(tf resource_load_balancer_policy.LoadBalancerPolicyModel) api.CreateLoadBalancerPolicyJSONRequestBody {
+	return api.CreateLoadBalancerPolicyJSONRequestBody{
+		CookieExpirationPeriod: utils.FromTfInt64ToIntPtr(tf.CookieExpirationPeriod),
+		CookieName:             utils.FromTfStringToStringPtr(tf.CookieName),
+		PolicyName:             tf.Name.ValueString(),
+		PolicyType:             tf.PolicyType.ValueString(),
+	}
+}
+
+// serializeLoadBalancerPolicy keeps the cookie expiration period of the model when it is not configured, the API
+// returns its default value for load balancer policies
+func serializeLoadBalancerPolicy(policy *core.LoadBalancerPolicy, tf resource_load_balancer_policy.LoadBalancerPolicyModel) resource_load_balancer_policy.LoadBalancerPolicyModel {
+	cookieExpirationPeriod := tf.CookieExpirationPeriod
+	if !cookieExpirationPeriod.IsNull() && policy.CookieExpirationPeriod != nil {
+		cookieExpirationPeriod = utils.FromIntPtrToTfInt64(policy.CookieExpirationPeriod)
+	}
+
+	return resource_load_balancer_policy.LoadBalancerPolicyModel{
+		CookieExpirationPeriod: cookieExpirationPeriod,
+		CookieName:             types.StringPointerValue(policy.CookieName),
+		Id:                     types.StringValue(tf.LoadBalancerName.ValueString() + "/" + policy.PolicyName),
+		LoadBalancerName:       tf.LoadBalancerName,
+		Name:                   types.StringValue(policy.PolicyName),
+		PolicyType:             types.StringValue(policy.PolicyType),
+	}
+}
@@ -0,0 +1,94 @@
+package location
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/location/datasource_location"
+)
+
+var _ datasource.DataSource = &locationsDataSource{}
+
+type locationsDataSource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewLocationsDataSource() datasource.DataSource {
+	return &locationsDataSource{}
+}
+
+func (d *locationsDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	d.provider = services.ConfigureProviderDatasource(request, response)
+}
+
+func (d *locationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_locations"
+}
+
+func (d *locationsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_location.LocationDataSourceSchema(ctx)
+}
+
+func (d *locationsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state, plan datasource_location.LocationModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	locations, err := core.ReadLocations(ctx, provider)
+	if err != nil {
+		response.Diagnostics.AddError("unable to read locations", err.Error())
+		return
+	}
+
+	items := serializeLocationsDatasource(ctx, locations, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state = plan
+	state.Items = items
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func serializeLocationsDatasource(ctx context.Context, locations []api.Location, diags *diag.Diagnostics) types.List {
+	itemsValue := make([]datasource_location.ItemsValue, 0, len(locations))
+
+	for _, location := range locations {
+		item, serializeDiags := datasource_location.NewItemsValue(datasource_location.ItemsValue{}.AttributeTypes(ctx), map[string]attr.Value{
+			"location_code":   types.StringValue(location.LocationCode),
+			"location_name":   types.StringValue(location.LocationName),
+			"region":          types.StringValue(location.Region),
+			"sub_region_name": types.StringValue(location.SubRegionName),
+		})
+		if serializeDiags.HasError() {
+			diags.Append(serializeDiags...)
+			continue
+		}
+		itemsValue = append(itemsValue, item)
+	}
+
+	list, serializeDiags := types.ListValueFrom(ctx, new(datasource_location.ItemsValue).Type(ctx), itemsValue)
+	diags.Append(serializeDiags...)
+
+	return list
+}
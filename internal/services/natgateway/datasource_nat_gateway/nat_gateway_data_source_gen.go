@@ -107,6 +107,12 @@ func NatGatewayDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Information about one or more NAT gateways.",
 				MarkdownDescription: "Information about one or more NAT gateways.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
 			"states": schema.ListAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
@@ -154,14 +160,15 @@ func NatGatewayDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type NatGatewayModel struct {
-	Ids       types.List `tfsdk:"ids"`
-	Items     types.List `tfsdk:"items"`
-	States    types.List `tfsdk:"states"`
-	SubnetIds types.List `tfsdk:"subnet_ids"`
-	TagKeys   types.List `tfsdk:"tag_keys"`
-	TagValues types.List `tfsdk:"tag_values"`
-	Tags      types.List `tfsdk:"tags"`
-	VpcIds    types.List `tfsdk:"vpc_ids"`
+	Ids       types.List   `tfsdk:"ids"`
+	Items     types.List   `tfsdk:"items"`
+	SpaceId   types.String `tfsdk:"space_id"`
+	States    types.List   `tfsdk:"states"`
+	SubnetIds types.List   `tfsdk:"subnet_ids"`
+	TagKeys   types.List   `tfsdk:"tag_keys"`
+	TagValues types.List   `tfsdk:"tag_values"`
+	Tags      types.List   `tfsdk:"tags"`
+	VpcIds    types.List   `tfsdk:"vpc_ids"`
 }
 
 var _ basetypes.ObjectTypable = ItemsType{}
@@ -48,12 +48,17 @@ func (d *natGatewaysDataSource) Read(ctx context.Context, request datasource.Rea
 		return
 	}
 
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	params := deserializeNatGatewaysParams(ctx, plan, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	numSpotNATGateway, err := core.ReadNATGatewaysWithParams(ctx, d.provider, params)
+	numSpotNATGateway, err := core.ReadNATGatewaysWithParams(ctx, provider, params)
 	if err != nil {
 		response.Diagnostics.AddError("unable to read nat gateway", err.Error())
 		return
@@ -71,6 +76,7 @@ func (d *natGatewaysDataSource) Read(ctx context.Context, request datasource.Rea
 
 	state = plan
 	state.Items = listValueItems
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
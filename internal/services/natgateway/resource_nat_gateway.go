@@ -40,6 +40,7 @@ func (r *natGatewayResource) Configure(_ context.Context, request resource.Confi
 }
 
 func (r *natGatewayResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
@@ -59,11 +60,24 @@ func (r *natGatewayResource) Create(ctx context.Context, request resource.Create
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	tagsValue := natGatewayTags(ctx, plan.Tags)
 
-	natGateway, err := core.CreateNATGateway(ctx, r.provider, tagsValue, deserializeCreateNATGateway(plan))
+	natGateway, err := core.CreateNATGateway(ctx, provider, tagsValue, deserializeCreateNATGateway(plan))
 	if err != nil {
-		response.Diagnostics.AddError("unable to create nat gateway", err.Error())
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create nat gateway", err, request.Plan)...)
 		return
 	}
 
@@ -72,6 +86,8 @@ func (r *natGatewayResource) Create(ctx context.Context, request resource.Create
 		return
 	}
 
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
@@ -83,9 +99,26 @@ func (r *natGatewayResource) Read(ctx context.Context, request resource.ReadRequ
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	natGatewayID := state.Id.ValueString()
 
-	numSpotNatGateway, err := core.ReadNATGateway(ctx, r.provider, natGatewayID)
+	numSpotNatGateway, err := core.ReadNATGateway(ctx, provider, natGatewayID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError("unable to read nat gateway", err.Error())
 		return
@@ -96,6 +129,8 @@ func (r *natGatewayResource) Read(ctx context.Context, request resource.ReadRequ
 		return
 	}
 
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 }
 
@@ -116,14 +151,27 @@ func (r *natGatewayResource) Update(ctx context.Context, request resource.Update
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	natGatewayID := state.Id.ValueString()
 	planTags := natGatewayTags(ctx, plan.Tags)
 	stateTags := natGatewayTags(ctx, state.Tags)
 
 	if !state.Tags.Equal(plan.Tags) {
-		numSpotNatGateway, err = core.UpdateNATGatewayTags(ctx, r.provider, stateTags, planTags, natGatewayID)
+		numSpotNatGateway, err = core.UpdateNATGatewayTags(ctx, provider, stateTags, planTags, natGatewayID)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update nat gateway tags", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update nat gateway tags", err, request.Plan)...)
 			return
 		}
 	}
@@ -133,6 +181,8 @@ func (r *natGatewayResource) Update(ctx context.Context, request resource.Update
 		return
 	}
 
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 }
 
@@ -144,9 +194,22 @@ func (r *natGatewayResource) Delete(ctx context.Context, request resource.Delete
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	natGatewayID := state.Id.ValueString()
 
-	if err := core.DeleteNATGateway(ctx, r.provider, natGatewayID); err != nil {
+	if err := core.DeleteNATGateway(ctx, provider, natGatewayID); err != nil {
 		response.Diagnostics.AddError("unable to delete nat gateway", err.Error())
 		return
 	}
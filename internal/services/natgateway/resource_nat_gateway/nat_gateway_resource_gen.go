@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -54,6 +55,16 @@ func NatGatewayResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Information about the public IP or IPs associated with the NAT gateway.",
 				MarkdownDescription: "Information about the public IP or IPs associated with the NAT gateway.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"state": schema.StringAttribute{
 				Computed:            true,
 				Description:         "The state of the NAT gateway (`pending` \\| `available` \\| `deleting` \\| `deleted`).",
@@ -98,17 +109,27 @@ func NatGatewayResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "The ID of the Vpc in which the NAT gateway is.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type NatGatewayModel struct {
-	Id         types.String `tfsdk:"id"`
-	PublicIpId types.String `tfsdk:"public_ip_id"`
-	PublicIps  types.List   `tfsdk:"public_ips"`
-	State      types.String `tfsdk:"state"`
-	SubnetId   types.String `tfsdk:"subnet_id"`
-	Tags       types.Set    `tfsdk:"tags"`
-	VpcId      types.String `tfsdk:"vpc_id"`
+	Id         types.String   `tfsdk:"id"`
+	PublicIpId types.String   `tfsdk:"public_ip_id"`
+	PublicIps  types.List     `tfsdk:"public_ips"`
+	SpaceId    types.String   `tfsdk:"space_id"`
+	State      types.String   `tfsdk:"state"`
+	SubnetId   types.String   `tfsdk:"subnet_id"`
+	Tags       types.Set      `tfsdk:"tags"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+	VpcId      types.String   `tfsdk:"vpc_id"`
 }
 
 var _ basetypes.ObjectTypable = PublicIpsType{}
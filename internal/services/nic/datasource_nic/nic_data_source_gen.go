@@ -385,6 +385,12 @@ func NicDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The names of the security groups associated with the NICs.",
 				MarkdownDescription: "The names of the security groups associated with the NICs.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
 			"states": schema.ListAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
@@ -432,32 +438,33 @@ func NicDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type NicModel struct {
-	AvailabilityZoneNames           types.List `tfsdk:"availability_zone_names"`
-	Descriptions                    types.List `tfsdk:"descriptions"`
-	Ids                             types.List `tfsdk:"ids"`
-	IsSourceDestCheck               types.Bool `tfsdk:"is_source_dest_check"`
-	Items                           types.List `tfsdk:"items"`
-	LinkNicDeleteOnVmDeletion       types.Bool `tfsdk:"link_nic_delete_on_vm_deletion"`
-	LinkNicDeviceNumbers            types.List `tfsdk:"link_nic_device_numbers"`
-	LinkNicLinkNicIds               types.List `tfsdk:"link_nic_link_nic_ids"`
-	LinkNicStates                   types.List `tfsdk:"link_nic_states"`
-	LinkNicVmIds                    types.List `tfsdk:"link_nic_vm_ids"`
-	LinkPublicIpLinkPublicIpIds     types.List `tfsdk:"link_public_ip_link_public_ip_ids"`
-	LinkPublicIpPublicIpIds         types.List `tfsdk:"link_public_ip_public_ip_ids"`
-	LinkPublicIpPublicIps           types.List `tfsdk:"link_public_ip_public_ips"`
-	MacAddresses                    types.List `tfsdk:"mac_addresses"`
-	PrivateDnsNames                 types.List `tfsdk:"private_dns_names"`
-	PrivateIpsLinkPublicIpPublicIps types.List `tfsdk:"private_ips_link_public_ip_public_ips"`
-	PrivateIpsPrimaryIp             types.Bool `tfsdk:"private_ips_primary_ip"`
-	PrivateIpsPrivateIps            types.List `tfsdk:"private_ips_private_ips"`
-	SecurityGroupIds                types.List `tfsdk:"security_group_ids"`
-	SecurityGroupNames              types.List `tfsdk:"security_group_names"`
-	States                          types.List `tfsdk:"states"`
-	SubnetIds                       types.List `tfsdk:"subnet_ids"`
-	TagKeys                         types.List `tfsdk:"tag_keys"`
-	TagValues                       types.List `tfsdk:"tag_values"`
-	Tags                            types.List `tfsdk:"tags"`
-	VpcIds                          types.List `tfsdk:"vpc_ids"`
+	AvailabilityZoneNames           types.List   `tfsdk:"availability_zone_names"`
+	Descriptions                    types.List   `tfsdk:"descriptions"`
+	Ids                             types.List   `tfsdk:"ids"`
+	IsSourceDestCheck               types.Bool   `tfsdk:"is_source_dest_check"`
+	Items                           types.List   `tfsdk:"items"`
+	LinkNicDeleteOnVmDeletion       types.Bool   `tfsdk:"link_nic_delete_on_vm_deletion"`
+	LinkNicDeviceNumbers            types.List   `tfsdk:"link_nic_device_numbers"`
+	LinkNicLinkNicIds               types.List   `tfsdk:"link_nic_link_nic_ids"`
+	LinkNicStates                   types.List   `tfsdk:"link_nic_states"`
+	LinkNicVmIds                    types.List   `tfsdk:"link_nic_vm_ids"`
+	LinkPublicIpLinkPublicIpIds     types.List   `tfsdk:"link_public_ip_link_public_ip_ids"`
+	LinkPublicIpPublicIpIds         types.List   `tfsdk:"link_public_ip_public_ip_ids"`
+	LinkPublicIpPublicIps           types.List   `tfsdk:"link_public_ip_public_ips"`
+	MacAddresses                    types.List   `tfsdk:"mac_addresses"`
+	PrivateDnsNames                 types.List   `tfsdk:"private_dns_names"`
+	PrivateIpsLinkPublicIpPublicIps types.List   `tfsdk:"private_ips_link_public_ip_public_ips"`
+	PrivateIpsPrimaryIp             types.Bool   `tfsdk:"private_ips_primary_ip"`
+	PrivateIpsPrivateIps            types.List   `tfsdk:"private_ips_private_ips"`
+	SecurityGroupIds                types.List   `tfsdk:"security_group_ids"`
+	SecurityGroupNames              types.List   `tfsdk:"security_group_names"`
+	SpaceId                         types.String `tfsdk:"space_id"`
+	States                          types.List   `tfsdk:"states"`
+	SubnetIds                       types.List   `tfsdk:"subnet_ids"`
+	TagKeys                         types.List   `tfsdk:"tag_keys"`
+	TagValues                       types.List   `tfsdk:"tag_values"`
+	Tags                            types.List   `tfsdk:"tags"`
+	VpcIds                          types.List   `tfsdk:"vpc_ids"`
 }
 
 var _ basetypes.ObjectTypable = ItemsType{}
@@ -49,12 +49,17 @@ func (d *nicsDataSource) Read(ctx context.Context, request datasource.ReadReques
 		return
 	}
 
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	params := deserializeReadParams(ctx, plan, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	nics, err := core.ReadNicsWithParams(ctx, d.provider, params)
+	nics, err := core.ReadNicsWithParams(ctx, provider, params)
 	if err != nil {
 		response.Diagnostics.AddError("unable to read nic with params", err.Error())
 		return
@@ -72,6 +77,7 @@ func (d *nicsDataSource) Read(ctx context.Context, request datasource.ReadReques
 
 	state = plan
 	state.Items = listValueItems
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -42,6 +42,7 @@ func (r *nicResource) Configure(_ context.Context, request resource.ConfigureReq
 }
 
 func (r *nicResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
@@ -61,6 +62,19 @@ func (r *nicResource) Create(ctx context.Context, request resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	tagsValue := nicTags(ctx, plan.Tags)
 	body := deserializeCreateNumSpotNic(ctx, plan, &response.Diagnostics)
 
@@ -68,9 +82,9 @@ func (r *nicResource) Create(ctx context.Context, request resource.CreateRequest
 		linkNicBody = deserializeLinkNic(plan.LinkNic)
 	}
 
-	nic, err := core.CreateNic(ctx, r.provider, body, tagsValue, linkNicBody)
+	nic, err := core.CreateNic(ctx, provider, body, tagsValue, linkNicBody)
 	if err != nil {
-		response.Diagnostics.AddError("unable to create nic", err.Error())
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create nic", err, request.Plan)...)
 		return
 	}
 
@@ -79,6 +93,8 @@ func (r *nicResource) Create(ctx context.Context, request resource.CreateRequest
 		return
 	}
 
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
 
@@ -90,8 +106,25 @@ func (r *nicResource) Read(ctx context.Context, request resource.ReadRequest, re
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	nicID := state.Id.ValueString()
-	numSpotNic, err := core.ReadNicWithID(ctx, r.provider, nicID)
+	numSpotNic, err := core.ReadNicWithID(ctx, provider, nicID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError("unable to read Nic", err.Error())
 		return
@@ -102,6 +135,8 @@ func (r *nicResource) Read(ctx context.Context, request resource.ReadRequest, re
 		return
 	}
 
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 }
 
@@ -117,22 +152,35 @@ func (r *nicResource) Update(ctx context.Context, request resource.UpdateRequest
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	nicId := state.Id.ValueString()
 	planTags := nicTags(ctx, plan.Tags)
 	stateTags := nicTags(ctx, state.Tags)
 
 	if !state.Tags.Equal(plan.Tags) {
-		numspotNic, err = core.UpdateNicTags(ctx, r.provider, nicId, stateTags, planTags)
+		numspotNic, err = core.UpdateNicTags(ctx, provider, nicId, stateTags, planTags)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update nic tags", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update nic tags", err, request.Plan)...)
 			return
 		}
 	}
 
 	if !utils.IsTfValueNull(plan.LinkNic) || !utils.IsTfValueNull(state.LinkNic) {
-		numspotNic, err = core.UpdateNicLink(ctx, r.provider, nicId, deserializeUnlinkNic(state.LinkNic), deserializeLinkNic(plan.LinkNic))
+		numspotNic, err = core.UpdateNicLink(ctx, provider, nicId, deserializeUnlinkNic(state.LinkNic), deserializeLinkNic(plan.LinkNic))
 		if err != nil {
-			response.Diagnostics.AddError("unable to update nic link", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update nic link", err, request.Plan)...)
 			return
 		}
 	}
@@ -142,9 +190,9 @@ func (r *nicResource) Update(ctx context.Context, request resource.UpdateRequest
 		if response.Diagnostics.HasError() {
 			return
 		}
-		numspotNic, err = core.UpdateNicAttributes(ctx, r.provider, body, nicId)
+		numspotNic, err = core.UpdateNicAttributes(ctx, provider, body, nicId)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update nic attributes", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update nic attributes", err, request.Plan)...)
 			return
 		}
 	}
@@ -154,6 +202,8 @@ func (r *nicResource) Update(ctx context.Context, request resource.UpdateRequest
 		return
 	}
 
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 }
 
@@ -164,7 +214,20 @@ func (r *nicResource) Delete(ctx context.Context, request resource.DeleteRequest
 		return
 	}
 
-	if err := core.DeleteNic(ctx, r.provider, state.Id.ValueString(), deserializeUnlinkNic(state.LinkNic)); err != nil {
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := core.DeleteNic(ctx, provider, state.Id.ValueString(), deserializeUnlinkNic(state.LinkNic)); err != nil {
 		response.Diagnostics.AddError("unable to delete Nic", err.Error())
 		return
 	}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -234,6 +235,16 @@ func NicResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "One or more IDs of security groups for the NIC.",
 				MarkdownDescription: "One or more IDs of security groups for the NIC.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"state": schema.StringAttribute{
 				Computed:            true,
 				Description:         "The state of the NIC (`available` \\| `attaching` \\| `in-use` \\| `detaching`).",
@@ -278,6 +289,14 @@ func NicResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "The ID of the Vpc for the NIC.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -293,9 +312,11 @@ type NicModel struct {
 	PrivateIps           types.Set         `tfsdk:"private_ips"`
 	SecurityGroupIds     types.List        `tfsdk:"security_group_ids"`
 	SecurityGroups       types.List        `tfsdk:"security_groups"`
+	SpaceId              types.String      `tfsdk:"space_id"`
 	State                types.String      `tfsdk:"state"`
 	SubnetId             types.String      `tfsdk:"subnet_id"`
 	Tags                 types.Set         `tfsdk:"tags"`
+	Timeouts             timeouts.Value    `tfsdk:"timeouts"`
 	VpcId                types.String      `tfsdk:"vpc_id"`
 }
 
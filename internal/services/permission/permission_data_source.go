@@ -0,0 +1,129 @@
+package permission
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/permission/datasource_permission"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var _ datasource.DataSource = &permissionsDataSource{}
+
+type permissionsDataSource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewPermissionsDataSource() datasource.DataSource {
+	return &permissionsDataSource{}
+}
+
+func (d *permissionsDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	d.provider = services.ConfigureProviderDatasource(request, response)
+}
+
+func (d *permissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions"
+}
+
+func (d *permissionsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_permission.PermissionDataSourceSchema(ctx)
+}
+
+func (d *permissionsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state, plan datasource_permission.PermissionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	organisationID, err := services.ParseOrganisationID(plan.OrganisationId)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse organisation id", err.Error())
+		return
+	}
+
+	provider := services.ScopeProvider(d.provider, organisationID, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var permissions []api.RegisteredPermission
+	if !plan.RoleId.IsNull() {
+		roleID, err := uuid.Parse(plan.RoleId.ValueString())
+		if err != nil {
+			response.Diagnostics.AddError("unable to parse role id", err.Error())
+			return
+		}
+
+		permissions, err = core.ReadRolePermissions(ctx, provider, organisationID, roleID)
+		if err != nil {
+			response.Diagnostics.AddError("unable to read role permissions", err.Error())
+			return
+		}
+	} else {
+		permissions, err = core.ReadPermissions(ctx, provider, organisationID, deserializePermissionsParams(plan))
+		if err != nil {
+			response.Diagnostics.AddError("unable to read permissions", err.Error())
+			return
+		}
+	}
+
+	items := serializePermissionsDatasource(ctx, permissions, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state = plan
+	state.Items = items
+	state.SpaceId = services.ScopeSpaceID(provider, organisationID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func deserializePermissionsParams(tf datasource_permission.PermissionModel) api.ListPermissionsSpaceParams {
+	return api.ListPermissionsSpaceParams{
+		Action:      utils.FromTfStringToStringPtr(tf.Action),
+		Resource:    utils.FromTfStringToStringPtr(tf.Resource),
+		Service:     utils.FromTfStringToStringPtr(tf.Service),
+		Subresource: utils.FromTfStringToStringPtr(tf.Subresource),
+	}
+}
+
+func serializePermissionsDatasource(ctx context.Context, permissions []api.RegisteredPermission, diags *diag.Diagnostics) types.List {
+	itemsValue := make([]datasource_permission.ItemsValue, 0, len(permissions))
+
+	for _, permission := range permissions {
+		item, serializeDiags := datasource_permission.NewItemsValue(datasource_permission.ItemsValue{}.AttributeTypes(ctx), map[string]attr.Value{
+			"action":      types.StringValue(permission.Action),
+			"description": types.StringValue(permission.Description),
+			"id":          types.StringValue(permission.Uuid.String()),
+			"name":        types.StringValue(permission.Name),
+			"resource":    types.StringPointerValue(permission.Resource),
+			"service":     types.StringValue(permission.Service),
+			"subresource": types.StringPointerValue(permission.SubResource),
+		})
+		if serializeDiags.HasError() {
+			diags.Append(serializeDiags...)
+			continue
+		}
+		itemsValue = append(itemsValue, item)
+	}
+
+	list, serializeDiags := types.ListValueFrom(ctx, new(datasource_permission.ItemsValue).Type(ctx), itemsValue)
+	diags.Append(serializeDiags...)
+
+	return list
+}
@@ -51,7 +51,12 @@ func (d *postgresClusterDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	clusters, err := core.ReadPostgresClusters(ctx, d.provider)
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusters, err := core.ReadPostgresClusters(ctx, provider)
 	if err != nil {
 		resp.Diagnostics.AddError("unable to read postgres clusters", err.Error())
 		return
@@ -69,6 +74,7 @@ func (d *postgresClusterDataSource) Read(ctx context.Context, req datasource.Rea
 
 	state = plan
 	state.Items = listValueItems
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -2,11 +2,13 @@ package postgres_cluster
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-numspot/internal/client"
@@ -14,9 +16,14 @@ import (
 	"terraform-provider-numspot/internal/sdk/api"
 	"terraform-provider-numspot/internal/services"
 	"terraform-provider-numspot/internal/services/postgres_cluster/resource_postgres_cluster"
+	"terraform-provider-numspot/internal/utils"
 )
 
-var _ resource.Resource = &postgresClusterResource{}
+var (
+	_ resource.Resource                = &postgresClusterResource{}
+	_ resource.ResourceWithImportState = &postgresClusterResource{}
+	_ resource.ResourceWithModifyPlan  = &postgresClusterResource{}
+)
 
 func (r *postgresClusterResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
@@ -34,6 +41,16 @@ type postgresClusterResource struct {
 	provider *client.NumSpotSDK
 }
 
+func (r *postgresClusterResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
+	if _, err := uuid.Parse(request.ID); err != nil {
+		response.Diagnostics.AddError("unexpected import identifier", fmt.Sprintf("Expected the cluster ID as import identifier. Got: %q", request.ID))
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
 func (r *postgresClusterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_postgres_cluster"
 }
@@ -50,16 +67,28 @@ func (r *postgresClusterResource) Create(ctx context.Context, request resource.C
 		return
 	}
 
-	var diags diag.Diagnostics
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestStateRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	body := deserializeCreatePostgresCluster(plan, &diags)
 	if diags.HasError() {
 		response.Diagnostics.Append(diags...)
 		return
 	}
 
-	res, err := core.CreatePostgresCluster(ctx, r.provider, *body)
+	res, err := core.CreatePostgresCluster(ctx, provider, *body)
 	if err != nil {
-		response.Diagnostics.AddError("unable to create postgres cluster", err.Error())
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create postgres cluster", err, request.Plan)...)
 		return
 	}
 
@@ -68,6 +97,8 @@ func (r *postgresClusterResource) Create(ctx context.Context, request resource.C
 		return
 	}
 
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
@@ -79,9 +110,26 @@ func (r *postgresClusterResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	readTimeout, diags := plan.Timeouts.Read(ctx, utils.TfRequestStateRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterId := uuid.MustParse(plan.Id.String())
 
-	res, err := core.ReadPostgresCluster(ctx, r.provider, clusterId)
+	res, err := core.ReadPostgresCluster(ctx, provider, clusterId)
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("unable to read postgres cluster", err.Error())
 		return
@@ -89,10 +137,77 @@ func (r *postgresClusterResource) Read(ctx context.Context, req resource.ReadReq
 
 	state := serializePostgresCluster(ctx, res, &resp.Diagnostics, plan)
 
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *postgresClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan resource_postgres_cluster.PostgresClusterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, modified := deserializeModifyPostgresCluster(plan, state)
+	if !modified {
+		state.Timeouts = plan.Timeouts
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestStateRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterId := uuid.MustParse(state.Id.ValueString())
+
+	res, err := core.UpdatePostgresCluster(ctx, provider, clusterId, body)
+	if err != nil {
+		resp.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update postgres cluster", err, req.Plan)...)
+		return
+	}
+
+	newState := serializePostgresCluster(ctx, res, &resp.Diagnostics, plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+// ModifyPlan rejects the reduction of the volume size, the API only allows to expand volumes
+func (r *postgresClusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var state, plan resource_postgres_cluster.PostgresClusterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || utils.IsTfValueNull(plan.Volume) || utils.IsTfValueNull(state.Volume) || plan.Volume.SizeGiB.IsUnknown() {
+		return
+	}
+
+	if plan.Volume.SizeGiB.ValueInt64() < state.Volume.SizeGiB.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("volume").AtName("size_gi_b"),
+			"volume size cannot be reduced",
+			fmt.Sprintf("The volume of a postgres cluster can only be expanded, it cannot be reduced from %d GiB to %d GiB.", state.Volume.SizeGiB.ValueInt64(), plan.Volume.SizeGiB.ValueInt64()),
+		)
+	}
 }
 
 func (r *postgresClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -103,9 +218,22 @@ func (r *postgresClusterResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, utils.TfRequestStateRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterId := uuid.MustParse(plan.Id.String())
 
-	if err := core.DeletePostgresCluster(ctx, r.provider, clusterId); err != nil {
+	if err := core.DeletePostgresCluster(ctx, provider, clusterId); err != nil {
 		resp.Diagnostics.AddError("unable to delete postgres cluster", err.Error())
 		return
 	}
@@ -182,6 +310,35 @@ func deserializeCreatePostgresCluster(tf resource_postgres_cluster.PostgresClust
 	}
 }
 
+// deserializeModifyPostgresCluster returns the modification request holding the replica count, node configuration and
+// volume size changed between state and plan, and whether any of them changed
+func deserializeModifyPostgresCluster(plan, state resource_postgres_cluster.PostgresClusterModel) (api.PostgresClusterModificationRequest, bool) {
+	var body api.PostgresClusterModificationRequest
+
+	if !plan.ReplicaCount.IsUnknown() && !plan.ReplicaCount.Equal(state.ReplicaCount) {
+		body.ReplicaCount = utils.PointerOf(api.PostgresReplicaCount(plan.ReplicaCount.ValueInt64()))
+	}
+
+	if !utils.IsTfValueNull(plan.NodeConfiguration) && !utils.IsTfValueNull(state.NodeConfiguration) {
+		var nodeConfiguration api.PostgresNodeConfigurationModification
+		if !plan.NodeConfiguration.MemorySizeGiB.Equal(state.NodeConfiguration.MemorySizeGiB) {
+			nodeConfiguration.MemorySizeGiB = utils.PointerOf(api.PostgresMemorySizeGiB(plan.NodeConfiguration.MemorySizeGiB.ValueInt64()))
+		}
+		if !plan.NodeConfiguration.VcpuCount.Equal(state.NodeConfiguration.VcpuCount) {
+			nodeConfiguration.VcpuCount = utils.PointerOf(api.PostgresVCPUCount(plan.NodeConfiguration.VcpuCount.ValueInt64()))
+		}
+		if nodeConfiguration.MemorySizeGiB != nil || nodeConfiguration.VcpuCount != nil {
+			body.NodeConfiguration = &nodeConfiguration
+		}
+	}
+
+	if !utils.IsTfValueNull(plan.Volume) && !utils.IsTfValueNull(state.Volume) && !plan.Volume.SizeGiB.Equal(state.Volume.SizeGiB) {
+		body.VolumeSizeGiB = utils.PointerOf(int32(plan.Volume.SizeGiB.ValueInt64()))
+	}
+
+	return body, body.ReplicaCount != nil || body.NodeConfiguration != nil || body.VolumeSizeGiB != nil
+}
+
 func deserializeExtensionsMapping(ext resource_postgres_cluster.ExtensionsValue) api.PostgresExtension {
 	return api.PostgresExtension{
 		Name: api.PostgresExtensionName(ext.Name.ValueString()),
@@ -256,7 +413,7 @@ func serializePostgresCluster(ctx context.Context, cluster *api.PostgresCluster,
 	}
 
 	return resource_postgres_cluster.PostgresClusterModel{
-		Visibility:        types.StringValue(plan.Visibility.ValueString()),
+		Visibility:        types.StringValue(string(cluster.Visibility)),
 		CreatedOn:         types.StringValue(cluster.CreatedOn.Format(time.RFC3339)),
 		Extensions:        extensionList,
 		ReplicaCount:      types.Int64Value(int64(cluster.ReplicaCount)),
@@ -0,0 +1,57 @@
+package postgres_cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-numspot/internal/services/postgres_cluster/resource_postgres_cluster"
+)
+
+// postgresClusterValue returns a cluster with every attribute null but its volume
+func postgresClusterValue(ctx context.Context, volumeSizeGiB int64) tftypes.Value {
+	objectType := resource_postgres_cluster.PostgresClusterResourceSchema(ctx).Type().TerraformType(ctx).(tftypes.Object)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	volumeType := objectType.AttributeTypes["volume"].(tftypes.Object)
+	values["volume"] = tftypes.NewValue(volumeType, map[string]tftypes.Value{
+		"size_gi_b": tftypes.NewValue(tftypes.Number, volumeSizeGiB),
+		"type":      tftypes.NewValue(tftypes.String, "GP2"),
+	})
+
+	return tftypes.NewValue(objectType, values)
+}
+
+func TestPostgresClusterModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := &postgresClusterResource{}
+	schema := resource_postgres_cluster.PostgresClusterResourceSchema(ctx)
+
+	modifyPlan := func(stateSizeGiB, planSizeGiB int64) *resource.ModifyPlanResponse {
+		plan := tfsdk.Plan{Schema: schema, Raw: postgresClusterValue(ctx, planSizeGiB)}
+		response := &resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+			Plan:  plan,
+			State: tfsdk.State{Schema: schema, Raw: postgresClusterValue(ctx, stateSizeGiB)},
+		}, response)
+		return response
+	}
+
+	assert.False(t, modifyPlan(10, 10).Diagnostics.HasError())
+	assert.False(t, modifyPlan(10, 20).Diagnostics.HasError())
+
+	response := modifyPlan(20, 10)
+	require.Equal(t, 1, response.Diagnostics.ErrorsCount())
+	assert.Equal(t, "volume size cannot be reduced", response.Diagnostics.Errors()[0].Summary())
+	diagnostic, ok := response.Diagnostics.Errors()[0].(interface{ Path() path.Path })
+	require.True(t, ok)
+	assert.Equal(t, path.Root("volume").AtName("size_gi_b"), diagnostic.Path())
+}
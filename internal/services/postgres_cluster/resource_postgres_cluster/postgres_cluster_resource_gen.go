@@ -11,7 +11,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -19,6 +22,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -61,6 +65,10 @@ func PostgresClusterResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "List of extensions on the cluster.",
 				MarkdownDescription: "List of extensions on the cluster.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+					listplanmodifier.RequiresReplaceIfConfigured(),
+				},
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(50),
 					listvalidator.UniqueValues(),
@@ -86,6 +94,9 @@ func PostgresClusterResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "The version of postgresql to create a cluster.",
 				MarkdownDescription: "The version of postgresql to create a cluster.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 				Validators: []validator.String{
 					stringvalidator.OneOf(
 						"16",
@@ -99,6 +110,9 @@ func PostgresClusterResourceSchema(ctx context.Context) schema.Schema {
 				Required:            true,
 				Description:         "A PostgreSQL cluster name.",
 				MarkdownDescription: "A PostgreSQL cluster name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(3, 63),
 					stringvalidator.RegexMatches(regexp.MustCompile("^[a-zA-Z0-9]+(?:[-_][a-zA-Z0-9]+)*$"), ""),
@@ -147,6 +161,16 @@ func PostgresClusterResourceSchema(ctx context.Context) schema.Schema {
 				},
 				Default: int64default.StaticInt64(0),
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"status": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"message": schema.StringAttribute{
@@ -173,6 +197,9 @@ func PostgresClusterResourceSchema(ctx context.Context) schema.Schema {
 				Required:            true,
 				Description:         "The name of the user on the cluster.",
 				MarkdownDescription: "The name of the user on the cluster.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(3, 63),
 					stringvalidator.RegexMatches(regexp.MustCompile("^[a-zA-Z0-9]+(?:[-_][a-zA-Z0-9]+)*$"), ""),
@@ -182,6 +209,9 @@ func PostgresClusterResourceSchema(ctx context.Context) schema.Schema {
 				Required:            true,
 				Description:         "Cluster exposition method.",
 				MarkdownDescription: "Cluster exposition method.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 				Validators: []validator.String{
 					stringvalidator.OneOf(
 						"EXTERNAL",
@@ -203,6 +233,9 @@ func PostgresClusterResourceSchema(ctx context.Context) schema.Schema {
 						Required:            true,
 						Description:         "The storage volume type.",
 						MarkdownDescription: "The storage volume type.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
 						Validators: []validator.String{
 							stringvalidator.OneOf(
 								"GP2",
@@ -222,6 +255,14 @@ func PostgresClusterResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "Configuration for a PostgreSQL storage volume.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -237,7 +278,9 @@ type PostgresClusterModel struct {
 	NodeConfiguration NodeConfigurationValue `tfsdk:"node_configuration"`
 	Port              types.Int64            `tfsdk:"port"`
 	ReplicaCount      types.Int64            `tfsdk:"replica_count"`
+	SpaceId           types.String           `tfsdk:"space_id"`
 	Status            StatusValue            `tfsdk:"status"`
+	Timeouts          timeouts.Value         `tfsdk:"timeouts"`
 	User              types.String           `tfsdk:"user"`
 	Visibility        types.String           `tfsdk:"visibility"`
 	Volume            VolumeValue            `tfsdk:"volume"`
@@ -0,0 +1,74 @@
+package postgres_cluster_credentials
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/postgres_cluster_credentials/datasource_postgres_cluster_credentials"
+)
+
+var _ datasource.DataSource = &postgresClusterCredentialsDataSource{}
+
+func (d *postgresClusterCredentialsDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	d.provider = services.ConfigureProviderDatasource(request, response)
+}
+
+func NewPostgresClusterCredentialsDataSource() datasource.DataSource {
+	return &postgresClusterCredentialsDataSource{}
+}
+
+// postgresClusterCredentialsDataSource stores the password in the state, the ephemeral resource should be preferred on
+// Terraform 1.10 and later
+type postgresClusterCredentialsDataSource struct {
+	provider *client.NumSpotSDK
+}
+
+func (d *postgresClusterCredentialsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgres_cluster_credentials"
+}
+
+func (d *postgresClusterCredentialsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_postgres_cluster_credentials.PostgresClusterCredentialsDataSourceSchema(ctx)
+}
+
+func (d *postgresClusterCredentialsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state datasource_postgres_cluster_credentials.PostgresClusterCredentialsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	provider := services.SpaceProvider(d.provider, state.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterId, err := uuid.Parse(state.ClusterId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("cluster_id"), "invalid cluster ID", err.Error())
+		return
+	}
+
+	credentials, err := core.ReadPostgresClusterCredentials(ctx, provider, clusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read postgres cluster credentials", err.Error())
+		return
+	}
+
+	state.Username = types.StringValue(credentials.Username)
+	state.Password = types.StringValue(credentials.Password)
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,109 @@
+package postgres_cluster_credentials
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/services"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &postgresClusterCredentialsEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &postgresClusterCredentialsEphemeralResource{}
+)
+
+func (r *postgresClusterCredentialsEphemeralResource) Configure(_ context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.provider = services.ConfigureProviderEphemeralResource(request, response)
+}
+
+func NewPostgresClusterCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &postgresClusterCredentialsEphemeralResource{}
+}
+
+// postgresClusterCredentialsEphemeralResource reads the credentials of a cluster without storing them in the plan or
+// the state
+type postgresClusterCredentialsEphemeralResource struct {
+	provider *client.NumSpotSDK
+}
+
+type PostgresClusterCredentialsModel struct {
+	ClusterId types.String `tfsdk:"cluster_id"`
+	Password  types.String `tfsdk:"password"`
+	SpaceId   types.String `tfsdk:"space_id"`
+	Username  types.String `tfsdk:"username"`
+}
+
+func (r *postgresClusterCredentialsEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgres_cluster_credentials"
+}
+
+func (r *postgresClusterCredentialsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "A cluster unique identifier.",
+				MarkdownDescription: "A cluster unique identifier.",
+			},
+			"password": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				Description:         "The password of the user on the cluster.",
+				MarkdownDescription: "The password of the user on the cluster.",
+			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
+			"username": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The name of the user on the cluster.",
+				MarkdownDescription: "The name of the user on the cluster.",
+			},
+		},
+	}
+}
+
+func (r *postgresClusterCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var result PostgresClusterCredentialsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &result)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	provider := services.SpaceProvider(r.provider, result.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterId, err := uuid.Parse(result.ClusterId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("cluster_id"), "invalid cluster ID", err.Error())
+		return
+	}
+
+	credentials, err := core.ReadPostgresClusterCredentials(ctx, provider, clusterId)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read postgres cluster credentials", err.Error())
+		return
+	}
+
+	result.Username = types.StringValue(credentials.Username)
+	result.Password = types.StringValue(credentials.Password)
+	result.SpaceId = types.StringValue(provider.SpaceID.String())
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &result)...)
+}
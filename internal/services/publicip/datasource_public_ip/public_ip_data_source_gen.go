@@ -114,6 +114,12 @@ func PublicIpDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The private IPs associated with the public IPs.",
 				MarkdownDescription: "The private IPs associated with the public IPs.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
 			"tag_keys": schema.ListAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
@@ -147,15 +153,16 @@ func PublicIpDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type PublicIpModel struct {
-	Ids             types.List `tfsdk:"ids"`
-	Items           types.List `tfsdk:"items"`
-	LinkPublicIpIds types.List `tfsdk:"link_public_ip_ids"`
-	NicIds          types.List `tfsdk:"nic_ids"`
-	PrivateIps      types.List `tfsdk:"private_ips"`
-	TagKeys         types.List `tfsdk:"tag_keys"`
-	TagValues       types.List `tfsdk:"tag_values"`
-	Tags            types.List `tfsdk:"tags"`
-	VmIds           types.List `tfsdk:"vm_ids"`
+	Ids             types.List   `tfsdk:"ids"`
+	Items           types.List   `tfsdk:"items"`
+	LinkPublicIpIds types.List   `tfsdk:"link_public_ip_ids"`
+	NicIds          types.List   `tfsdk:"nic_ids"`
+	PrivateIps      types.List   `tfsdk:"private_ips"`
+	SpaceId         types.String `tfsdk:"space_id"`
+	TagKeys         types.List   `tfsdk:"tag_keys"`
+	TagValues       types.List   `tfsdk:"tag_values"`
+	Tags            types.List   `tfsdk:"tags"`
+	VmIds           types.List   `tfsdk:"vm_ids"`
 }
 
 var _ basetypes.ObjectTypable = ItemsType{}
@@ -48,12 +48,17 @@ func (d *publicIpsDataSource) Read(ctx context.Context, request datasource.ReadR
 		return
 	}
 
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	params := deserializePublicIpParams(ctx, plan, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	numSpotPublicIp, err := core.ReadPublicIpsWithParams(ctx, d.provider, params)
+	numSpotPublicIp, err := core.ReadPublicIpsWithParams(ctx, provider, params)
 	if err != nil {
 		response.Diagnostics.AddError("unable to read public ip", err.Error())
 		return
@@ -72,6 +77,7 @@ func (d *publicIpsDataSource) Read(ctx context.Context, request datasource.ReadR
 
 	state = plan
 	state.Items = listValueItems
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
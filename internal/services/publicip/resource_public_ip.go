@@ -40,6 +40,7 @@ func (r *publicIpResource) Configure(_ context.Context, request resource.Configu
 }
 
 func (r *publicIpResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
@@ -59,13 +60,26 @@ func (r *publicIpResource) Create(ctx context.Context, request resource.CreateRe
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	vmId := plan.VmId.ValueString()
 	nicId := plan.NicId.ValueString()
 	tagsValue := publicIpTags(ctx, plan.Tags)
 
-	publicIp, err := core.CreatePublicIp(ctx, r.provider, tagsValue, vmId, nicId)
+	publicIp, err := core.CreatePublicIp(ctx, provider, tagsValue, vmId, nicId)
 	if err != nil {
-		response.Diagnostics.AddError("unable to create public ip", err.Error())
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create public ip", err, request.Plan)...)
 		return
 	}
 
@@ -74,6 +88,8 @@ func (r *publicIpResource) Create(ctx context.Context, request resource.CreateRe
 		return
 	}
 
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
 
@@ -85,9 +101,26 @@ func (r *publicIpResource) Read(ctx context.Context, request resource.ReadReques
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	publicIpID := state.Id.ValueString()
 
-	numSpotPublicIp, err := core.ReadPublicIp(ctx, r.provider, publicIpID)
+	numSpotPublicIp, err := core.ReadPublicIp(ctx, provider, publicIpID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError("unable to read public ip", err.Error())
 		return
@@ -98,6 +131,8 @@ func (r *publicIpResource) Read(ctx context.Context, request resource.ReadReques
 		return
 	}
 
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, newState)...)
 }
 
@@ -115,14 +150,27 @@ func (r *publicIpResource) Update(ctx context.Context, request resource.UpdateRe
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	publicIpID := state.Id.ValueString()
 	planTags := publicIpTags(ctx, plan.Tags)
 	stateTags := publicIpTags(ctx, state.Tags)
 
 	if !state.Tags.Equal(plan.Tags) {
-		numSpotPublicIp, err = core.UpdatePublicIpTags(ctx, r.provider, stateTags, planTags, publicIpID)
+		numSpotPublicIp, err = core.UpdatePublicIpTags(ctx, provider, stateTags, planTags, publicIpID)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update public ip tags", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update public ip tags", err, request.Plan)...)
 			return
 		}
 	}
@@ -132,6 +180,8 @@ func (r *publicIpResource) Update(ctx context.Context, request resource.UpdateRe
 		return
 	}
 
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
 
@@ -143,9 +193,22 @@ func (r *publicIpResource) Delete(ctx context.Context, request resource.DeleteRe
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	publicIpID := state.Id.ValueString()
 	linkPublicIpId := state.LinkPublicIpId.ValueString()
-	err := core.DeletePublicIp(ctx, r.provider, publicIpID, linkPublicIpId)
+	err := core.DeletePublicIp(ctx, provider, publicIpID, linkPublicIpId)
 	if err != nil {
 		response.Diagnostics.AddError("unable to delete public ip", err.Error())
 		return
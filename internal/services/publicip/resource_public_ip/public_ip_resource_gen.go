@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -55,6 +56,16 @@ func PublicIpResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The public IP.",
 				MarkdownDescription: "The public IP.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"tags": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -92,17 +103,27 @@ func PublicIpResourceSchema(ctx context.Context) schema.Schema {
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type PublicIpModel struct {
-	Id             types.String `tfsdk:"id"`
-	LinkPublicIpId types.String `tfsdk:"link_public_ip_id"`
-	NicId          types.String `tfsdk:"nic_id"`
-	PrivateIp      types.String `tfsdk:"private_ip"`
-	PublicIp       types.String `tfsdk:"public_ip"`
-	Tags           types.Set    `tfsdk:"tags"`
-	VmId           types.String `tfsdk:"vm_id"`
+	Id             types.String   `tfsdk:"id"`
+	LinkPublicIpId types.String   `tfsdk:"link_public_ip_id"`
+	NicId          types.String   `tfsdk:"nic_id"`
+	PrivateIp      types.String   `tfsdk:"private_ip"`
+	PublicIp       types.String   `tfsdk:"public_ip"`
+	SpaceId        types.String   `tfsdk:"space_id"`
+	Tags           types.Set      `tfsdk:"tags"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+	VmId           types.String   `tfsdk:"vm_id"`
 }
 
 var _ basetypes.ObjectTypable = TagsType{}
@@ -273,6 +273,12 @@ func RouteTableDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The IDs of the Vpc peerings specified in routes in the tables.",
 				MarkdownDescription: "The IDs of the Vpc peerings specified in routes in the tables.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
 			"tag_keys": schema.ListAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
@@ -306,24 +312,25 @@ func RouteTableDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type RouteTableModel struct {
-	Ids                             types.List `tfsdk:"ids"`
-	Items                           types.List `tfsdk:"items"`
-	LinkRouteTableIds               types.List `tfsdk:"link_route_table_ids"`
-	LinkRouteTableLinkRouteTableIds types.List `tfsdk:"link_route_table_link_route_table_ids"`
-	LinkRouteTableMain              types.Bool `tfsdk:"link_route_table_main"`
-	LinkSubnetIds                   types.List `tfsdk:"link_subnet_ids"`
-	RouteCreationMethods            types.List `tfsdk:"route_creation_methods"`
-	RouteDestinationIpRanges        types.List `tfsdk:"route_destination_ip_ranges"`
-	RouteDestinationServiceIds      types.List `tfsdk:"route_destination_service_ids"`
-	RouteGatewayIds                 types.List `tfsdk:"route_gateway_ids"`
-	RouteNatGatewayIds              types.List `tfsdk:"route_nat_gateway_ids"`
-	RouteStates                     types.List `tfsdk:"route_states"`
-	RouteVmIds                      types.List `tfsdk:"route_vm_ids"`
-	RouteVpcPeeringIds              types.List `tfsdk:"route_vpc_peering_ids"`
-	TagKeys                         types.List `tfsdk:"tag_keys"`
-	TagValues                       types.List `tfsdk:"tag_values"`
-	Tags                            types.List `tfsdk:"tags"`
-	VpcIds                          types.List `tfsdk:"vpc_ids"`
+	Ids                             types.List   `tfsdk:"ids"`
+	Items                           types.List   `tfsdk:"items"`
+	LinkRouteTableIds               types.List   `tfsdk:"link_route_table_ids"`
+	LinkRouteTableLinkRouteTableIds types.List   `tfsdk:"link_route_table_link_route_table_ids"`
+	LinkRouteTableMain              types.Bool   `tfsdk:"link_route_table_main"`
+	LinkSubnetIds                   types.List   `tfsdk:"link_subnet_ids"`
+	RouteCreationMethods            types.List   `tfsdk:"route_creation_methods"`
+	RouteDestinationIpRanges        types.List   `tfsdk:"route_destination_ip_ranges"`
+	RouteDestinationServiceIds      types.List   `tfsdk:"route_destination_service_ids"`
+	RouteGatewayIds                 types.List   `tfsdk:"route_gateway_ids"`
+	RouteNatGatewayIds              types.List   `tfsdk:"route_nat_gateway_ids"`
+	RouteStates                     types.List   `tfsdk:"route_states"`
+	RouteVmIds                      types.List   `tfsdk:"route_vm_ids"`
+	RouteVpcPeeringIds              types.List   `tfsdk:"route_vpc_peering_ids"`
+	SpaceId                         types.String `tfsdk:"space_id"`
+	TagKeys                         types.List   `tfsdk:"tag_keys"`
+	TagValues                       types.List   `tfsdk:"tag_values"`
+	Tags                            types.List   `tfsdk:"tags"`
+	VpcIds                          types.List   `tfsdk:"vpc_ids"`
 }
 
 var _ basetypes.ObjectTypable = ItemsType{}
@@ -48,12 +48,17 @@ func (d *routeTablesDataSource) Read(ctx context.Context, request datasource.Rea
 		return
 	}
 
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	params := deserializeRouteTableDatasourceParams(ctx, plan, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	routeTables, err := core.ReadRouteTables(ctx, d.provider, params)
+	routeTables, err := core.ReadRouteTables(ctx, provider, params)
 	if err != nil {
 		response.Diagnostics.AddError("failed to read route tables", err.Error())
 		return
@@ -71,6 +76,7 @@ func (d *routeTablesDataSource) Read(ctx context.Context, request datasource.Rea
 
 	state = plan
 	state.Items = listValueItems
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -41,6 +41,7 @@ func (r *routeTableResource) Configure(_ context.Context, request resource.Confi
 }
 
 func (r *routeTableResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
@@ -60,6 +61,19 @@ func (r *routeTableResource) Create(ctx context.Context, request resource.Create
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	if !plan.SubnetId.IsNull() && len(plan.SubnetIds.Elements()) > 0 {
 		response.Diagnostics.AddError(
 			"Invalid configuration",
@@ -84,9 +98,9 @@ func (r *routeTableResource) Create(ctx context.Context, request resource.Create
 	tagsList := routeTableTags(ctx, plan.Tags)
 	routes := deserializeRoutes(ctx, plan.Routes)
 
-	res, err := core.CreateRouteTable(ctx, r.provider, payload, tagsList, routes, subnetIds)
+	res, err := core.CreateRouteTable(ctx, provider, payload, tagsList, routes, subnetIds)
 	if err != nil {
-		response.Diagnostics.AddError("unable to create route table", err.Error())
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create route table", err, request.Plan)...)
 		return
 	}
 
@@ -95,6 +109,8 @@ func (r *routeTableResource) Create(ctx context.Context, request resource.Create
 		return
 	}
 
+	tf.SpaceId = types.StringValue(provider.SpaceID.String())
+	tf.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &tf)...)
 }
 
@@ -106,7 +122,24 @@ func (r *routeTableResource) Read(ctx context.Context, request resource.ReadRequ
 		return
 	}
 
-	res, err := core.ReadRouteTable(ctx, r.provider, state.Id.ValueString())
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := core.ReadRouteTable(ctx, provider, state.Id.ValueString())
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError("unable to read route table", err.Error())
 		return
@@ -122,6 +155,8 @@ func (r *routeTableResource) Read(ctx context.Context, request resource.ReadRequ
 		return
 	}
 
+	tf.SpaceId = types.StringValue(provider.SpaceID.String())
+	tf.Timeouts = state.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &tf)...)
 }
 
@@ -142,6 +177,19 @@ func (r *routeTableResource) Update(ctx context.Context, request resource.Update
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	if !plan.SubnetId.IsNull() && len(plan.SubnetIds.Elements()) > 0 {
 		response.Diagnostics.AddError(
 			"Invalid configuration",
@@ -153,9 +201,9 @@ func (r *routeTableResource) Update(ctx context.Context, request resource.Update
 	stateTags := routeTableTags(ctx, state.Tags)
 	planTags := routeTableTags(ctx, plan.Tags)
 	if !state.Tags.Equal(plan.Tags) {
-		routeTable, err = core.UpdateRouteTableTags(ctx, r.provider, state.Id.ValueString(), stateTags, planTags)
+		routeTable, err = core.UpdateRouteTableTags(ctx, provider, state.Id.ValueString(), stateTags, planTags)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update route table tags", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update route table tags", err, request.Plan)...)
 			return
 		}
 	}
@@ -163,9 +211,9 @@ func (r *routeTableResource) Update(ctx context.Context, request resource.Update
 	stRoutes := deserializeRoutes(ctx, state.Routes)
 	plRoutes := deserializeRoutes(ctx, plan.Routes)
 	if !state.Routes.Equal(plan.Routes) {
-		routeTable, err = core.UpdateRouteTableRoutes(ctx, r.provider, state.Id.ValueString(), stRoutes, plRoutes)
+		routeTable, err = core.UpdateRouteTableRoutes(ctx, provider, state.Id.ValueString(), stRoutes, plRoutes)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update route table routes", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update route table routes", err, request.Plan)...)
 			return
 		}
 	}
@@ -210,7 +258,7 @@ func (r *routeTableResource) Update(ctx context.Context, request resource.Update
 	}
 
 	if subnetChanged && routeTable == nil {
-		res, err := core.ReadRouteTable(ctx, r.provider, state.Id.ValueString())
+		res, err := core.ReadRouteTable(ctx, provider, state.Id.ValueString())
 		if err != nil {
 			response.Diagnostics.AddError("unable to read route table", err.Error())
 			return
@@ -219,15 +267,15 @@ func (r *routeTableResource) Update(ctx context.Context, request resource.Update
 	}
 
 	if subnetChanged {
-		routeTable, err = core.UpdateRouteTableSubnets(ctx, r.provider, state.Id.ValueString(), desiredSubnetIds, *routeTable.LinkRouteTables)
+		routeTable, err = core.UpdateRouteTableSubnets(ctx, provider, state.Id.ValueString(), desiredSubnetIds, *routeTable.LinkRouteTables)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update route table subnet associations", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update route table subnet associations", err, request.Plan)...)
 			return
 		}
 	}
 
 	if routeTable == nil {
-		routeTable, err = core.ReadRouteTable(ctx, r.provider, state.Id.ValueString())
+		routeTable, err = core.ReadRouteTable(ctx, provider, state.Id.ValueString())
 		if err != nil {
 			response.Diagnostics.AddError("unable to read route table", err.Error())
 			return
@@ -238,17 +286,35 @@ func (r *routeTableResource) Update(ctx context.Context, request resource.Update
 	if response.Diagnostics.HasError() {
 		return
 	}
+	tf.SpaceId = types.StringValue(provider.SpaceID.String())
+	tf.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &tf)...)
 }
 
 func (r *routeTableResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	var data resource_route_table.RouteTableModel
 	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, data.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
 	links := utils.TfListToGenericList(func(link resource_route_table.LinkRouteTablesValue) string {
 		return link.Id.ValueString()
 	}, ctx, data.LinkRouteTables, &response.Diagnostics)
-	if err := core.DeleteRouteTable(ctx, r.provider, data.Id.ValueString(), links); err != nil {
+	if err := core.DeleteRouteTable(ctx, provider, data.Id.ValueString(), links); err != nil {
 		response.Diagnostics.AddError("unable to delete route table", err.Error())
 	}
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -208,6 +209,16 @@ func RouteTableResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "One or more routes in the route table.",
 				MarkdownDescription: "One or more routes in the route table.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"subnet_id": schema.StringAttribute{
 				Optional:            true,
 				Description:         "The ID of the subnet to associate with the route table. Deprecated: use subnet_ids instead.",
@@ -255,6 +266,14 @@ func RouteTableResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "The ID of the Vpc for which you want to create a route table.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -264,9 +283,11 @@ type RouteTableModel struct {
 	LocalRoute                      LocalRouteValue `tfsdk:"local_route"`
 	RoutePropagatingVirtualGateways types.List      `tfsdk:"route_propagating_virtual_gateways"`
 	Routes                          types.Set       `tfsdk:"routes"`
+	SpaceId                         types.String    `tfsdk:"space_id"`
 	SubnetId                        types.String    `tfsdk:"subnet_id"`
 	SubnetIds                       types.List      `tfsdk:"subnet_ids"`
 	Tags                            types.Set       `tfsdk:"tags"`
+	Timeouts                        timeouts.Value  `tfsdk:"timeouts"`
 	VpcId                           types.String    `tfsdk:"vpc_id"`
 }
 
@@ -249,6 +249,12 @@ func SecurityGroupDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The names of the security groups.",
 				MarkdownDescription: "The names of the security groups.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
 			"tag_keys": schema.ListAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
@@ -282,24 +288,25 @@ func SecurityGroupDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type SecurityGroupModel struct {
-	Descriptions                 types.List `tfsdk:"descriptions"`
-	InboundRuleFromPortRanges    types.List `tfsdk:"inbound_rule_from_port_ranges"`
-	InboundRuleIpRanges          types.List `tfsdk:"inbound_rule_ip_ranges"`
-	InboundRuleProtocols         types.List `tfsdk:"inbound_rule_protocols"`
-	InboundRuleSecurityGroupIds  types.List `tfsdk:"inbound_rule_security_group_ids"`
-	InboundRuleToPortRanges      types.List `tfsdk:"inbound_rule_to_port_ranges"`
-	Items                        types.List `tfsdk:"items"`
-	OutboundRuleFromPortRanges   types.List `tfsdk:"outbound_rule_from_port_ranges"`
-	OutboundRuleIpRanges         types.List `tfsdk:"outbound_rule_ip_ranges"`
-	OutboundRuleProtocols        types.List `tfsdk:"outbound_rule_protocols"`
-	OutboundRuleSecurityGroupIds types.List `tfsdk:"outbound_rule_security_group_ids"`
-	OutboundRuleToPortRanges     types.List `tfsdk:"outbound_rule_to_port_ranges"`
-	SecurityGroupIds             types.List `tfsdk:"security_group_ids"`
-	SecurityGroupNames           types.List `tfsdk:"security_group_names"`
-	TagKeys                      types.List `tfsdk:"tag_keys"`
-	TagValues                    types.List `tfsdk:"tag_values"`
-	Tags                         types.List `tfsdk:"tags"`
-	VpcIds                       types.List `tfsdk:"vpc_ids"`
+	Descriptions                 types.List   `tfsdk:"descriptions"`
+	InboundRuleFromPortRanges    types.List   `tfsdk:"inbound_rule_from_port_ranges"`
+	InboundRuleIpRanges          types.List   `tfsdk:"inbound_rule_ip_ranges"`
+	InboundRuleProtocols         types.List   `tfsdk:"inbound_rule_protocols"`
+	InboundRuleSecurityGroupIds  types.List   `tfsdk:"inbound_rule_security_group_ids"`
+	InboundRuleToPortRanges      types.List   `tfsdk:"inbound_rule_to_port_ranges"`
+	Items                        types.List   `tfsdk:"items"`
+	OutboundRuleFromPortRanges   types.List   `tfsdk:"outbound_rule_from_port_ranges"`
+	OutboundRuleIpRanges         types.List   `tfsdk:"outbound_rule_ip_ranges"`
+	OutboundRuleProtocols        types.List   `tfsdk:"outbound_rule_protocols"`
+	OutboundRuleSecurityGroupIds types.List   `tfsdk:"outbound_rule_security_group_ids"`
+	OutboundRuleToPortRanges     types.List   `tfsdk:"outbound_rule_to_port_ranges"`
+	SecurityGroupIds             types.List   `tfsdk:"security_group_ids"`
+	SecurityGroupNames           types.List   `tfsdk:"security_group_names"`
+	SpaceId                      types.String `tfsdk:"space_id"`
+	TagKeys                      types.List   `tfsdk:"tag_keys"`
+	TagValues                    types.List   `tfsdk:"tag_values"`
+	Tags                         types.List   `tfsdk:"tags"`
+	VpcIds                       types.List   `tfsdk:"vpc_ids"`
 }
 
 var _ basetypes.ObjectTypable = ItemsType{}
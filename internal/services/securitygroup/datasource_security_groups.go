@@ -48,12 +48,17 @@ func (d *securityGroupsDataSource) Read(ctx context.Context, request datasource.
 		return
 	}
 
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	params := deserializeSecurityGroupsDatasourceParams(ctx, plan, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	res, err := core.ReadSecurityGroups(ctx, d.provider, params)
+	res, err := core.ReadSecurityGroups(ctx, provider, params)
 	if err != nil {
 		response.Diagnostics.AddError("failed to read security groups", err.Error())
 		return
@@ -76,6 +81,7 @@ func (d *securityGroupsDataSource) Read(ctx context.Context, request datasource.
 
 	state = plan
 	state.Items = listValueItems
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -40,6 +40,7 @@ func (r *securityGroupResource) Configure(_ context.Context, request resource.Co
 }
 
 func (r *securityGroupResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
@@ -59,13 +60,26 @@ func (r *securityGroupResource) Create(ctx context.Context, request resource.Cre
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	tagsList := securityGroupTags(ctx, plan.Tags)
 	inboundRules := deserializeCreateInboundRules(ctx, plan.InboundRules)
 	outboundRules := deserializeCreateOutboundRules(ctx, plan.OutboundRules)
 
-	numSpotSecurityGroup, err := core.CreateSecurityGroup(ctx, r.provider, deserializeCreateSecurityGroupRequest(plan), tagsList, inboundRules, outboundRules)
+	numSpotSecurityGroup, err := core.CreateSecurityGroup(ctx, provider, deserializeCreateSecurityGroupRequest(plan), tagsList, inboundRules, outboundRules)
 	if err != nil {
-		response.Diagnostics.AddError("unable to create security group", err.Error())
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create security group", err, request.Plan)...)
 		return
 	}
 
@@ -74,6 +88,8 @@ func (r *securityGroupResource) Create(ctx context.Context, request resource.Cre
 		return
 	}
 
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
@@ -85,9 +101,26 @@ func (r *securityGroupResource) Read(ctx context.Context, request resource.ReadR
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	securityGroupID := state.Id.ValueString()
 
-	numSpotSecurityGroup, err := core.ReadSecurityGroup(ctx, r.provider, securityGroupID)
+	numSpotSecurityGroup, err := core.ReadSecurityGroup(ctx, provider, securityGroupID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError("unable to read security group", err.Error())
 		return
@@ -97,6 +130,8 @@ func (r *securityGroupResource) Read(ctx context.Context, request resource.ReadR
 	if response.Diagnostics.HasError() {
 		return
 	}
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 }
 
@@ -117,13 +152,26 @@ func (r *securityGroupResource) Update(ctx context.Context, request resource.Upd
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	stateTags := securityGroupTags(ctx, state.Tags)
 	planTags := securityGroupTags(ctx, plan.Tags)
 
 	if !plan.Tags.Equal(state.Tags) {
-		numSpotSecurityGroup, err = core.UpdateSecurityGroupTags(ctx, r.provider, state.Id.ValueString(), stateTags, planTags)
+		numSpotSecurityGroup, err = core.UpdateSecurityGroupTags(ctx, provider, state.Id.ValueString(), stateTags, planTags)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update security group tags", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update security group tags", err, request.Plan)...)
 			return
 		}
 	}
@@ -135,9 +183,9 @@ func (r *securityGroupResource) Update(ctx context.Context, request resource.Upd
 	stateOutboundRules := deserializeDeleteOutboundRules(ctx, state.OutboundRules)
 
 	if !plan.InboundRules.Equal(state.InboundRules) || !plan.OutboundRules.Equal(state.OutboundRules) {
-		numSpotSecurityGroup, err = core.UpdateSecurityGroupRules(ctx, r.provider, securityGroupID, stateInboundRules, stateOutboundRules, planInboundRules, planOutboundRules)
+		numSpotSecurityGroup, err = core.UpdateSecurityGroupRules(ctx, provider, securityGroupID, stateInboundRules, stateOutboundRules, planInboundRules, planOutboundRules)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update security group rules", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update security group rules", err, request.Plan)...)
 		}
 	}
 
@@ -146,6 +194,8 @@ func (r *securityGroupResource) Update(ctx context.Context, request resource.Upd
 		return
 	}
 
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 }
 
@@ -156,9 +206,22 @@ func (r *securityGroupResource) Delete(ctx context.Context, request resource.Del
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	securityGroupID := state.Id.ValueString()
 
-	if err := core.DeleteSecurityGroup(ctx, r.provider, securityGroupID); err != nil {
+	if err := core.DeleteSecurityGroup(ctx, provider, securityGroupID); err != nil {
 		response.Diagnostics.AddError("unable to delete security group", err.Error())
 		return
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -132,6 +133,16 @@ func SecurityGroupResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The outbound rules associated with the security group.",
 				MarkdownDescription: "The outbound rules associated with the security group.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"tags": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -166,17 +177,27 @@ func SecurityGroupResourceSchema(ctx context.Context) schema.Schema {
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type SecurityGroupModel struct {
-	Description   types.String `tfsdk:"description"`
-	Id            types.String `tfsdk:"id"`
-	InboundRules  types.Set    `tfsdk:"inbound_rules"`
-	Name          types.String `tfsdk:"name"`
-	OutboundRules types.Set    `tfsdk:"outbound_rules"`
-	Tags          types.Set    `tfsdk:"tags"`
-	VpcId         types.String `tfsdk:"vpc_id"`
+	Description   types.String   `tfsdk:"description"`
+	Id            types.String   `tfsdk:"id"`
+	InboundRules  types.Set      `tfsdk:"inbound_rules"`
+	Name          types.String   `tfsdk:"name"`
+	OutboundRules types.Set      `tfsdk:"outbound_rules"`
+	SpaceId       types.String   `tfsdk:"space_id"`
+	Tags          types.Set      `tfsdk:"tags"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+	VpcId         types.String   `tfsdk:"vpc_id"`
 }
 
 var _ basetypes.ObjectTypable = InboundRulesType{}
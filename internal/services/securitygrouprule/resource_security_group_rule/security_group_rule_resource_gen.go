@@ -4,11 +4,15 @@ package resource_security_group_rule
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -40,12 +44,15 @@ func SecurityGroupRuleResourceSchema(ctx context.Context) schema.Schema {
 				},
 			},
 			"ip_range": schema.StringAttribute{
-				Required:            true,
-				Description:         "The IP range for the security group rule, in CIDR notation (for example, 10.0.0.0/16). If you specify this parameter, you cannot specify the `Rules` parameter and its subparameters.",
-				MarkdownDescription: "The IP range for the security group rule, in CIDR notation (for example, 10.0.0.0/16). If you specify this parameter, you cannot specify the `Rules` parameter and its subparameters.",
+				Optional:            true,
+				Description:         "The IP range for the security group rule, in CIDR notation (for example, 10.0.0.0/16). Exactly one of `ip_range` or `source_security_group_id` must be specified.",
+				MarkdownDescription: "The IP range for the security group rule, in CIDR notation (for example, 10.0.0.0/16). Exactly one of `ip_range` or `source_security_group_id` must be specified.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("source_security_group_id")),
+				},
 			},
 			"security_group_id": schema.StringAttribute{
 				Required:            true,
@@ -55,6 +62,24 @@ func SecurityGroupRuleResourceSchema(ctx context.Context) schema.Schema {
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
+			"source_security_group_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The ID of the source security group the rule allows the traffic from (for `Inbound` rules) or to (for `Outbound` rules). Exactly one of `ip_range` or `source_security_group_id` must be specified.",
+				MarkdownDescription: "The ID of the source security group the rule allows the traffic from (for `Inbound` rules) or to (for `Outbound` rules). Exactly one of `ip_range` or `source_security_group_id` must be specified.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"to_port_range": schema.Int64Attribute{
 				Required:            true,
 				Description:         "The end of the port range for the TCP and UDP protocols, or an ICMP code number. If you specify this parameter, you cannot specify the `Rules` parameter and its subparameters.",
@@ -64,14 +89,25 @@ func SecurityGroupRuleResourceSchema(ctx context.Context) schema.Schema {
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type SecurityGroupRuleModel struct {
-	Flow            types.String `tfsdk:"flow"`
-	FromPortRange   types.Int64  `tfsdk:"from_port_range"`
-	IpProtocol      types.String `tfsdk:"ip_protocol"`
-	IpRange         types.String `tfsdk:"ip_range"`
-	SecurityGroupId types.String `tfsdk:"security_group_id"`
-	ToPortRange     types.Int64  `tfsdk:"to_port_range"`
+	Flow                  types.String   `tfsdk:"flow"`
+	FromPortRange         types.Int64    `tfsdk:"from_port_range"`
+	IpProtocol            types.String   `tfsdk:"ip_protocol"`
+	IpRange               types.String   `tfsdk:"ip_range"`
+	SecurityGroupId       types.String   `tfsdk:"security_group_id"`
+	SourceSecurityGroupId types.String   `tfsdk:"source_security_group_id"`
+	SpaceId               types.String   `tfsdk:"space_id"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+	ToPortRange           types.Int64    `tfsdk:"to_port_range"`
 }
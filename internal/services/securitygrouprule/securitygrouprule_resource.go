@@ -3,7 +3,12 @@ package securitygrouprule
 import (
 	"context"
 	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-numspot/internal/client"
@@ -14,7 +19,14 @@ import (
 	"terraform-provider-numspot/internal/utils"
 )
 
-var _ resource.Resource = &securityGroupRuleResource{}
+var (
+	_ resource.Resource                = &securityGroupRuleResource{}
+	_ resource.ResourceWithImportState = &securityGroupRuleResource{}
+)
+
+// importIDFormat is the format of the identifier used to import a rule, the last part is either an IP range or the ID
+// of the source security group
+const importIDFormat = "security_group_id_flow_ip_protocol_from_port_range_to_port_range_(ip_range|source_security_group_id)"
 
 func NewSecurityGroupRuleResource() resource.Resource {
 	return &securityGroupRuleResource{}
@@ -32,6 +44,43 @@ func (r *securityGroupRuleResource) Configure(_ context.Context, request resourc
 	r.provider = services.ConfigureProviderResource(request, response)
 }
 
+func (r *securityGroupRuleResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
+	parts := strings.SplitN(request.ID, "_", 6)
+	if len(parts) != 6 || slices.Contains(parts, "") {
+		response.Diagnostics.AddError("unexpected import identifier", fmt.Sprintf("Expected import identifier with format: %s. Got: %q", importIDFormat, request.ID))
+		return
+	}
+
+	securityGroupId, flow, ipProtocol, target := parts[0], parts[1], parts[2], parts[5]
+	if flow != "Inbound" && flow != "Outbound" {
+		response.Diagnostics.AddError("unexpected import identifier", fmt.Sprintf("Expected flow Inbound or Outbound in import identifier. Got: %q", flow))
+		return
+	}
+
+	fromPortRange, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		response.Diagnostics.AddError("unexpected import identifier", fmt.Sprintf("Expected from_port_range to be a number in import identifier. Got: %q", parts[3]))
+		return
+	}
+	toPortRange, err := strconv.ParseInt(parts[4], 10, 64)
+	if err != nil {
+		response.Diagnostics.AddError("unexpected import identifier", fmt.Sprintf("Expected to_port_range to be a number in import identifier. Got: %q", parts[4]))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("security_group_id"), securityGroupId)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("flow"), flow)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("ip_protocol"), ipProtocol)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("from_port_range"), fromPortRange)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("to_port_range"), toPortRange)...)
+	if _, _, err = net.ParseCIDR(target); err == nil {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("ip_range"), target)...)
+	} else {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("source_security_group_id"), target)...)
+	}
+}
+
 func (r *securityGroupRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_security_group_rule"
 }
@@ -48,12 +97,25 @@ func (r *securityGroupRuleResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sgId := plan.SecurityGroupId.ValueString()
 	body := deserializeCreateSecurityGroupRule(plan)
 
-	res, err := core.CreateSecurityGroupRule(ctx, r.provider, sgId, body)
+	res, err := core.CreateSecurityGroupRule(ctx, provider, sgId, body)
 	if err != nil {
-		resp.Diagnostics.AddError("unable to create security group rule", err.Error())
+		resp.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create security group rule", err, req.Plan)...)
 		return
 	}
 
@@ -78,21 +140,38 @@ func (r *securityGroupRuleResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	plan.FromPortRange = utils.FromIntPtrToTfInt64(createdRule.FromPortRange)
-	plan.ToPortRange = utils.FromIntPtrToTfInt64(createdRule.ToPortRange)
-	plan.IpProtocol = types.StringPointerValue(createdRule.IpProtocol)
+	state := serializeSecurityGroupRule(createdRule, plan)
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
 
-	if createdRule.IpRanges != nil && len(*createdRule.IpRanges) > 0 {
-		plan.IpRange = types.StringValue((*createdRule.IpRanges)[0])
-	} else {
-		plan.IpRange = types.StringNull()
+func deserializeCreateSecurityGroupRule(tf resource_security_group_rule.SecurityGroupRuleModel) api.CreateSecurityGroupRuleJSONRequestBody {
+	// Rules from a source security group can only be expressed through the Rules parameter
+	if !utils.IsTfValueNull(tf.SourceSecurityGroupId) {
+		return api.CreateSecurityGroupRuleJSONRequestBody{
+			Flow:  tf.Flow.ValueString(),
+			Rules: &[]api.SecurityGroupRule{deserializeSourceSecurityGroupRule(tf)},
+		}
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	return api.CreateSecurityGroupRuleJSONRequestBody{
+		Flow:          tf.Flow.ValueString(),
+		FromPortRange: utils.FromTfInt64ToIntPtr(tf.FromPortRange),
+		ToPortRange:   utils.FromTfInt64ToIntPtr(tf.ToPortRange),
+		IpProtocol:    tf.IpProtocol.ValueStringPointer(),
+		IpRange:       tf.IpRange.ValueStringPointer(),
+	}
 }
 
-func deserializeCreateSecurityGroupRule(tf resource_security_group_rule.SecurityGroupRuleModel) api.CreateSecurityGroupRuleJSONRequestBody {
-	return api.CreateSecurityGroupRuleJSONRequestBody{
+func deserializeDeleteSecurityGroupRule(tf resource_security_group_rule.SecurityGroupRuleModel) api.DeleteSecurityGroupRuleJSONRequestBody {
+	if !utils.IsTfValueNull(tf.SourceSecurityGroupId) {
+		return api.DeleteSecurityGroupRuleJSONRequestBody{
+			Flow:  tf.Flow.ValueString(),
+			Rules: &[]api.SecurityGroupRule{deserializeSourceSecurityGroupRule(tf)},
+		}
+	}
+
+	return api.DeleteSecurityGroupRuleJSONRequestBody{
 		Flow:          tf.Flow.ValueString(),
 		FromPortRange: utils.FromTfInt64ToIntPtr(tf.FromPortRange),
 		ToPortRange:   utils.FromTfInt64ToIntPtr(tf.ToPortRange),
@@ -101,6 +180,27 @@ func deserializeCreateSecurityGroupRule(tf resource_security_group_rule.Security
 	}
 }
 
+func deserializeSourceSecurityGroupRule(tf resource_security_group_rule.SecurityGroupRuleModel) api.SecurityGroupRule {
+	return api.SecurityGroupRule{
+		FromPortRange: utils.FromTfInt64ToIntPtr(tf.FromPortRange),
+		ToPortRange:   utils.FromTfInt64ToIntPtr(tf.ToPortRange),
+		IpProtocol:    tf.IpProtocol.ValueStringPointer(),
+		SecurityGroupsMembers: &[]api.SecurityGroupsMember{
+			{SecurityGroupId: tf.SourceSecurityGroupId.ValueStringPointer()},
+		},
+	}
+}
+
+// serializeSecurityGroupRule returns the state of a rule matched by findMatchingRule, the IP range or source security
+// group of the model is kept since the rule may group several of them
+func serializeSecurityGroupRule(rule *api.SecurityGroupRule, tf resource_security_group_rule.SecurityGroupRuleModel) resource_security_group_rule.SecurityGroupRuleModel {
+	tf.FromPortRange = utils.FromIntPtrToTfInt64(rule.FromPortRange)
+	tf.ToPortRange = utils.FromIntPtrToTfInt64(rule.ToPortRange)
+	tf.IpProtocol = types.StringPointerValue(rule.IpProtocol)
+
+	return tf
+}
+
 func findMatchingRule(rules *[]api.SecurityGroupRule, plan resource_security_group_rule.SecurityGroupRuleModel) *api.SecurityGroupRule {
 	if rules == nil {
 		return nil
@@ -110,6 +210,7 @@ func findMatchingRule(rules *[]api.SecurityGroupRule, plan resource_security_gro
 	planFrom := utils.FromTfInt64ToInt(plan.FromPortRange)
 	planTo := utils.FromTfInt64ToInt(plan.ToPortRange)
 	planRange := plan.IpRange.ValueString()
+	planSourceSecurityGroupId := plan.SourceSecurityGroupId.ValueString()
 
 	for _, rule := range *rules {
 		if rule.IpProtocol == nil || *rule.IpProtocol != planProto {
@@ -123,6 +224,19 @@ func findMatchingRule(rules *[]api.SecurityGroupRule, plan resource_security_gro
 			continue
 		}
 
+		if !utils.IsTfValueNull(plan.SourceSecurityGroupId) {
+			if rule.SecurityGroupsMembers == nil {
+				continue
+			}
+
+			for _, member := range *rule.SecurityGroupsMembers {
+				if member.SecurityGroupId != nil && *member.SecurityGroupId == planSourceSecurityGroupId {
+					return &rule
+				}
+			}
+			continue
+		}
+
 		if rule.IpRanges == nil || len(*rule.IpRanges) == 0 {
 			continue
 		}
@@ -145,9 +259,26 @@ func (r *securityGroupRuleResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	readTimeout, diags := plan.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sgId := plan.SecurityGroupId.ValueString()
 
-	res, err := core.ReadSecurityGroup(ctx, r.provider, sgId)
+	res, err := core.ReadSecurityGroup(ctx, provider, sgId)
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("unable to read security group", err.Error())
 		return
@@ -170,20 +301,22 @@ func (r *securityGroupRuleResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	plan.FromPortRange = utils.FromIntPtrToTfInt64(matchedRule.FromPortRange)
-	plan.ToPortRange = utils.FromIntPtrToTfInt64(matchedRule.ToPortRange)
-	plan.IpProtocol = types.StringPointerValue(matchedRule.IpProtocol)
+	state := serializeSecurityGroupRule(matchedRule, plan)
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
 
-	if matchedRule.IpRanges != nil && len(*matchedRule.IpRanges) > 0 {
-		plan.IpRange = types.StringValue((*matchedRule.IpRanges)[0])
-	} else {
-		plan.IpRange = types.StringNull()
+func (r *securityGroupRuleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state, plan resource_security_group_rule.SecurityGroupRuleModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
-}
-
-func (r *securityGroupRuleResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	// No implementation at this time, only the timeouts are persisted
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
 func (r *securityGroupRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -193,17 +326,24 @@ func (r *securityGroupRuleResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	sgId := state.SecurityGroupId.ValueString()
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 
-	body := api.DeleteSecurityGroupRuleJSONRequestBody{
-		Flow:          state.Flow.ValueString(),
-		FromPortRange: utils.FromTfInt64ToIntPtr(state.FromPortRange),
-		ToPortRange:   utils.FromTfInt64ToIntPtr(state.ToPortRange),
-		IpProtocol:    state.IpProtocol.ValueStringPointer(),
-		IpRange:       state.IpRange.ValueStringPointer(),
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if err := core.DeleteSecurityGroupRule(ctx, r.provider, sgId, body); err != nil {
+	sgId := state.SecurityGroupId.ValueString()
+
+	body := deserializeDeleteSecurityGroupRule(state)
+
+	if err := core.DeleteSecurityGroupRule(ctx, provider, sgId, body); err != nil {
 		resp.Diagnostics.AddError("unable to delete security group rule", err.Error())
 		return
 	}
@@ -64,13 +64,20 @@ func ServerCertificateDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The paths to the server certificates.",
 				MarkdownDescription: "The paths to the server certificates.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
 		},
 	}
 }
 
 type ServerCertificateModel struct {
-	Items types.List `tfsdk:"items"`
-	Paths types.List `tfsdk:"paths"`
+	Items   types.List   `tfsdk:"items"`
+	Paths   types.List   `tfsdk:"paths"`
+	SpaceId types.String `tfsdk:"space_id"`
 }
 
 var _ basetypes.ObjectTypable = ItemsType{}
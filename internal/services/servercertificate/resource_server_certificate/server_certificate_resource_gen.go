@@ -5,10 +5,13 @@ package resource_server_certificate
 import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -55,22 +58,42 @@ func ServerCertificateResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The PEM-encoded private key matching the certificate.",
 				MarkdownDescription: "The PEM-encoded private key matching the certificate.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"upload_date": schema.StringAttribute{
 				Computed:            true,
 				Description:         "The date on which the server certificate has been uploaded.",
 				MarkdownDescription: "The date on which the server certificate has been uploaded.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type ServerCertificateModel struct {
-	Body           types.String `tfsdk:"body"`
-	Chain          types.String `tfsdk:"chain"`
-	ExpirationDate types.String `tfsdk:"expiration_date"`
-	Id             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	Path           types.String `tfsdk:"path"`
-	PrivateKey     types.String `tfsdk:"private_key"`
-	UploadDate     types.String `tfsdk:"upload_date"`
+	Body           types.String   `tfsdk:"body"`
+	Chain          types.String   `tfsdk:"chain"`
+	ExpirationDate types.String   `tfsdk:"expiration_date"`
+	Id             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	Path           types.String   `tfsdk:"path"`
+	PrivateKey     types.String   `tfsdk:"private_key"`
+	SpaceId        types.String   `tfsdk:"space_id"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+	UploadDate     types.String   `tfsdk:"upload_date"`
 }
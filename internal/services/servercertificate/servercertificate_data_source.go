@@ -48,12 +48,17 @@ func (d *servercertificateDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	serverCertificateParams := deserializeReadServerCertificate(ctx, plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	read, err := core.ReadServerCertificates(ctx, d.provider, &serverCertificateParams)
+	read, err := core.ReadServerCertificates(ctx, provider, &serverCertificateParams)
 	if err != nil {
 		resp.Diagnostics.AddError("unable to read server certificate", err.Error())
 		return
@@ -72,6 +77,7 @@ func (d *servercertificateDataSource) Read(ctx context.Context, req datasource.R
 
 	state = plan
 	state.Items = listValueItems
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
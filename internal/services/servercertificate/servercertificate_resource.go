@@ -11,6 +11,7 @@ import (
 	"terraform-provider-numspot/internal/sdk/api"
 	"terraform-provider-numspot/internal/services"
 	"terraform-provider-numspot/internal/services/servercertificate/resource_server_certificate"
+	"terraform-provider-numspot/internal/utils"
 )
 
 var _ resource.Resource = &serverCertificateResource{}
@@ -32,6 +33,7 @@ func (r *serverCertificateResource) Configure(_ context.Context, request resourc
 }
 
 func (r *serverCertificateResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
@@ -50,11 +52,24 @@ func (r *serverCertificateResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	body := deserializeServerCertificate(plan)
 
-	numSpot, err := core.CreateServerCertificate(ctx, r.provider, body)
+	numSpot, err := core.CreateServerCertificate(ctx, provider, body)
 	if err != nil {
-		resp.Diagnostics.AddError("unable to create server certificate", err.Error())
+		resp.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create server certificate", err, req.Plan)...)
 		return
 	}
 
@@ -63,6 +78,8 @@ func (r *serverCertificateResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	data.SpaceId = types.StringValue(provider.SpaceID.String())
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -74,18 +91,37 @@ func (r *serverCertificateResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	readTimeout, diags := plan.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	body := plan.Body.ValueString()
 	chain := plan.Chain.ValueStringPointer()
 	key := plan.PrivateKey.ValueString()
 	serverCertificateName := plan.Name.ValueString()
 
-	serverCertificate, err := core.ReadServerCertificate(ctx, r.provider, serverCertificateName)
+	serverCertificate, err := core.ReadServerCertificate(ctx, provider, serverCertificateName)
+	if utils.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("unable to read server certificate", err.Error())
 		return
 	}
 
 	newPlan := serializeServerCertificate(serverCertificate, body, key, chain)
+	newPlan.SpaceId = types.StringValue(provider.SpaceID.String())
+	newPlan.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newPlan)...)
 }
 
@@ -97,6 +133,20 @@ func (r *serverCertificateResource) Update(ctx context.Context, req resource.Upd
 	)
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -118,9 +168,9 @@ func (r *serverCertificateResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	if needUpdate {
-		serverCertificate, err = core.UpdateServerCertificate(ctx, r.provider, plan.Name.ValueString(), updateBody)
+		serverCertificate, err = core.UpdateServerCertificate(ctx, provider, state.Name.ValueString(), updateBody)
 		if err != nil {
-			resp.Diagnostics.AddError("unable to update server certificate", err.Error())
+			resp.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update server certificate", err, req.Plan)...)
 			return
 		}
 
@@ -129,8 +179,15 @@ func (r *serverCertificateResource) Update(ctx context.Context, req resource.Upd
 			return
 		}
 
+		newState.SpaceId = types.StringValue(provider.SpaceID.String())
+		newState.Timeouts = plan.Timeouts
 		resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+		return
 	}
+
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *serverCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -140,9 +197,22 @@ func (r *serverCertificateResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	serverCertificateName := plan.Name.ValueString()
 
-	if err := core.DeleteServerCertificate(ctx, r.provider, serverCertificateName); err != nil {
+	if err := core.DeleteServerCertificate(ctx, provider, serverCertificateName); err != nil {
 		resp.Diagnostics.AddError("unable to delete server certificate", err.Error())
 		return
 	}
@@ -0,0 +1,276 @@
+package serviceaccount
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/serviceaccount/resource_service_account"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &serviceAccountResource{}
+	_ resource.ResourceWithConfigure   = &serviceAccountResource{}
+	_ resource.ResourceWithImportState = &serviceAccountResource{}
+)
+
+type serviceAccountResource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewServiceAccountResource() resource.Resource {
+	return &serviceAccountResource{}
+}
+
+func (r *serviceAccountResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.provider = services.ConfigureProviderResource(request, response)
+}
+
+// ImportState accepts the ID of a space service account, or organisation_id/id for an organisation service account
+func (r *serviceAccountResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	organisationID, serviceAccountID, found := strings.Cut(request.ID, "/")
+	if !found {
+		request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+		return
+	}
+
+	if organisationID == "" || serviceAccountID == "" {
+		response.Diagnostics.AddError("unexpected import identifier", fmt.Sprintf("Expected import identifier with format: id or organisation_id/id. Got: %q", request.ID))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("organisation_id"), organisationID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), serviceAccountID)...)
+}
+
+func (r *serviceAccountResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_service_account"
+}
+
+func (r *serviceAccountResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resource_service_account.ServiceAccountResourceSchema(ctx)
+}
+
+func (r *serviceAccountResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan resource_service_account.ServiceAccountModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	organisationID, err := services.ParseOrganisationID(plan.OrganisationId)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse organisation id", err.Error())
+		return
+	}
+
+	provider := services.ScopeProvider(r.provider, organisationID, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	serviceAccount, err := core.CreateServiceAccount(ctx, provider, organisationID, deserializeServiceAccount(plan))
+	if err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create service account", err, request.Plan)...)
+		return
+	}
+
+	state := serializeServiceAccount(&api.ServiceAccountEdited{
+		ExpirationDate: serviceAccount.ExpirationDate,
+		Id:             serviceAccount.Id,
+		Name:           serviceAccount.Name,
+		TokenDuration:  serviceAccount.TokenDuration,
+	}, plan)
+	state.Secret = types.StringValue(serviceAccount.Secret)
+	state.SpaceId = services.ScopeSpaceID(provider, organisationID)
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *serviceAccountResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state resource_service_account.ServiceAccountModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	organisationID, serviceAccountID, err := parseServiceAccountIDs(state)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	provider := services.ScopeProvider(r.provider, organisationID, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	serviceAccount, err := core.ReadServiceAccount(ctx, provider, organisationID, serviceAccountID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError("unable to read service account", err.Error())
+		return
+	}
+
+	newState := serializeServiceAccount(serviceAccount, state)
+	// The secret is only returned on creation
+	newState.Secret = state.Secret
+	newState.SpaceId = services.ScopeSpaceID(provider, organisationID)
+	newState.Timeouts = state.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *serviceAccountResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state, plan resource_service_account.ServiceAccountModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	organisationID, serviceAccountID, err := parseServiceAccountIDs(state)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	provider := services.ScopeProvider(r.provider, organisationID, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	serviceAccount, err := core.UpdateServiceAccount(ctx, provider, organisationID, serviceAccountID, deserializeServiceAccount(plan))
+	if err != nil {
+		response.Diagnostics.AddError("unable to update service account", err.Error())
+		return
+	}
+
+	newState := serializeServiceAccount(serviceAccount, plan)
+	newState.Secret = state.Secret
+	newState.SpaceId = services.ScopeSpaceID(provider, organisationID)
+	newState.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *serviceAccountResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state resource_service_account.ServiceAccountModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	organisationID, serviceAccountID, err := parseServiceAccountIDs(state)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	provider := services.ScopeProvider(r.provider, organisationID, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := core.DeleteServiceAccount(ctx, provider, organisationID, serviceAccountID); err != nil {
+		response.Diagnostics.AddError("unable to delete service account", err.Error())
+		return
+	}
+}
+
+func parseServiceAccountIDs(tf resource_service_account.ServiceAccountModel) (*api.OrganisationId, api.ServiceAccountId, error) {
+	organisationID, err := services.ParseOrganisationID(tf.OrganisationId)
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+
+	serviceAccountID, err := uuid.Parse(tf.Id.ValueString())
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+
+	return organisationID, serviceAccountID, nil
+}
+
+func serializeServiceAccount(http *api.ServiceAccountEdited, tf resource_service_account.ServiceAccountModel) resource_service_account.ServiceAccountModel {
+	return resource_service_account.ServiceAccountModel{
+		ExpirationDate: serializeExpirationDate(http.ExpirationDate, tf.ExpirationDate),
+		Id:             types.StringValue(http.Id),
+		Name:           types.StringValue(http.Name),
+		OrganisationId: tf.OrganisationId,
+		TokenDuration:  types.StringPointerValue(http.TokenDuration),
+	}
+}
+
+// serializeExpirationDate keeps the configured date when the API returns the same instant in another format
+func serializeExpirationDate(http *string, tf types.String) types.String {
+	if http == nil {
+		return types.StringNull()
+	}
+
+	if !utils.IsTfValueNull(tf) {
+		configured, errConfigured := time.Parse(time.RFC3339, tf.ValueString())
+		returned, errReturned := time.Parse(time.RFC3339, *http)
+		if errConfigured == nil && errReturned == nil && configured.Equal(returned) {
+			return tf
+		}
+	}
+
+	return types.StringValue(*http)
+}
+
+func deserializeServiceAccount(tf resource_service_account.ServiceAccountModel) api.ServiceAccount {
+	return api.ServiceAccount{
+		ExpirationDate: utils.FromTfStringToStringPtr(tf.ExpirationDate),
+		Name:           tf.Name.ValueString(),
+		TokenDuration:  utils.FromTfStringToStringPtr(tf.TokenDuration),
+	}
+}
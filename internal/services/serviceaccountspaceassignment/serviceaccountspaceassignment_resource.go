@@ -0,0 +1,211 @@
+package serviceaccountspaceassignment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/serviceaccountspaceassignment/resource_service_account_space_assignment"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &serviceAccountSpaceAssignmentResource{}
+	_ resource.ResourceWithConfigure   = &serviceAccountSpaceAssignmentResource{}
+	_ resource.ResourceWithImportState = &serviceAccountSpaceAssignmentResource{}
+)
+
+type serviceAccountSpaceAssignmentResource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewServiceAccountSpaceAssignmentResource() resource.Resource {
+	return &serviceAccountSpaceAssignmentResource{}
+}
+
+func (r *serviceAccountSpaceAssignmentResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.provider = services.ConfigureProviderResource(request, response)
+}
+
+func (r *serviceAccountSpaceAssignmentResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	ids := strings.Split(request.ID, "/")
+	if len(ids) != 3 || ids[0] == "" || ids[1] == "" || ids[2] == "" {
+		response.Diagnostics.AddError("unexpected import identifier", fmt.Sprintf("Expected import identifier with format: organisation_id/space_id/service_account_id. Got: %q", request.ID))
+		return
+	}
+	organisationID, spaceID, serviceAccountID := ids[0], ids[1], ids[2]
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), spaceID+"/"+serviceAccountID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("organisation_id"), organisationID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("service_account_id"), serviceAccountID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("space_id"), spaceID)...)
+}
+
+func (r *serviceAccountSpaceAssignmentResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_service_account_space_assignment"
+}
+
+func (r *serviceAccountSpaceAssignmentResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resource_service_account_space_assignment.ServiceAccountSpaceAssignmentResourceSchema(ctx)
+}
+
+func (r *serviceAccountSpaceAssignmentResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan resource_service_account_space_assignment.ServiceAccountSpaceAssignmentModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	organisationID, err := uuid.Parse(plan.OrganisationId.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse organisation id", err.Error())
+		return
+	}
+
+	serviceAccountID, err := uuid.Parse(plan.ServiceAccountId.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse service account id", err.Error())
+		return
+	}
+
+	if err = core.AssignServiceAccountToSpace(ctx, provider, organisationID, serviceAccountID); err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to assign service account to space", err, request.Plan)...)
+		return
+	}
+
+	state := serializeServiceAccountSpaceAssignment(provider, serviceAccountID)
+	state.OrganisationId = plan.OrganisationId
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *serviceAccountSpaceAssignmentResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state resource_service_account_space_assignment.ServiceAccountSpaceAssignmentModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	serviceAccountID, err := uuid.Parse(state.ServiceAccountId.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	// The service account can only be read from the space while it is assigned to it
+	_, err = core.ReadServiceAccount(ctx, provider, nil, serviceAccountID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError("unable to read service account space assignment", err.Error())
+		return
+	}
+
+	newState := serializeServiceAccountSpaceAssignment(provider, serviceAccountID)
+	newState.OrganisationId = state.OrganisationId
+	newState.Timeouts = state.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *serviceAccountSpaceAssignmentResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state, plan resource_service_account_space_assignment.ServiceAccountSpaceAssignmentModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Every attribute requires a replacement, only the timeouts are persisted
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *serviceAccountSpaceAssignmentResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state resource_service_account_space_assignment.ServiceAccountSpaceAssignmentModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	organisationID, err := uuid.Parse(state.OrganisationId.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	serviceAccountID, err := uuid.Parse(state.ServiceAccountId.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	// A service account deleted from the organisation is not assigned to any space anymore
+	err = core.UnassignServiceAccountFromSpace(ctx, provider, organisationID, serviceAccountID)
+	if utils.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError("unable to unassign service account from space", err.Error())
+		return
+	}
+}
+
+func serializeServiceAccountSpaceAssignment(provider *client.NumSpotSDK, serviceAccountID uuid.UUID) resource_service_account_space_assignment.ServiceAccountSpaceAssignmentModel {
+	return resource_service_account_space_assignment.ServiceAccountSpaceAssignmentModel{
+		Id:               types.StringValue(provider.SpaceID.String() + "/" + serviceAccountID.String()),
+		ServiceAccountId: types.StringValue(serviceAccountID.String()),
+		SpaceId:          types.StringValue(provider.SpaceID.String()),
+	}
+}
@@ -141,6 +141,12 @@ func SnapshotDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The progresses of the snapshots, as a percentage.",
 				MarkdownDescription: "The progresses of the snapshots, as a percentage.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
 			"states": schema.ListAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
@@ -200,6 +206,7 @@ type SnapshotModel struct {
 	IsPublic         types.Bool   `tfsdk:"is_public"`
 	Items            types.List   `tfsdk:"items"`
 	Progresses       types.List   `tfsdk:"progresses"`
+	SpaceId          types.String `tfsdk:"space_id"`
 	States           types.List   `tfsdk:"states"`
 	TagKeys          types.List   `tfsdk:"tag_keys"`
 	TagValues        types.List   `tfsdk:"tag_values"`
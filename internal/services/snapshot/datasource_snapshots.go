@@ -50,12 +50,17 @@ func (d *snapshotsDataSource) Read(ctx context.Context, request datasource.ReadR
 		return
 	}
 
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	params := deserializeSnapshotsParams(ctx, plan, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	numSpotSnapshot, err := core.ReadSnapshotsWithParams(ctx, d.provider, params)
+	numSpotSnapshot, err := core.ReadSnapshotsWithParams(ctx, provider, params)
 	if err != nil {
 		response.Diagnostics.AddError("unable to read snapshot", err.Error())
 		return
@@ -73,6 +78,7 @@ func (d *snapshotsDataSource) Read(ctx context.Context, request datasource.ReadR
 
 	state = plan
 	state.Items = listValueItems
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -39,6 +39,7 @@ func (r *snapshotResource) Configure(_ context.Context, request resource.Configu
 }
 
 func (r *snapshotResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
@@ -57,15 +58,28 @@ func (r *snapshotResource) Create(ctx context.Context, request resource.CreateRe
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	tagsValue := snapshotTags(ctx, plan.Tags)
 	body := deserializeCreateSnapshot(plan)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	snapshot, err := core.CreateSnapshot(ctx, r.provider, tagsValue, body)
+	snapshot, err := core.CreateSnapshot(ctx, provider, tagsValue, body)
 	if err != nil {
-		response.Diagnostics.AddError("unable to create snapshot", err.Error())
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create snapshot", err, request.Plan)...)
 		return
 	}
 
@@ -74,6 +88,8 @@ func (r *snapshotResource) Create(ctx context.Context, request resource.CreateRe
 		return
 	}
 
+	tf.SpaceId = types.StringValue(provider.SpaceID.String())
+	tf.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &tf)...)
 }
 
@@ -85,9 +101,26 @@ func (r *snapshotResource) Read(ctx context.Context, request resource.ReadReques
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	snapshotID := state.Id.ValueString()
 
-	snapshot, err := core.ReadSnapshot(ctx, r.provider, snapshotID)
+	snapshot, err := core.ReadSnapshot(ctx, provider, snapshotID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError("unable to read snapshot", err.Error())
 		return
@@ -98,6 +131,8 @@ func (r *snapshotResource) Read(ctx context.Context, request resource.ReadReques
 		return
 	}
 
+	tf.SpaceId = types.StringValue(provider.SpaceID.String())
+	tf.Timeouts = state.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &tf)...)
 }
 
@@ -110,6 +145,19 @@ func (r *snapshotResource) Update(ctx context.Context, request resource.UpdateRe
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	snapshotID := state.Id.ValueString()
 	planTags := snapshotTags(ctx, plan.Tags)
 	stateTags := snapshotTags(ctx, state.Tags)
@@ -117,9 +165,9 @@ func (r *snapshotResource) Update(ctx context.Context, request resource.UpdateRe
 	var numspotSnapshot *api.Snapshot
 	var err error
 	if !state.Tags.Equal(plan.Tags) {
-		numspotSnapshot, err = core.UpdateSnapshotTags(ctx, r.provider, stateTags, planTags, snapshotID)
+		numspotSnapshot, err = core.UpdateSnapshotTags(ctx, provider, stateTags, planTags, snapshotID)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update snapshot tags", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update snapshot tags", err, request.Plan)...)
 			return
 		}
 	}
@@ -129,6 +177,8 @@ func (r *snapshotResource) Update(ctx context.Context, request resource.UpdateRe
 		return
 	}
 
+	tf.SpaceId = types.StringValue(provider.SpaceID.String())
+	tf.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &tf)...)
 }
 
@@ -139,8 +189,21 @@ func (r *snapshotResource) Delete(ctx context.Context, request resource.DeleteRe
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	snapshotID := state.Id.ValueString()
-	err := core.DeleteSnapshot(ctx, r.provider, snapshotID)
+	err := core.DeleteSnapshot(ctx, provider, snapshotID)
 	if err != nil {
 		response.Diagnostics.AddError("unable to delete snapshot", err.Error())
 		return
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -82,6 +83,16 @@ func SnapshotResourceSchema(ctx context.Context) schema.Schema {
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"state": schema.StringAttribute{
 				Computed:            true,
 				Description:         "The state of the snapshot (`in-queue` \\| `completed` \\| `error`).",
@@ -127,21 +138,31 @@ func SnapshotResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "The size of the volume used to create the snapshot, in gibibytes (GiB).",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type SnapshotModel struct {
-	Access           AccessValue  `tfsdk:"access"`
-	CreationDate     types.String `tfsdk:"creation_date"`
-	Description      types.String `tfsdk:"description"`
-	Id               types.String `tfsdk:"id"`
-	Progress         types.Int64  `tfsdk:"progress"`
-	SourceRegionName types.String `tfsdk:"source_region_name"`
-	SourceSnapshotId types.String `tfsdk:"source_snapshot_id"`
-	State            types.String `tfsdk:"state"`
-	Tags             types.Set    `tfsdk:"tags"`
-	VolumeId         types.String `tfsdk:"volume_id"`
-	VolumeSize       types.Int64  `tfsdk:"volume_size"`
+	Access           AccessValue    `tfsdk:"access"`
+	CreationDate     types.String   `tfsdk:"creation_date"`
+	Description      types.String   `tfsdk:"description"`
+	Id               types.String   `tfsdk:"id"`
+	Progress         types.Int64    `tfsdk:"progress"`
+	SourceRegionName types.String   `tfsdk:"source_region_name"`
+	SourceSnapshotId types.String   `tfsdk:"source_snapshot_id"`
+	SpaceId          types.String   `tfsdk:"space_id"`
+	State            types.String   `tfsdk:"state"`
+	Tags             types.Set      `tfsdk:"tags"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+	VolumeId         types.String   `tfsdk:"volume_id"`
+	VolumeSize       types.Int64    `tfsdk:"volume_size"`
 }
 
 var _ basetypes.ObjectTypable = AccessType{}
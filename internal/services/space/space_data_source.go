@@ -0,0 +1,114 @@
+package space
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/space/datasource_space"
+)
+
+var _ datasource.DataSource = &spacesDataSource{}
+
+type spacesDataSource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewSpacesDataSource() datasource.DataSource {
+	return &spacesDataSource{}
+}
+
+func (d *spacesDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	d.provider = services.ConfigureProviderDatasource(request, response)
+}
+
+func (d *spacesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_spaces"
+}
+
+func (d *spacesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_space.SpaceDataSourceSchema(ctx)
+}
+
+func (d *spacesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state, plan datasource_space.SpaceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	organisationID, err := uuid.Parse(plan.OrganisationId.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse organisation id", err.Error())
+		return
+	}
+
+	spaces, err := core.ReadSpaces(ctx, d.provider, organisationID)
+	if err != nil {
+		response.Diagnostics.AddError("unable to read spaces", err.Error())
+		return
+	}
+
+	// The API cannot filter spaces, they are looked up by name here
+	if !plan.Name.IsNull() {
+		spaces = filterSpacesByName(spaces, plan.Name.ValueString())
+	}
+
+	items := serializeSpacesDatasource(ctx, spaces, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state = plan
+	state.Items = items
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func filterSpacesByName(spaces []api.Space, name string) []api.Space {
+	filtered := make([]api.Space, 0, len(spaces))
+	for _, space := range spaces {
+		if space.Name == name {
+			filtered = append(filtered, space)
+		}
+	}
+	return filtered
+}
+
+func serializeSpacesDatasource(ctx context.Context, spaces []api.Space, diags *diag.Diagnostics) types.List {
+	itemsValue := make([]datasource_space.ItemsValue, 0, len(spaces))
+
+	for _, space := range spaces {
+		item, serializeDiags := datasource_space.NewItemsValue(datasource_space.ItemsValue{}.AttributeTypes(ctx), map[string]attr.Value{
+			"created_on":      types.StringValue(space.CreatedOn.Format(time.RFC3339)),
+			"description":     types.StringValue(space.Description),
+			"id":              types.StringValue(space.Id.String()),
+			"name":            types.StringValue(space.Name),
+			"organisation_id": types.StringValue(space.OrganisationId.String()),
+			"status":          types.StringValue(string(space.Status)),
+			"updated_on":      types.StringValue(space.UpdatedOn.Format(time.RFC3339)),
+		})
+		if serializeDiags.HasError() {
+			diags.Append(serializeDiags...)
+			continue
+		}
+		itemsValue = append(itemsValue, item)
+	}
+
+	list, serializeDiags := types.ListValueFrom(ctx, new(datasource_space.ItemsValue).Type(ctx), itemsValue)
+	diags.Append(serializeDiags...)
+
+	return list
+}
@@ -0,0 +1,198 @@
+package space
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/space/resource_space"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &spaceResource{}
+	_ resource.ResourceWithConfigure   = &spaceResource{}
+	_ resource.ResourceWithImportState = &spaceResource{}
+)
+
+type spaceResource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewSpaceResource() resource.Resource {
+	return &spaceResource{}
+}
+
+func (r *spaceResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.provider = services.ConfigureProviderResource(request, response)
+}
+
+func (r *spaceResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	organisationID, spaceID, found := strings.Cut(request.ID, "/")
+	if !found || organisationID == "" || spaceID == "" {
+		response.Diagnostics.AddError("unexpected import identifier", fmt.Sprintf("Expected import identifier with format: organisation_id/space_id. Got: %q", request.ID))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("organisation_id"), organisationID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), spaceID)...)
+}
+
+func (r *spaceResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_space"
+}
+
+func (r *spaceResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resource_space.SpaceResourceSchema(ctx)
+}
+
+func (r *spaceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan resource_space.SpaceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	organisationID, err := uuid.Parse(plan.OrganisationId.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse organisation id", err.Error())
+		return
+	}
+
+	space, err := core.CreateSpace(ctx, r.provider, organisationID, api.CreateSpaceJSONRequestBody{
+		Description: plan.Description.ValueString(),
+		Name:        plan.Name.ValueString(),
+	})
+	if err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create space", err, request.Plan)...)
+		return
+	}
+
+	state := serializeSpace(space)
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *spaceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state resource_space.SpaceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	organisationID, spaceID, err := parseSpaceIDs(state)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	space, err := core.ReadSpace(ctx, r.provider, organisationID, spaceID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError("unable to read space", err.Error())
+		return
+	}
+
+	newState := serializeSpace(space)
+	newState.Timeouts = state.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *spaceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state, plan resource_space.SpaceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	organisationID, spaceID, err := parseSpaceIDs(state)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	space, err := core.UpdateSpace(ctx, r.provider, organisationID, spaceID, api.UpdateSpaceJSONRequestBody{
+		Description: plan.Description.ValueStringPointer(),
+		Name:        plan.Name.ValueStringPointer(),
+	})
+	if err != nil {
+		response.Diagnostics.AddError("unable to update space", err.Error())
+		return
+	}
+
+	newState := serializeSpace(space)
+	newState.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *spaceResource) Delete(_ context.Context, _ resource.DeleteRequest, response *resource.DeleteResponse) {
+	// The API cannot delete spaces, the space is only removed from the state
+	response.Diagnostics.AddWarning("space not deleted", "The NumSpot API cannot delete spaces, the space has only been removed from the Terraform state. Delete it from the NumSpot console.")
+}
+
+func parseSpaceIDs(tf resource_space.SpaceModel) (api.OrganisationId, api.SpaceId, error) {
+	organisationID, err := uuid.Parse(tf.OrganisationId.ValueString())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	spaceID, err := uuid.Parse(tf.Id.ValueString())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	return organisationID, spaceID, nil
+}
+
+func serializeSpace(http *api.Space) resource_space.SpaceModel {
+	return resource_space.SpaceModel{
+		CreatedOn:      types.StringValue(http.CreatedOn.Format(time.RFC3339)),
+		Description:    types.StringValue(http.Description),
+		Id:             types.StringValue(http.Id.String()),
+		Name:           types.StringValue(http.Name),
+		OrganisationId: types.StringValue(http.OrganisationId.String()),
+		Status:         types.StringValue(string(http.Status)),
+		UpdatedOn:      types.StringValue(http.UpdatedOn.Format(time.RFC3339)),
+	}
+}
@@ -0,0 +1,190 @@
+package spaceuser
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/spaceuser/resource_space_user"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &spaceUserResource{}
+	_ resource.ResourceWithConfigure   = &spaceUserResource{}
+	_ resource.ResourceWithImportState = &spaceUserResource{}
+)
+
+type spaceUserResource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewSpaceUserResource() resource.Resource {
+	return &spaceUserResource{}
+}
+
+func (r *spaceUserResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.provider = services.ConfigureProviderResource(request, response)
+}
+
+func (r *spaceUserResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	spaceID, userID, found := strings.Cut(request.ID, "/")
+	if !found || spaceID == "" || userID == "" {
+		response.Diagnostics.AddError("unexpected import identifier", fmt.Sprintf("Expected import identifier with format: space_id/user_id. Got: %q", request.ID))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), request.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("space_id"), spaceID)...)
+}
+
+func (r *spaceUserResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_space_user"
+}
+
+func (r *spaceUserResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resource_space_user.SpaceUserResourceSchema(ctx)
+}
+
+func (r *spaceUserResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan resource_space_user.SpaceUserModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	userID, err := uuid.Parse(plan.UserId.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse user id", err.Error())
+		return
+	}
+
+	if err = core.AssignUserToSpace(ctx, provider, userID); err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to assign user to space", err, request.Plan)...)
+		return
+	}
+
+	state := serializeSpaceUser(provider, userID)
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *spaceUserResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state resource_space_user.SpaceUserModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	userID, err := uuid.Parse(state.UserId.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	// The user can only be read from the space while it is assigned to it
+	_, err = core.ReadUser(ctx, provider, nil, userID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError("unable to read space user", err.Error())
+		return
+	}
+
+	newState := serializeSpaceUser(provider, userID)
+	newState.Timeouts = state.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *spaceUserResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state, plan resource_space_user.SpaceUserModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Every attribute requires a replacement, only the timeouts are persisted
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *spaceUserResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state resource_space_user.SpaceUserModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	userID, err := uuid.Parse(state.UserId.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	if err = core.UnassignUserFromSpace(ctx, provider, userID); err != nil {
+		response.Diagnostics.AddError("unable to unassign user from space", err.Error())
+		return
+	}
+}
+
+func serializeSpaceUser(provider *client.NumSpotSDK, userID uuid.UUID) resource_space_user.SpaceUserModel {
+	return resource_space_user.SpaceUserModel{
+		Id:      types.StringValue(provider.SpaceID.String() + "/" + userID.String()),
+		UserId:  types.StringValue(userID.String()),
+		SpaceId: types.StringValue(provider.SpaceID.String()),
+	}
+}
@@ -119,6 +119,12 @@ func SubnetDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Information about one or more Subnets.",
 				MarkdownDescription: "Information about one or more Subnets.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
 			"states": schema.ListAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
@@ -159,16 +165,17 @@ func SubnetDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type SubnetModel struct {
-	AvailabilityZoneNames types.List `tfsdk:"availability_zone_names"`
-	AvailableIpsCounts    types.List `tfsdk:"available_ips_counts"`
-	Ids                   types.List `tfsdk:"ids"`
-	IpRanges              types.List `tfsdk:"ip_ranges"`
-	Items                 types.List `tfsdk:"items"`
-	States                types.List `tfsdk:"states"`
-	TagKeys               types.List `tfsdk:"tag_keys"`
-	TagValues             types.List `tfsdk:"tag_values"`
-	Tags                  types.List `tfsdk:"tags"`
-	VpcIds                types.List `tfsdk:"vpc_ids"`
+	AvailabilityZoneNames types.List   `tfsdk:"availability_zone_names"`
+	AvailableIpsCounts    types.List   `tfsdk:"available_ips_counts"`
+	Ids                   types.List   `tfsdk:"ids"`
+	IpRanges              types.List   `tfsdk:"ip_ranges"`
+	Items                 types.List   `tfsdk:"items"`
+	SpaceId               types.String `tfsdk:"space_id"`
+	States                types.List   `tfsdk:"states"`
+	TagKeys               types.List   `tfsdk:"tag_keys"`
+	TagValues             types.List   `tfsdk:"tag_values"`
+	Tags                  types.List   `tfsdk:"tags"`
+	VpcIds                types.List   `tfsdk:"vpc_ids"`
 }
 
 var _ basetypes.ObjectTypable = ItemsType{}
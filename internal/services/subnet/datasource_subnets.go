@@ -48,12 +48,17 @@ func (d *subnetsDataSource) Read(ctx context.Context, request datasource.ReadReq
 		return
 	}
 
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	params := deserializeParams(ctx, plan, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	numspotSubnet, err := core.ReadSubnetsWithParams(ctx, d.provider, params)
+	numspotSubnet, err := core.ReadSubnetsWithParams(ctx, provider, params)
 	if err != nil {
 		response.Diagnostics.AddError("unable to read subnets", err.Error())
 		return
@@ -72,6 +77,7 @@ func (d *subnetsDataSource) Read(ctx context.Context, request datasource.ReadReq
 
 	state = plan
 	state.Items = listValueItems
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
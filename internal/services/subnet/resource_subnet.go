@@ -39,6 +39,7 @@ func (r *subnetResource) Configure(_ context.Context, request resource.Configure
 }
 
 func (r *subnetResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
@@ -57,12 +58,25 @@ func (r *subnetResource) Create(ctx context.Context, request resource.CreateRequ
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	tagsValue := subnetTags(ctx, plan.Tags)
 	mapPublicIP := plan.MapPublicIpOnLaunch.ValueBool()
 
-	numSpotSubnet, err := core.CreateSubnet(ctx, r.provider, deserializeCreateSubnet(plan), mapPublicIP, tagsValue)
+	numSpotSubnet, err := core.CreateSubnet(ctx, provider, deserializeCreateSubnet(plan), mapPublicIP, tagsValue)
 	if err != nil {
-		response.Diagnostics.AddError("unable to create subnet", err.Error())
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create subnet", err, request.Plan)...)
 		return
 	}
 
@@ -71,6 +85,8 @@ func (r *subnetResource) Create(ctx context.Context, request resource.CreateRequ
 		return
 	}
 
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
@@ -81,9 +97,26 @@ func (r *subnetResource) Read(ctx context.Context, request resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	subnetID := state.Id.ValueString()
 
-	numSpotSubnet, err := core.ReadSubnet(ctx, r.provider, subnetID)
+	numSpotSubnet, err := core.ReadSubnet(ctx, provider, subnetID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError("unable to read subnet", err.Error())
 		return
@@ -93,6 +126,8 @@ func (r *subnetResource) Read(ctx context.Context, request resource.ReadRequest,
 	if response.Diagnostics.HasError() {
 		return
 	}
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 }
 
@@ -112,21 +147,34 @@ func (r *subnetResource) Update(ctx context.Context, request resource.UpdateRequ
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	subnetID := state.Id.ValueString()
 	mapPublicIPOnLaunch := plan.MapPublicIpOnLaunch.ValueBool()
 	planTags := subnetTags(ctx, plan.Tags)
 	stateTags := subnetTags(ctx, state.Tags)
 
 	if !plan.MapPublicIpOnLaunch.Equal(state.MapPublicIpOnLaunch) {
-		if numSpotSubnet, err = core.UpdateSubnetAttributes(ctx, r.provider, subnetID, mapPublicIPOnLaunch); err != nil {
-			response.Diagnostics.AddError("unable to update subnet attributes", err.Error())
+		if numSpotSubnet, err = core.UpdateSubnetAttributes(ctx, provider, subnetID, mapPublicIPOnLaunch); err != nil {
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update subnet attributes", err, request.Plan)...)
 			return
 		}
 	}
 
 	if !plan.Tags.Equal(state.Tags) {
-		if numSpotSubnet, err = core.UpdateSubnetTags(ctx, r.provider, subnetID, stateTags, planTags); err != nil {
-			response.Diagnostics.AddError("unable to update subnet tags", err.Error())
+		if numSpotSubnet, err = core.UpdateSubnetTags(ctx, provider, subnetID, stateTags, planTags); err != nil {
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update subnet tags", err, request.Plan)...)
 		}
 	}
 
@@ -135,6 +183,8 @@ func (r *subnetResource) Update(ctx context.Context, request resource.UpdateRequ
 		return
 	}
 
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 }
 
@@ -145,9 +195,22 @@ func (r *subnetResource) Delete(ctx context.Context, request resource.DeleteRequ
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	subnetID := state.Id.ValueString()
 
-	if err := core.DeleteSubnet(ctx, r.provider, subnetID); err != nil {
+	if err := core.DeleteSubnet(ctx, provider, subnetID); err != nil {
 		response.Diagnostics.AddError("unable to delete subnet", err.Error())
 	}
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -53,6 +54,16 @@ func SubnetResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "If true, a public IP is assigned to the network interface cards (NICs) created in the specified Subnet.",
 				MarkdownDescription: "If true, a public IP is assigned to the network interface cards (NICs) created in the specified Subnet.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"state": schema.StringAttribute{
 				Computed:            true,
 				Description:         "The state of the Subnet (`pending` \\| `available` \\| `deleted`).",
@@ -92,18 +103,28 @@ func SubnetResourceSchema(ctx context.Context) schema.Schema {
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type SubnetModel struct {
-	AvailabilityZoneName types.String `tfsdk:"availability_zone_name"`
-	AvailableIpsCount    types.Int64  `tfsdk:"available_ips_count"`
-	Id                   types.String `tfsdk:"id"`
-	IpRange              types.String `tfsdk:"ip_range"`
-	MapPublicIpOnLaunch  types.Bool   `tfsdk:"map_public_ip_on_launch"`
-	State                types.String `tfsdk:"state"`
-	Tags                 types.Set    `tfsdk:"tags"`
-	VpcId                types.String `tfsdk:"vpc_id"`
+	AvailabilityZoneName types.String   `tfsdk:"availability_zone_name"`
+	AvailableIpsCount    types.Int64    `tfsdk:"available_ips_count"`
+	Id                   types.String   `tfsdk:"id"`
+	IpRange              types.String   `tfsdk:"ip_range"`
+	MapPublicIpOnLaunch  types.Bool     `tfsdk:"map_public_ip_on_launch"`
+	SpaceId              types.String   `tfsdk:"space_id"`
+	State                types.String   `tfsdk:"state"`
+	Tags                 types.Set      `tfsdk:"tags"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+	VpcId                types.String   `tfsdk:"vpc_id"`
 }
 
 var _ basetypes.ObjectTypable = TagsType{}
@@ -485,7 +485,7 @@ func CreateTags(
 	}
 
 	if res.StatusCode() != http.StatusNoContent {
-		apiError := utils.HandleError(res.Body)
+		apiError := utils.NewAPIError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
 		diagnostics.AddError("Failed to create Tags", apiError.Error())
 		return
 	}
@@ -558,7 +558,7 @@ func DeleteTags(
 	}
 
 	if res.StatusCode() != http.StatusNoContent {
-		apiError := utils.HandleError(res.Body)
+		apiError := utils.NewAPIError(res.Body, res.StatusCode(), res.HTTPResponse.Header)
 		diagnostics.AddError("Failed to delete Tags", apiError.Error())
 		return
 	}
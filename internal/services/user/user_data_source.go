@@ -0,0 +1,83 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/user/datasource_user"
+)
+
+var _ datasource.DataSource = &userDataSource{}
+
+type userDataSource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewUserDataSource() datasource.DataSource {
+	return &userDataSource{}
+}
+
+func (d *userDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	d.provider = services.ConfigureProviderDatasource(request, response)
+}
+
+func (d *userDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *userDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_user.UserDataSourceSchema(ctx)
+}
+
+func (d *userDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state, plan datasource_user.UserModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	organisationID, err := services.ParseOrganisationID(plan.OrganisationId)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse organisation id", err.Error())
+		return
+	}
+
+	provider := services.ScopeProvider(d.provider, organisationID, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := core.ReadUserByEmail(ctx, provider, organisationID, api.UserEmail(plan.Email.ValueString()))
+	if err != nil {
+		response.Diagnostics.AddError("unable to read user", err.Error())
+		return
+	}
+
+	state = serializeUserDatasource(user)
+	state.Email = plan.Email
+	state.OrganisationId = plan.OrganisationId
+	state.SpaceId = services.ScopeSpaceID(provider, organisationID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func serializeUserDatasource(http *api.UserModified) datasource_user.UserModel {
+	return datasource_user.UserModel{
+		Active:    types.BoolValue(http.Active),
+		CreatedOn: types.StringValue(http.CreatedOn.Format(time.RFC3339)),
+		Firstname: types.StringValue(http.Firstname),
+		Id:        types.StringValue(http.Id.String()),
+		Lastname:  types.StringValue(http.Lastname),
+		UpdatedOn: types.StringValue(http.UpdatedOn.Format(time.RFC3339)),
+	}
+}
@@ -0,0 +1,283 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/core"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services"
+	"terraform-provider-numspot/internal/services/user/resource_user"
+	"terraform-provider-numspot/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &userResource{}
+	_ resource.ResourceWithConfigure   = &userResource{}
+	_ resource.ResourceWithImportState = &userResource{}
+)
+
+type userResource struct {
+	provider *client.NumSpotSDK
+}
+
+func NewUserResource() resource.Resource {
+	return &userResource{}
+}
+
+func (r *userResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	r.provider = services.ConfigureProviderResource(request, response)
+}
+
+func (r *userResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	organisationID, userID, found := strings.Cut(request.ID, "/")
+	if !found || organisationID == "" || userID == "" {
+		response.Diagnostics.AddError("unexpected import identifier", fmt.Sprintf("Expected import identifier with format: organisation_id/id. Got: %q", request.ID))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("organisation_id"), organisationID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), userID)...)
+}
+
+func (r *userResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_user"
+}
+
+func (r *userResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resource_user.UserResourceSchema(ctx)
+}
+
+func (r *userResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan resource_user.UserModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	organisationID, err := uuid.Parse(plan.OrganisationId.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse organisation id", err.Error())
+		return
+	}
+
+	user, err := core.CreateUser(ctx, r.provider, organisationID, deserializeUser(plan))
+	if err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create user", err, request.Plan)...)
+		return
+	}
+
+	// Users are enabled on creation, the created user is saved before disabling it so that it stays tracked if
+	// disabling it fails
+	if !plan.Active.ValueBool() {
+		state := serializeCreatedUser(user, plan)
+		state.Timeouts = plan.Timeouts
+		response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if err = core.UpdateUserState(ctx, r.provider, organisationID, user.Id, false); err != nil {
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to disable user", err, request.Plan)...)
+			return
+		}
+	}
+
+	read, err := core.ReadUser(ctx, r.provider, &organisationID, user.Id)
+	if err != nil {
+		response.Diagnostics.AddError("unable to read user", err.Error())
+		return
+	}
+
+	state := serializeUser(read, plan)
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *userResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state resource_user.UserModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	organisationID, userID, err := parseUserIDs(state)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	user, err := core.ReadUser(ctx, r.provider, &organisationID, userID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError("unable to read user", err.Error())
+		return
+	}
+
+	newState := serializeUser(user, state)
+	newState.Timeouts = state.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *userResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state, plan resource_user.UserModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	organisationID, userID, err := parseUserIDs(state)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	// Enabling or disabling a user does not require its whole profile to be sent again
+	if plan.Email.Equal(state.Email) && plan.Firstname.Equal(state.Firstname) && plan.Lastname.Equal(state.Lastname) {
+		err = core.UpdateUserState(ctx, r.provider, organisationID, userID, plan.Active.ValueBool())
+	} else {
+		_, err = core.UpdateUser(ctx, r.provider, organisationID, userID, api.UserUpdate{
+			Active:    plan.Active.ValueBool(),
+			Email:     api.Email(plan.Email.ValueString()),
+			Firstname: plan.Firstname.ValueString(),
+			Lastname:  plan.Lastname.ValueString(),
+		})
+	}
+	if err != nil {
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update user", err, request.Plan)...)
+		return
+	}
+
+	user, err := core.ReadUser(ctx, r.provider, &organisationID, userID)
+	if err != nil {
+		response.Diagnostics.AddError("unable to read user", err.Error())
+		return
+	}
+
+	newState := serializeUser(user, plan)
+	newState.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
+}
+
+func (r *userResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state resource_user.UserModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	organisationID, userID, err := parseUserIDs(state)
+	if err != nil {
+		response.Diagnostics.AddError("unable to parse id from state", err.Error())
+		return
+	}
+
+	if err = core.DeleteUser(ctx, r.provider, organisationID, userID); err != nil {
+		response.Diagnostics.AddError("unable to delete user", err.Error())
+		return
+	}
+}
+
+func parseUserIDs(tf resource_user.UserModel) (api.OrganisationId, api.UserId, error) {
+	organisationID, err := uuid.Parse(tf.OrganisationId.ValueString())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	userID, err := uuid.Parse(tf.Id.ValueString())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	return organisationID, userID, nil
+}
+
+func serializeUser(http *api.UserModified, tf resource_user.UserModel) resource_user.UserModel {
+	return resource_user.UserModel{
+		Active:         types.BoolValue(http.Active),
+		CreatedOn:      types.StringValue(http.CreatedOn.Format(time.RFC3339)),
+		Email:          serializeEmail(string(http.Email), tf.Email),
+		Firstname:      types.StringValue(http.Firstname),
+		Id:             types.StringValue(http.Id.String()),
+		Lastname:       types.StringValue(http.Lastname),
+		OrganisationId: tf.OrganisationId,
+		UpdatedOn:      types.StringValue(http.UpdatedOn.Format(time.RFC3339)),
+	}
+}
+
+// serializeCreatedUser serializes a user that has not been updated since its creation
+func serializeCreatedUser(http *api.UserCreated, tf resource_user.UserModel) resource_user.UserModel {
+	return serializeUser(&api.UserModified{
+		Active:    http.Active,
+		CreatedOn: http.CreatedOn,
+		Email:     http.Email,
+		Firstname: http.Firstname,
+		Id:        http.Id,
+		Lastname:  http.Lastname,
+		UpdatedOn: http.CreatedOn,
+	}, tf)
+}
+
+// serializeEmail keeps the configured email when the API returns it with another case
+func serializeEmail(http string, tf types.String) types.String {
+	if strings.EqualFold(http, tf.ValueString()) {
+		return tf
+	}
+
+	return types.StringValue(http)
+}
+
+func deserializeUser(tf resource_user.UserModel) api.User {
+	return api.User{
+		Email:     api.Email(tf.Email.ValueString()),
+		Firstname: tf.Firstname.ValueString(),
+		Lastname:  tf.Lastname.ValueString(),
+	}
+}
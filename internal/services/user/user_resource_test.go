@@ -0,0 +1,51 @@
+package user
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-numspot/internal/client/clienttest"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/services/user/resource_user"
+)
+
+func TestUserCreateKeepsUserWhenDisablingFails(t *testing.T) {
+	ctx := context.Background()
+	organisationID := uuid.New()
+	userID := uuid.New()
+	r := &userResource{provider: clienttest.NewStubSDK(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			clienttest.WriteJSON(w, http.StatusCreated, api.UserCreated{Active: true, Email: "jane.doe@example.com", Id: userID})
+			return
+		}
+		clienttest.WriteJSON(w, http.StatusInternalServerError, api.Error{Title: "Internal Server Error"})
+	}))}
+	schema := resource_user.UserResourceSchema(ctx)
+
+	objectType := schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["active"] = tftypes.NewValue(tftypes.Bool, false)
+	values["email"] = tftypes.NewValue(tftypes.String, "jane.doe@example.com")
+	values["organisation_id"] = tftypes.NewValue(tftypes.String, organisationID.String())
+	plan := tftypes.NewValue(objectType, values)
+
+	response := &resource.CreateResponse{State: tfsdk.State{Schema: schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: schema, Raw: plan}}, response)
+	require.Equal(t, 1, response.Diagnostics.ErrorsCount())
+	assert.Equal(t, "unable to disable user", response.Diagnostics.Errors()[0].Summary())
+
+	var state resource_user.UserModel
+	require.False(t, response.State.Get(ctx, &state).HasError())
+	assert.Equal(t, userID.String(), state.Id.ValueString())
+	assert.Equal(t, organisationID.String(), state.OrganisationId.ValueString())
+}
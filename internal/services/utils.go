@@ -1,11 +1,20 @@
 package services
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-numspot/internal/client"
+	"terraform-provider-numspot/internal/sdk/api"
+	"terraform-provider-numspot/internal/utils"
 )
 
 func ConfigureProviderDatasource(request datasource.ConfigureRequest, response *datasource.ConfigureResponse) *client.NumSpotSDK {
@@ -35,3 +44,82 @@ func ConfigureProviderResource(request resource.ConfigureRequest, response *reso
 
 	return provider
 }
+
+func ConfigureProviderEphemeralResource(request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) *client.NumSpotSDK {
+	provider, ok := request.ProviderData.(*client.NumSpotSDK)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return nil
+	}
+
+	return provider
+}
+
+// SpaceProvider returns the provider operating in the space set by the space_id attribute, or in the space of the
+// provider configuration when it is not set.
+func SpaceProvider(provider *client.NumSpotSDK, spaceID types.String, diags *diag.Diagnostics) *client.NumSpotSDK {
+	if spaceID.IsNull() || spaceID.IsUnknown() {
+		return provider
+	}
+
+	numSpotSpaceID, err := uuid.Parse(spaceID.ValueString())
+	if err != nil {
+		diags.AddError("invalid space_id", fmt.Sprintf("Expected space_id to be a UUID. Got: %q", spaceID.ValueString()))
+		return nil
+	}
+
+	return provider.ForSpace(numSpotSpaceID)
+}
+
+// ImportStateSpaceID sets the space_id attribute of an import identifier prefixed with a space, as in space_id:id, and
+// returns the identifier of the resource in its space. Identifiers without a space prefix are imported in the space of
+// the provider configuration.
+func ImportStateSpaceID(ctx context.Context, id string, response *resource.ImportStateResponse) string {
+	spaceID, resourceID, found := strings.Cut(id, ":")
+	if !found {
+		return id
+	}
+
+	if _, err := uuid.Parse(spaceID); err != nil {
+		return id
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("space_id"), spaceID)...)
+	return resourceID
+}
+
+// ParseOrganisationID returns the organisation set by the organisation_id attribute of the resources that can belong
+// to an organisation or to a space, nil when they belong to a space.
+func ParseOrganisationID(organisationID types.String) (*api.OrganisationId, error) {
+	if utils.IsTfValueNull(organisationID) {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(organisationID.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// ScopeProvider returns the provider of a resource belonging to an organisation or to a space, the space set by the
+// space_id attribute is only used when the resource does not belong to an organisation.
+func ScopeProvider(provider *client.NumSpotSDK, organisationID *api.OrganisationId, spaceID types.String, diags *diag.Diagnostics) *client.NumSpotSDK {
+	if organisationID != nil {
+		return provider
+	}
+	return SpaceProvider(provider, spaceID, diags)
+}
+
+// ScopeSpaceID returns the space_id attribute of a resource belonging to an organisation or to a space, it is null
+// when the resource belongs to an organisation.
+func ScopeSpaceID(provider *client.NumSpotSDK, organisationID *api.OrganisationId) types.String {
+	if organisationID != nil {
+		return types.StringNull()
+	}
+	return types.StringValue(provider.SpaceID.String())
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -30,6 +31,16 @@ func VirtualGatewayResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The ID of the virtual gateway.",
 				MarkdownDescription: "The ID of the virtual gateway.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"state": schema.StringAttribute{
 				Computed:            true,
 				Description:         "The state of the virtual gateway (`pending` \\| `available` \\| `deleting` \\| `deleted`).",
@@ -69,15 +80,25 @@ func VirtualGatewayResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "the Vpc to which the virtual gateway is attached.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type VirtualGatewayModel struct {
-	ConnectionType           types.String `tfsdk:"connection_type"`
-	Id                       types.String `tfsdk:"id"`
-	State                    types.String `tfsdk:"state"`
-	VpcId                    types.String `tfsdk:"vpc_id"`
-	VpcToVirtualGatewayLinks types.List   `tfsdk:"vpc_to_virtual_gateway_links"`
+	ConnectionType           types.String   `tfsdk:"connection_type"`
+	Id                       types.String   `tfsdk:"id"`
+	SpaceId                  types.String   `tfsdk:"space_id"`
+	State                    types.String   `tfsdk:"state"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+	VpcId                    types.String   `tfsdk:"vpc_id"`
+	VpcToVirtualGatewayLinks types.List     `tfsdk:"vpc_to_virtual_gateway_links"`
 }
 
 var _ basetypes.ObjectTypable = VpcToVirtualGatewayLinksType{}
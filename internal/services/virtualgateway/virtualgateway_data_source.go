@@ -47,7 +47,12 @@ func (d *virtualGatewaysDataSource) Read(ctx context.Context, request datasource
 		return
 	}
 
-	numspotVirtualGateway, err := core.ReadVirtualGatewaysWithParams(ctx, d.provider)
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	numspotVirtualGateway, err := core.ReadVirtualGatewaysWithParams(ctx, provider)
 	if err != nil {
 		response.Diagnostics.AddError("unable to read virtual gateways", err.Error())
 		return
@@ -60,6 +65,7 @@ func (d *virtualGatewaysDataSource) Read(ctx context.Context, request datasource
 
 	state = plan
 	state.Items = objectItems.Items
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -40,6 +40,7 @@ func (r *virtualGatewayResource) Configure(_ context.Context, request resource.C
 }
 
 func (r *virtualGatewayResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
@@ -58,10 +59,23 @@ func (r *virtualGatewayResource) Create(ctx context.Context, request resource.Cr
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	vpcId := plan.VpcId.ValueString()
-	virtualGateway, err := core.CreateVirtualGateway(ctx, r.provider, deserializeCreateVirtualGateway(plan), vpcId)
+	virtualGateway, err := core.CreateVirtualGateway(ctx, provider, deserializeCreateVirtualGateway(plan), vpcId)
 	if err != nil {
-		response.Diagnostics.AddError("unable to create virtual gateway", err.Error())
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create virtual gateway", err, request.Plan)...)
 		return
 	}
 
@@ -70,6 +84,8 @@ func (r *virtualGatewayResource) Create(ctx context.Context, request resource.Cr
 		return
 	}
 
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
@@ -80,27 +96,56 @@ func (r *virtualGatewayResource) Read(ctx context.Context, request resource.Read
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	virtualGatewayID, err := uuid.Parse(state.Id.ValueString())
 	if err != nil {
 		response.Diagnostics.AddError("unable to parse id from state", err.Error())
 		return
 	}
 
-	numSpotVirtualGateway, err := core.ReadVirtualGateway(ctx, r.provider, virtualGatewayID)
+	numSpotVirtualGateway, err := core.ReadVirtualGateway(ctx, provider, virtualGatewayID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError("unable to read virtual gateway", err.Error())
 		return
 	}
 
-	state = serializeVirtualGateway(ctx, numSpotVirtualGateway, &response.Diagnostics)
+	newState := serializeVirtualGateway(ctx, numSpotVirtualGateway, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, newState)...)
 }
 
 func (r *virtualGatewayResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state, plan resource_virtual_gateway.VirtualGatewayModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// No implementation at this time, only the timeouts are persisted
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
 func (r *virtualGatewayResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
@@ -111,6 +156,19 @@ func (r *virtualGatewayResource) Delete(ctx context.Context, request resource.De
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	virtualGatewayID, err := uuid.Parse(state.Id.ValueString())
 	if err != nil {
 		response.Diagnostics.AddError("unable to parse id from state", err.Error())
@@ -118,7 +176,7 @@ func (r *virtualGatewayResource) Delete(ctx context.Context, request resource.De
 	}
 	vpcId := state.VpcId.ValueString()
 
-	err = core.DeleteVirtualGateway(ctx, r.provider, virtualGatewayID, vpcId)
+	err = core.DeleteVirtualGateway(ctx, provider, virtualGatewayID, vpcId)
 	if err != nil {
 		response.Diagnostics.AddError("unable to delete virtual gateway", err.Error())
 		return
@@ -773,6 +773,12 @@ func VmDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The names of the security groups for the VMs (only in the public Cloud).",
 				MarkdownDescription: "The names of the security groups for the VMs (only in the public Cloud).",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
 			"state_reason_codes": schema.ListAttribute{
 				ElementType:         types.Int64Type,
 				Optional:            true,
@@ -876,64 +882,65 @@ func VmDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type VmModel struct {
-	Architectures                        types.List `tfsdk:"architectures"`
-	AvailabilityZoneNames                types.List `tfsdk:"availability_zone_names"`
-	BlockDeviceMappingDeleteOnVmDeletion types.Bool `tfsdk:"block_device_mapping_delete_on_vm_deletion"`
-	BlockDeviceMappingDeviceNames        types.List `tfsdk:"block_device_mapping_device_names"`
-	BlockDeviceMappingStates             types.List `tfsdk:"block_device_mapping_states"`
-	BlockDeviceMappingVolumeIds          types.List `tfsdk:"block_device_mapping_volume_ids"`
-	ClientTokens                         types.List `tfsdk:"client_tokens"`
-	Ids                                  types.List `tfsdk:"ids"`
-	ImageIds                             types.List `tfsdk:"image_ids"`
-	IsSourceDestChecked                  types.Bool `tfsdk:"is_source_dest_checked"`
-	Items                                types.List `tfsdk:"items"`
-	KeypairNames                         types.List `tfsdk:"keypair_names"`
-	LaunchNumbers                        types.List `tfsdk:"launch_numbers"`
-	Lifecycles                           types.List `tfsdk:"lifecycles"`
-	NicAvailabilityZoneNames             types.List `tfsdk:"nic_availability_zone_names"`
-	NicDescriptions                      types.List `tfsdk:"nic_descriptions"`
-	NicIsSourceDestChecked               types.Bool `tfsdk:"nic_is_source_dest_checked"`
-	NicLinkNicDeleteOnVmDeletion         types.Bool `tfsdk:"nic_link_nic_delete_on_vm_deletion"`
-	NicLinkNicDeviceNumbers              types.List `tfsdk:"nic_link_nic_device_numbers"`
-	NicLinkNicLinkNicIds                 types.List `tfsdk:"nic_link_nic_link_nic_ids"`
-	NicLinkNicStates                     types.List `tfsdk:"nic_link_nic_states"`
-	NicLinkNicVmIds                      types.List `tfsdk:"nic_link_nic_vm_ids"`
-	NicLinkPublicIpLinkPublicIpIds       types.List `tfsdk:"nic_link_public_ip_link_public_ip_ids"`
-	NicLinkPublicIpPublicIpIds           types.List `tfsdk:"nic_link_public_ip_public_ip_ids"`
-	NicLinkPublicIpPublicIps             types.List `tfsdk:"nic_link_public_ip_public_ips"`
-	NicMacAddresses                      types.List `tfsdk:"nic_mac_addresses"`
-	NicNicIds                            types.List `tfsdk:"nic_nic_ids"`
-	NicPrivateIpsLinkPublicIpIds         types.List `tfsdk:"nic_private_ips_link_public_ip_ids"`
-	NicPrivateIpsPrimaryIp               types.Bool `tfsdk:"nic_private_ips_primary_ip"`
-	NicPrivateIpsPrivateIps              types.List `tfsdk:"nic_private_ips_private_ips"`
-	NicSecurityGroupIds                  types.List `tfsdk:"nic_security_group_ids"`
-	NicSecurityGroupNames                types.List `tfsdk:"nic_security_group_names"`
-	NicStates                            types.List `tfsdk:"nic_states"`
-	NicSubnetIds                         types.List `tfsdk:"nic_subnet_ids"`
-	NicVpcIds                            types.List `tfsdk:"nic_vpc_ids"`
-	Platforms                            types.List `tfsdk:"platforms"`
-	PrivateIps                           types.List `tfsdk:"private_ips"`
-	ProductCodes                         types.List `tfsdk:"product_codes"`
-	PublicIps                            types.List `tfsdk:"public_ips"`
-	ReservationIds                       types.List `tfsdk:"reservation_ids"`
-	RootDeviceNames                      types.List `tfsdk:"root_device_names"`
-	RootDeviceTypes                      types.List `tfsdk:"root_device_types"`
-	SecurityGroupIds                     types.Set  `tfsdk:"security_group_ids"`
-	SecurityGroupNames                   types.List `tfsdk:"security_group_names"`
-	StateReasonCodes                     types.List `tfsdk:"state_reason_codes"`
-	StateReasonMessages                  types.List `tfsdk:"state_reason_messages"`
-	StateReasons                         types.List `tfsdk:"state_reasons"`
-	SubnetIds                            types.List `tfsdk:"subnet_ids"`
-	TagKeys                              types.List `tfsdk:"tag_keys"`
-	TagValues                            types.List `tfsdk:"tag_values"`
-	Tags                                 types.List `tfsdk:"tags"`
-	Tenancies                            types.List `tfsdk:"tenancies"`
-	Types                                types.List `tfsdk:"types"`
-	VmSecurityGroupIds                   types.List `tfsdk:"vm_security_group_ids"`
-	VmSecurityGroupNames                 types.List `tfsdk:"vm_security_group_names"`
-	VmStateCodes                         types.List `tfsdk:"vm_state_codes"`
-	VmStateNames                         types.List `tfsdk:"vm_state_names"`
-	VpcIds                               types.List `tfsdk:"vpc_ids"`
+	Architectures                        types.List   `tfsdk:"architectures"`
+	AvailabilityZoneNames                types.List   `tfsdk:"availability_zone_names"`
+	BlockDeviceMappingDeleteOnVmDeletion types.Bool   `tfsdk:"block_device_mapping_delete_on_vm_deletion"`
+	BlockDeviceMappingDeviceNames        types.List   `tfsdk:"block_device_mapping_device_names"`
+	BlockDeviceMappingStates             types.List   `tfsdk:"block_device_mapping_states"`
+	BlockDeviceMappingVolumeIds          types.List   `tfsdk:"block_device_mapping_volume_ids"`
+	ClientTokens                         types.List   `tfsdk:"client_tokens"`
+	Ids                                  types.List   `tfsdk:"ids"`
+	ImageIds                             types.List   `tfsdk:"image_ids"`
+	IsSourceDestChecked                  types.Bool   `tfsdk:"is_source_dest_checked"`
+	Items                                types.List   `tfsdk:"items"`
+	KeypairNames                         types.List   `tfsdk:"keypair_names"`
+	LaunchNumbers                        types.List   `tfsdk:"launch_numbers"`
+	Lifecycles                           types.List   `tfsdk:"lifecycles"`
+	NicAvailabilityZoneNames             types.List   `tfsdk:"nic_availability_zone_names"`
+	NicDescriptions                      types.List   `tfsdk:"nic_descriptions"`
+	NicIsSourceDestChecked               types.Bool   `tfsdk:"nic_is_source_dest_checked"`
+	NicLinkNicDeleteOnVmDeletion         types.Bool   `tfsdk:"nic_link_nic_delete_on_vm_deletion"`
+	NicLinkNicDeviceNumbers              types.List   `tfsdk:"nic_link_nic_device_numbers"`
+	NicLinkNicLinkNicIds                 types.List   `tfsdk:"nic_link_nic_link_nic_ids"`
+	NicLinkNicStates                     types.List   `tfsdk:"nic_link_nic_states"`
+	NicLinkNicVmIds                      types.List   `tfsdk:"nic_link_nic_vm_ids"`
+	NicLinkPublicIpLinkPublicIpIds       types.List   `tfsdk:"nic_link_public_ip_link_public_ip_ids"`
+	NicLinkPublicIpPublicIpIds           types.List   `tfsdk:"nic_link_public_ip_public_ip_ids"`
+	NicLinkPublicIpPublicIps             types.List   `tfsdk:"nic_link_public_ip_public_ips"`
+	NicMacAddresses                      types.List   `tfsdk:"nic_mac_addresses"`
+	NicNicIds                            types.List   `tfsdk:"nic_nic_ids"`
+	NicPrivateIpsLinkPublicIpIds         types.List   `tfsdk:"nic_private_ips_link_public_ip_ids"`
+	NicPrivateIpsPrimaryIp               types.Bool   `tfsdk:"nic_private_ips_primary_ip"`
+	NicPrivateIpsPrivateIps              types.List   `tfsdk:"nic_private_ips_private_ips"`
+	NicSecurityGroupIds                  types.List   `tfsdk:"nic_security_group_ids"`
+	NicSecurityGroupNames                types.List   `tfsdk:"nic_security_group_names"`
+	NicStates                            types.List   `tfsdk:"nic_states"`
+	NicSubnetIds                         types.List   `tfsdk:"nic_subnet_ids"`
+	NicVpcIds                            types.List   `tfsdk:"nic_vpc_ids"`
+	Platforms                            types.List   `tfsdk:"platforms"`
+	PrivateIps                           types.List   `tfsdk:"private_ips"`
+	ProductCodes                         types.List   `tfsdk:"product_codes"`
+	PublicIps                            types.List   `tfsdk:"public_ips"`
+	ReservationIds                       types.List   `tfsdk:"reservation_ids"`
+	RootDeviceNames                      types.List   `tfsdk:"root_device_names"`
+	RootDeviceTypes                      types.List   `tfsdk:"root_device_types"`
+	SecurityGroupIds                     types.Set    `tfsdk:"security_group_ids"`
+	SecurityGroupNames                   types.List   `tfsdk:"security_group_names"`
+	SpaceId                              types.String `tfsdk:"space_id"`
+	StateReasonCodes                     types.List   `tfsdk:"state_reason_codes"`
+	StateReasonMessages                  types.List   `tfsdk:"state_reason_messages"`
+	StateReasons                         types.List   `tfsdk:"state_reasons"`
+	SubnetIds                            types.List   `tfsdk:"subnet_ids"`
+	TagKeys                              types.List   `tfsdk:"tag_keys"`
+	TagValues                            types.List   `tfsdk:"tag_values"`
+	Tags                                 types.List   `tfsdk:"tags"`
+	Tenancies                            types.List   `tfsdk:"tenancies"`
+	Types                                types.List   `tfsdk:"types"`
+	VmSecurityGroupIds                   types.List   `tfsdk:"vm_security_group_ids"`
+	VmSecurityGroupNames                 types.List   `tfsdk:"vm_security_group_names"`
+	VmStateCodes                         types.List   `tfsdk:"vm_state_codes"`
+	VmStateNames                         types.List   `tfsdk:"vm_state_names"`
+	VpcIds                               types.List   `tfsdk:"vpc_ids"`
 }
 
 var _ basetypes.ObjectTypable = ItemsType{}
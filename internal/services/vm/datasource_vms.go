@@ -49,12 +49,17 @@ func (d *vmsDataSource) Read(ctx context.Context, request datasource.ReadRequest
 		return
 	}
 
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	params := deserializeVmParams(ctx, plan, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	numspotVm, err := core.ReadVMsWithParams(ctx, d.provider, params)
+	numspotVm, err := core.ReadVMsWithParams(ctx, provider, params)
 	if err != nil {
 		response.Diagnostics.AddError("unable to read vms", err.Error())
 		return
@@ -72,6 +77,7 @@ func (d *vmsDataSource) Read(ctx context.Context, request datasource.ReadRequest
 
 	state = plan
 	state.Items = listValueItems
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
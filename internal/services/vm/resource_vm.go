@@ -41,6 +41,7 @@ func (r *vmResource) Configure(_ context.Context, request resource.ConfigureRequ
 }
 
 func (r *vmResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
@@ -59,18 +60,31 @@ func (r *vmResource) Create(ctx context.Context, request resource.CreateRequest,
 	if response.Diagnostics.HasError() {
 		return
 	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	tagsValue := vmTags(ctx, plan.Tags)
 
-	var diags diag.Diagnostics
 	numSpotCreateVM := deserializeCreateNumSpotVM(ctx, plan, &diags)
 	if diags.HasError() {
 		response.Diagnostics.Append(diags...)
 		return
 	}
 
-	numSpotVM, err := core.CreateVM(ctx, r.provider, numSpotCreateVM, tagsValue)
+	numSpotVM, err := core.CreateVM(ctx, provider, numSpotCreateVM, tagsValue)
 	if err != nil {
-		response.Diagnostics.AddError("unable to create vm", err.Error())
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create vm", err, request.Plan)...)
 		return
 	}
 
@@ -79,6 +93,8 @@ func (r *vmResource) Create(ctx context.Context, request resource.CreateRequest,
 		return
 	}
 
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
@@ -89,11 +105,29 @@ func (r *vmResource) Read(ctx context.Context, request resource.ReadRequest, res
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	vmID := state.Id.ValueString()
 
-	numSpotVM, err := core.ReadVM(ctx, r.provider, vmID)
+	numSpotVM, err := core.ReadVM(ctx, provider, vmID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError("unable to read vm", err.Error())
+		return
 	}
 
 	newState := serializeNumSpotVM(ctx, numSpotVM, &response.Diagnostics)
@@ -101,6 +135,8 @@ func (r *vmResource) Read(ctx context.Context, request resource.ReadRequest, res
 		return
 	}
 
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 }
 
@@ -120,6 +156,19 @@ func (r *vmResource) Update(ctx context.Context, request resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	planTags := vmTags(ctx, plan.Tags)
 	stateTags := vmTags(ctx, state.Tags)
 	vmID := state.Id.ValueString()
@@ -130,9 +179,9 @@ func (r *vmResource) Update(ctx context.Context, request resource.UpdateRequest,
 	}
 
 	if !plan.KeypairName.Equal(state.KeypairName) {
-		numSpotVM, err = core.UpdateVMKeypair(ctx, r.provider, numSpotUpdateVM, vmID)
+		numSpotVM, err = core.UpdateVMKeypair(ctx, provider, numSpotUpdateVM, vmID)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update vm keypair", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update vm keypair", err, request.Plan)...)
 			return
 		}
 	}
@@ -143,17 +192,17 @@ func (r *vmResource) Update(ctx context.Context, request resource.UpdateRequest,
 		!plan.Type.Equal(state.Type) ||
 		!plan.UserData.Equal(state.UserData) ||
 		!plan.VmInitiatedShutdownBehavior.Equal(state.VmInitiatedShutdownBehavior) {
-		numSpotVM, err = core.UpdateVMAttributes(ctx, r.provider, numSpotUpdateVM, vmID)
+		numSpotVM, err = core.UpdateVMAttributes(ctx, provider, numSpotUpdateVM, vmID)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update vm attributes", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update vm attributes", err, request.Plan)...)
 			return
 		}
 	}
 
 	if !plan.Tags.Equal(state.Tags) {
-		numSpotVM, err = core.UpdateVMTags(ctx, r.provider, stateTags, planTags, vmID)
+		numSpotVM, err = core.UpdateVMTags(ctx, provider, stateTags, planTags, vmID)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update vm tags", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update vm tags", err, request.Plan)...)
 			return
 		}
 	}
@@ -162,6 +211,8 @@ func (r *vmResource) Update(ctx context.Context, request resource.UpdateRequest,
 	if response.Diagnostics.HasError() {
 		return
 	}
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 }
 
@@ -172,7 +223,20 @@ func (r *vmResource) Delete(ctx context.Context, request resource.DeleteRequest,
 		return
 	}
 
-	if err := core.DeleteVM(ctx, r.provider, state.Id.ValueString()); err != nil {
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := core.DeleteVM(ctx, provider, state.Id.ValueString()); err != nil {
 		response.Diagnostics.AddError("unable to delete vm", err.Error())
 		return
 	}
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -461,6 +462,16 @@ func VmResourceSchema(ctx context.Context) schema.Schema {
 					setplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"state": schema.StringAttribute{
 				Computed:            true,
 				Description:         "The state of the VM (`pending` \\| `running` \\| `stopping` \\| `stopped` \\| `shutting-down` \\| `terminated` \\| `quarantine`).",
@@ -526,6 +537,14 @@ func VmResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "The ID of the Vpc in which the VM is running.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -557,10 +576,12 @@ type VmModel struct {
 	RootDeviceType              types.String   `tfsdk:"root_device_type"`
 	SecurityGroupIds            types.Set      `tfsdk:"security_group_ids"`
 	SecurityGroups              types.Set      `tfsdk:"security_groups"`
+	SpaceId                     types.String   `tfsdk:"space_id"`
 	State                       types.String   `tfsdk:"state"`
 	StateReason                 types.String   `tfsdk:"state_reason"`
 	SubnetId                    types.String   `tfsdk:"subnet_id"`
 	Tags                        types.Set      `tfsdk:"tags"`
+	Timeouts                    timeouts.Value `tfsdk:"timeouts"`
 	Type                        types.String   `tfsdk:"type"`
 	UserData                    types.String   `tfsdk:"user_data"`
 	VmInitiatedShutdownBehavior types.String   `tfsdk:"vm_initiated_shutdown_behavior"`
@@ -197,6 +197,12 @@ func VolumeDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The snapshots from which the volumes were created.",
 				MarkdownDescription: "The snapshots from which the volumes were created.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
 			"tag_keys": schema.ListAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
@@ -244,22 +250,23 @@ func VolumeDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type VolumeModel struct {
-	AvailabilityZoneNames        types.List `tfsdk:"availability_zone_names"`
-	CreationDates                types.List `tfsdk:"creation_dates"`
-	Ids                          types.List `tfsdk:"ids"`
-	Items                        types.List `tfsdk:"items"`
-	LinkVolumeDeleteOnVmDeletion types.Bool `tfsdk:"link_volume_delete_on_vm_deletion"`
-	LinkVolumeDeviceNames        types.List `tfsdk:"link_volume_device_names"`
-	LinkVolumeLinkDates          types.List `tfsdk:"link_volume_link_dates"`
-	LinkVolumeLinkStates         types.List `tfsdk:"link_volume_link_states"`
-	LinkVolumeVmIds              types.List `tfsdk:"link_volume_vm_ids"`
-	SnapshotIds                  types.List `tfsdk:"snapshot_ids"`
-	TagKeys                      types.List `tfsdk:"tag_keys"`
-	TagValues                    types.List `tfsdk:"tag_values"`
-	Tags                         types.List `tfsdk:"tags"`
-	VolumeSizes                  types.List `tfsdk:"volume_sizes"`
-	VolumeStates                 types.List `tfsdk:"volume_states"`
-	VolumeTypes                  types.List `tfsdk:"volume_types"`
+	AvailabilityZoneNames        types.List   `tfsdk:"availability_zone_names"`
+	CreationDates                types.List   `tfsdk:"creation_dates"`
+	Ids                          types.List   `tfsdk:"ids"`
+	Items                        types.List   `tfsdk:"items"`
+	LinkVolumeDeleteOnVmDeletion types.Bool   `tfsdk:"link_volume_delete_on_vm_deletion"`
+	LinkVolumeDeviceNames        types.List   `tfsdk:"link_volume_device_names"`
+	LinkVolumeLinkDates          types.List   `tfsdk:"link_volume_link_dates"`
+	LinkVolumeLinkStates         types.List   `tfsdk:"link_volume_link_states"`
+	LinkVolumeVmIds              types.List   `tfsdk:"link_volume_vm_ids"`
+	SnapshotIds                  types.List   `tfsdk:"snapshot_ids"`
+	SpaceId                      types.String `tfsdk:"space_id"`
+	TagKeys                      types.List   `tfsdk:"tag_keys"`
+	TagValues                    types.List   `tfsdk:"tag_values"`
+	Tags                         types.List   `tfsdk:"tags"`
+	VolumeSizes                  types.List   `tfsdk:"volume_sizes"`
+	VolumeStates                 types.List   `tfsdk:"volume_states"`
+	VolumeTypes                  types.List   `tfsdk:"volume_types"`
 }
 
 var _ basetypes.ObjectTypable = ItemsType{}
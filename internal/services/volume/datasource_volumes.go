@@ -76,12 +76,17 @@ func (d *volumesDataSource) Read(ctx context.Context, request datasource.ReadReq
 		return
 	}
 
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	params := deserializeVolumeParams(ctx, plan, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	volumes, err := core.ReadVolumeWithParams(ctx, d.provider, params)
+	volumes, err := core.ReadVolumeWithParams(ctx, provider, params)
 	if err != nil {
 		return
 	}
@@ -98,6 +103,7 @@ func (d *volumesDataSource) Read(ctx context.Context, request datasource.ReadReq
 
 	state = plan
 	state.Items = listValueItems
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -54,6 +54,7 @@ func (r *volumeResource) Configure(_ context.Context, request resource.Configure
 
 // ImportState handles importing an existing Volume into Terraform state.
 func (r *volumeResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
@@ -77,13 +78,26 @@ func (r *volumeResource) Create(ctx context.Context, request resource.CreateRequ
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	vmID := plan.LinkVm.VmId.ValueString()
 	deviceName := plan.LinkVm.DeviceName.ValueString()
 	tagsValue := volumeTags(ctx, plan.Tags)
 
-	numSpotVolume, err := core.CreateVolume(ctx, r.provider, deserializeCreateNumSpotVolume(plan), tagsValue, vmID, deviceName)
+	numSpotVolume, err := core.CreateVolume(ctx, provider, deserializeCreateNumSpotVolume(plan), tagsValue, vmID, deviceName)
 	if err != nil {
-		response.Diagnostics.AddError("unable to create volume", err.Error())
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create volume", err, request.Plan)...)
 		return
 	}
 
@@ -92,6 +106,8 @@ func (r *volumeResource) Create(ctx context.Context, request resource.CreateRequ
 		return
 	}
 
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
@@ -104,9 +120,26 @@ func (r *volumeResource) Read(ctx context.Context, request resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	volumeID := state.Id.ValueString()
 
-	numSpotVolume, err := core.ReadVolume(ctx, r.provider, volumeID)
+	numSpotVolume, err := core.ReadVolume(ctx, provider, volumeID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError("unable to read volume", err.Error())
 		return
@@ -116,6 +149,8 @@ func (r *volumeResource) Read(ctx context.Context, request resource.ReadRequest,
 	if response.Diagnostics.HasError() {
 		return
 	}
+	tf.SpaceId = types.StringValue(provider.SpaceID.String())
+	tf.Timeouts = state.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &tf)...)
 }
 
@@ -143,6 +178,19 @@ func (r *volumeResource) Update(ctx context.Context, request resource.UpdateRequ
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	if plan.Size.ValueInt64() < state.Size.ValueInt64() {
 		response.Diagnostics.AddError("volume downsize", fmt.Sprintf("Trying to update volume size from %v to %v. It is not possible to downsize a volume in an update. "+
 			"To force the replace of volume, set attribute 'replace_volume_on_downsize' to true. Note : All data on volume will be lost.", state.Size.ValueInt64(), plan.Size.ValueInt64()))
@@ -157,25 +205,25 @@ func (r *volumeResource) Update(ctx context.Context, request resource.UpdateRequ
 	newDeviceName := plan.LinkVm.DeviceName.ValueString()
 
 	if !plan.Size.Equal(state.Size) || !plan.Type.Equal(state.Type) || (!utils.IsTfValueNull(plan.Iops) && !plan.Iops.Equal(state.Iops)) {
-		numSpotVolume, err = core.UpdateVolumeAttributes(ctx, r.provider, deserializeUpdateNumspotVolume(plan), volumeID, stateVMID)
+		numSpotVolume, err = core.UpdateVolumeAttributes(ctx, provider, deserializeUpdateNumspotVolume(plan), volumeID, stateVMID)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update volume attributes", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update volume attributes", err, request.Plan)...)
 			return
 		}
 	}
 
 	if !plan.LinkVm.VmId.Equal(state.LinkVm.VmId) || !plan.LinkVm.DeviceName.Equal(state.LinkVm.DeviceName) {
-		numSpotVolume, err = core.UpdateVolumeLink(ctx, r.provider, volumeID, stateVMID, planVMID, newDeviceName)
+		numSpotVolume, err = core.UpdateVolumeLink(ctx, provider, volumeID, stateVMID, planVMID, newDeviceName)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update volume link", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update volume link", err, request.Plan)...)
 			return
 		}
 	}
 
 	if !plan.Tags.Equal(state.Tags) {
-		numSpotVolume, err = core.UpdateVolumeTags(ctx, r.provider, volumeID, stateTags, planTags)
+		numSpotVolume, err = core.UpdateVolumeTags(ctx, provider, volumeID, stateTags, planTags)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update volume tags", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update volume tags", err, request.Plan)...)
 			return
 		}
 	}
@@ -184,6 +232,8 @@ func (r *volumeResource) Update(ctx context.Context, request resource.UpdateRequ
 	if response.Diagnostics.HasError() {
 		return
 	}
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 }
 
@@ -196,7 +246,20 @@ func (r *volumeResource) Delete(ctx context.Context, request resource.DeleteRequ
 		return
 	}
 
-	if err := core.DeleteVolume(ctx, r.provider, state.Id.ValueString(), state.LinkVm.VmId.ValueString()); err != nil {
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := core.DeleteVolume(ctx, provider, state.Id.ValueString(), state.LinkVm.VmId.ValueString()); err != nil {
 		response.Diagnostics.AddError("unable to delete volume", err.Error())
 		return
 	}
@@ -12,11 +12,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -131,6 +133,16 @@ func VolumeResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The ID of the snapshot from which you want to create the volume.",
 				MarkdownDescription: "The ID of the snapshot from which you want to create the volume.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"state": schema.StringAttribute{
 				Computed:            true,
 				Description:         "The state of the volume (`creating` \\| `available` \\| `in-use` \\| `updating` \\| `deleting` \\| `error`).",
@@ -169,22 +181,32 @@ func VolumeResourceSchema(ctx context.Context) schema.Schema {
 				Default:             stringdefault.StaticString("standard"),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type VolumeModel struct {
-	AvailabilityZoneName    types.String `tfsdk:"availability_zone_name"`
-	CreationDate            types.String `tfsdk:"creation_date"`
-	Id                      types.String `tfsdk:"id"`
-	Iops                    types.Int64  `tfsdk:"iops"`
-	LinkVm                  LinkVmValue  `tfsdk:"link_vm"`
-	LinkedVolumes           types.List   `tfsdk:"linked_volumes"`
-	ReplaceVolumeOnDownsize types.Bool   `tfsdk:"replace_volume_on_downsize"`
-	Size                    types.Int64  `tfsdk:"size"`
-	SnapshotId              types.String `tfsdk:"snapshot_id"`
-	State                   types.String `tfsdk:"state"`
-	Tags                    types.Set    `tfsdk:"tags"`
-	Type                    types.String `tfsdk:"type"`
+	AvailabilityZoneName    types.String   `tfsdk:"availability_zone_name"`
+	CreationDate            types.String   `tfsdk:"creation_date"`
+	Id                      types.String   `tfsdk:"id"`
+	Iops                    types.Int64    `tfsdk:"iops"`
+	LinkVm                  LinkVmValue    `tfsdk:"link_vm"`
+	LinkedVolumes           types.List     `tfsdk:"linked_volumes"`
+	ReplaceVolumeOnDownsize types.Bool     `tfsdk:"replace_volume_on_downsize"`
+	Size                    types.Int64    `tfsdk:"size"`
+	SnapshotId              types.String   `tfsdk:"snapshot_id"`
+	SpaceId                 types.String   `tfsdk:"space_id"`
+	State                   types.String   `tfsdk:"state"`
+	Tags                    types.Set      `tfsdk:"tags"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+	Type                    types.String   `tfsdk:"type"`
 }
 
 var _ basetypes.ObjectTypable = LinkVmType{}
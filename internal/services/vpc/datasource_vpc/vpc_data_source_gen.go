@@ -108,6 +108,12 @@ func VpcDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Information about the described Vpcs.",
 				MarkdownDescription: "Information about the described Vpcs.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space to read from. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space to read from. Defaults to the space of the provider configuration.",
+			},
 			"states": schema.ListAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
@@ -141,15 +147,16 @@ func VpcDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type VpcModel struct {
-	DhcpOptionsSetIds types.List `tfsdk:"dhcp_options_set_ids"`
-	Ids               types.List `tfsdk:"ids"`
-	IpRanges          types.List `tfsdk:"ip_ranges"`
-	IsDefault         types.Bool `tfsdk:"is_default"`
-	Items             types.List `tfsdk:"items"`
-	States            types.List `tfsdk:"states"`
-	TagKeys           types.List `tfsdk:"tag_keys"`
-	TagValues         types.List `tfsdk:"tag_values"`
-	Tags              types.List `tfsdk:"tags"`
+	DhcpOptionsSetIds types.List   `tfsdk:"dhcp_options_set_ids"`
+	Ids               types.List   `tfsdk:"ids"`
+	IpRanges          types.List   `tfsdk:"ip_ranges"`
+	IsDefault         types.Bool   `tfsdk:"is_default"`
+	Items             types.List   `tfsdk:"items"`
+	SpaceId           types.String `tfsdk:"space_id"`
+	States            types.List   `tfsdk:"states"`
+	TagKeys           types.List   `tfsdk:"tag_keys"`
+	TagValues         types.List   `tfsdk:"tag_values"`
+	Tags              types.List   `tfsdk:"tags"`
 }
 
 var _ basetypes.ObjectTypable = ItemsType{}
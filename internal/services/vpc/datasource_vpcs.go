@@ -48,12 +48,17 @@ func (d *vpcsDataSource) Read(ctx context.Context, request datasource.ReadReques
 		return
 	}
 
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	params := deserializeVPCParams(ctx, plan, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	numSpotVpc, err := core.ReadVPCsWithParams(ctx, d.provider, params)
+	numSpotVpc, err := core.ReadVPCsWithParams(ctx, provider, params)
 	if err != nil {
 		response.Diagnostics.AddError("unable to read internet gateway", err.Error())
 		return
@@ -72,6 +77,7 @@ func (d *vpcsDataSource) Read(ctx context.Context, request datasource.ReadReques
 
 	state = plan
 	state.Items = listValueItems
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
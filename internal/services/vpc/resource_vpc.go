@@ -39,6 +39,7 @@ func (r *vpcResource) Configure(_ context.Context, request resource.ConfigureReq
 }
 
 func (r *vpcResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
@@ -58,12 +59,25 @@ func (r *vpcResource) Create(ctx context.Context, request resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	tagsValue := vpcTags(ctx, plan.Tags)
 	dhcpOptionsSet := plan.DhcpOptionsSetId.ValueString()
 
-	numSpotVPC, err := core.CreateVPC(ctx, r.provider, deserializeCreateVPCRequest(plan), dhcpOptionsSet, tagsValue)
+	numSpotVPC, err := core.CreateVPC(ctx, provider, deserializeCreateVPCRequest(plan), dhcpOptionsSet, tagsValue)
 	if err != nil {
-		response.Diagnostics.AddError("unable to create vpc", err.Error())
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create vpc", err, request.Plan)...)
 		return
 	}
 
@@ -72,6 +86,8 @@ func (r *vpcResource) Create(ctx context.Context, request resource.CreateRequest
 		return
 	}
 
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
@@ -83,9 +99,26 @@ func (r *vpcResource) Read(ctx context.Context, request resource.ReadRequest, re
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	vpcID := state.Id.ValueString()
 
-	numSpotVPC, err := core.ReadVPC(ctx, r.provider, vpcID)
+	numSpotVPC, err := core.ReadVPC(ctx, provider, vpcID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError("unable to read vpc", err.Error())
 		return
@@ -96,6 +129,8 @@ func (r *vpcResource) Read(ctx context.Context, request resource.ReadRequest, re
 		return
 	}
 
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 }
 
@@ -116,14 +151,27 @@ func (r *vpcResource) Update(ctx context.Context, request resource.UpdateRequest
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	vpcID := state.Id.ValueString()
 	planTags := vpcTags(ctx, plan.Tags)
 	stateTags := vpcTags(ctx, state.Tags)
 
 	if !plan.Tags.Equal(state.Tags) {
-		numSpotVPC, err = core.UpdateVPCTags(ctx, r.provider, vpcID, stateTags, planTags)
+		numSpotVPC, err = core.UpdateVPCTags(ctx, provider, vpcID, stateTags, planTags)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update vpc tags", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update vpc tags", err, request.Plan)...)
 			return
 		}
 	}
@@ -133,6 +181,8 @@ func (r *vpcResource) Update(ctx context.Context, request resource.UpdateRequest
 		return
 	}
 
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
 }
 
@@ -144,7 +194,20 @@ func (r *vpcResource) Delete(ctx context.Context, request resource.DeleteRequest
 		return
 	}
 
-	if err := core.DeleteVPC(ctx, r.provider, state.Id.ValueString()); err != nil {
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := core.DeleteVPC(ctx, provider, state.Id.ValueString()); err != nil {
 		response.Diagnostics.AddError("unable to delete vpc", err.Error())
 		return
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -39,6 +40,16 @@ func VpcResourceSchema(ctx context.Context) schema.Schema {
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"state": schema.StringAttribute{
 				Computed:            true,
 				Description:         "The state of the Vpc (`pending` \\| `available` \\| `deleting`).",
@@ -76,16 +87,26 @@ func VpcResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "The tenancy options for the VMs:<br />\n- `default` if a VM created in a Vpc can be launched with any tenancy.<br />\n- `dedicated` if it can be launched with dedicated tenancy VMs running on single-tenant hardware.<br />\n- `dedicated group ID`: if it can be launched in a dedicated group on single-tenant hardware.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
 type VpcModel struct {
-	DhcpOptionsSetId types.String `tfsdk:"dhcp_options_set_id"`
-	Id               types.String `tfsdk:"id"`
-	IpRange          types.String `tfsdk:"ip_range"`
-	State            types.String `tfsdk:"state"`
-	Tags             types.Set    `tfsdk:"tags"`
-	Tenancy          types.String `tfsdk:"tenancy"`
+	DhcpOptionsSetId types.String   `tfsdk:"dhcp_options_set_id"`
+	Id               types.String   `tfsdk:"id"`
+	IpRange          types.String   `tfsdk:"ip_range"`
+	SpaceId          types.String   `tfsdk:"space_id"`
+	State            types.String   `tfsdk:"state"`
+	Tags             types.Set      `tfsdk:"tags"`
+	Tenancy          types.String   `tfsdk:"tenancy"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 var _ basetypes.ObjectTypable = TagsType{}
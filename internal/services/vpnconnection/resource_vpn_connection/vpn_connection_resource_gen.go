@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -78,6 +79,16 @@ func VpnConnectionResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Information about one or more static routes associated with the VPN connection, if any.",
 				MarkdownDescription: "Information about one or more static routes associated with the VPN connection, if any.",
 			},
+			"space_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				MarkdownDescription: "The ID of the space the resource belongs to. Defaults to the space of the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"state": schema.StringAttribute{
 				Computed:            true,
 				Description:         "The state of the VPN connection (`pending` \\| `available` \\| `deleting` \\| `deleted`).",
@@ -262,6 +273,14 @@ func VpnConnectionResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "Information about the VPN options.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -271,8 +290,10 @@ type VpnConnectionModel struct {
 	ConnectionType             types.String    `tfsdk:"connection_type"`
 	Id                         types.String    `tfsdk:"id"`
 	Routes                     types.Set       `tfsdk:"routes"`
+	SpaceId                    types.String    `tfsdk:"space_id"`
 	State                      types.String    `tfsdk:"state"`
 	StaticRoutesOnly           types.Bool      `tfsdk:"static_routes_only"`
+	Timeouts                   timeouts.Value  `tfsdk:"timeouts"`
 	VgwTelemetries             types.List      `tfsdk:"vgw_telemetries"`
 	VirtualGatewayId           types.String    `tfsdk:"virtual_gateway_id"`
 	VpnOptions                 VpnOptionsValue `tfsdk:"vpn_options"`
@@ -50,7 +50,12 @@ func (d *vpnConnectionsDataSource) Read(ctx context.Context, request datasource.
 		return
 	}
 
-	numSpotVpnConnections, err := core.ReadVpnConnectionsWithParams(ctx, d.provider)
+	provider := services.SpaceProvider(d.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	numSpotVpnConnections, err := core.ReadVpnConnectionsWithParams(ctx, provider)
 	if err != nil {
 		response.Diagnostics.AddError("unable to read vpn connections", err.Error())
 		return
@@ -63,6 +68,7 @@ func (d *vpnConnectionsDataSource) Read(ctx context.Context, request datasource.
 
 	state = plan
 	state.Items = objectItems.Items
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
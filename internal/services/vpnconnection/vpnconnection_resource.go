@@ -41,6 +41,7 @@ func (r *vpnConnectionResource) Configure(_ context.Context, request resource.Co
 }
 
 func (r *vpnConnectionResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	request.ID = services.ImportStateSpaceID(ctx, request.ID, response)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
@@ -59,14 +60,27 @@ func (r *vpnConnectionResource) Create(ctx context.Context, request resource.Cre
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, plan.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	routeSlice := routesSetToRoutesSlice(ctx, plan.Routes, &response.Diagnostics)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	vpnConnection, err := core.CreateVpnConnection(ctx, r.provider, deserializeCreateVpnConnection(plan), deserializeCreateRoutes(routeSlice))
+	vpnConnection, err := core.CreateVpnConnection(ctx, provider, deserializeCreateVpnConnection(plan), deserializeCreateRoutes(routeSlice))
 	if err != nil {
-		response.Diagnostics.AddError("unable to create vpn connection", err.Error())
+		response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to create vpn connection", err, request.Plan)...)
 		return
 	}
 
@@ -75,6 +89,8 @@ func (r *vpnConnectionResource) Create(ctx context.Context, request resource.Cre
 		return
 	}
 
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
 
@@ -85,13 +101,30 @@ func (r *vpnConnectionResource) Read(ctx context.Context, request resource.ReadR
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	vpnConnectionID, err := uuid.Parse(state.Id.ValueString())
 	if err != nil {
 		response.Diagnostics.AddError("unable to parse id from state", err.Error())
 		return
 	}
 
-	vpnConnection, err := core.ReadVpnConnection(ctx, r.provider, vpnConnectionID)
+	vpnConnection, err := core.ReadVpnConnection(ctx, provider, vpnConnectionID)
+	if utils.IsNotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		response.Diagnostics.AddError("unable to read Vpn connection", err.Error())
 		return
@@ -102,6 +135,8 @@ func (r *vpnConnectionResource) Read(ctx context.Context, request resource.ReadR
 		return
 	}
 
+	newState.SpaceId = types.StringValue(provider.SpaceID.String())
+	newState.Timeouts = state.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, newState)...)
 }
 
@@ -116,6 +151,19 @@ func (r *vpnConnectionResource) Update(ctx context.Context, request resource.Upd
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	vpnConnectionID, err := uuid.Parse(state.Id.ValueString())
 	if err != nil {
 		response.Diagnostics.AddError("unable to parse id from state", err.Error())
@@ -131,9 +179,9 @@ func (r *vpnConnectionResource) Update(ctx context.Context, request resource.Upd
 
 		tfRoutesToCreate, tfRoutesToDelete := utils.Diff(stateRoutes, planRoutes)
 
-		vpnConnection, err = core.UpdateVpnConnectionRoutes(ctx, r.provider, deserializeDeleteRoutes(tfRoutesToDelete), deserializeCreateRoutes(tfRoutesToCreate), vpnConnectionID)
+		vpnConnection, err = core.UpdateVpnConnectionRoutes(ctx, provider, deserializeDeleteRoutes(tfRoutesToDelete), deserializeCreateRoutes(tfRoutesToCreate), vpnConnectionID)
 		if err != nil {
-			response.Diagnostics.AddError("unable to update vpn connection routes", err.Error())
+			response.Diagnostics.Append(utils.ErrorDiagnostics(ctx, "unable to update vpn connection routes", err, request.Plan)...)
 			return
 		}
 
@@ -142,10 +190,14 @@ func (r *vpnConnectionResource) Update(ctx context.Context, request resource.Upd
 			return
 		}
 
+		newState.SpaceId = types.StringValue(provider.SpaceID.String())
+		newState.Timeouts = plan.Timeouts
 		response.Diagnostics.Append(response.State.Set(ctx, newState)...)
 		return
 	}
 
+	state.SpaceId = types.StringValue(provider.SpaceID.String())
+	state.Timeouts = plan.Timeouts
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
 
@@ -156,13 +208,26 @@ func (r *vpnConnectionResource) Delete(ctx context.Context, request resource.Del
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.TfRequestRetryTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	provider := services.SpaceProvider(r.provider, state.SpaceId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	vpnConnectionID, err := uuid.Parse(state.Id.ValueString())
 	if err != nil {
 		response.Diagnostics.AddError("unable to parse id from state", err.Error())
 		return
 	}
 
-	err = core.DeleteVpnConnection(ctx, r.provider, vpnConnectionID)
+	err = core.DeleteVpnConnection(ctx, provider, vpnConnectionID)
 	if err != nil {
 		response.Diagnostics.AddError("unable to delete vpn connection", err.Error())
 		return
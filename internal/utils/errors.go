@@ -1,33 +1,234 @@
 package utils
 
 import (
+	"context"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"net/http"
+	"strings"
+	"unicode"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"terraform-provider-numspot/internal/sdk/api"
 )
 
-func ParseHTTPError(httpResponseBody []byte, statusCode int) error {
+// RequestIDHeaders are the response headers that may carry the ID used by the NumSpot support to correlate a request
+var RequestIDHeaders = []string{"X-Request-Id", "X-Correlation-Id", "X-Amz-Request-Id"}
+
+// APIError is the error returned by the NumSpot API, parsed from the problem details of the response body
+type APIError struct {
+	StatusCode int
+	RequestID  string
+	Type       string
+	Title      string
+	Detail     string
+	Violations []api.Violation
+}
+
+// apiErrorBody is the union of api.Error, api.BadRequestError and the catalogue errors holding violations
+type apiErrorBody struct {
+	api.Error
+	Violations []api.Violation `json:"violations,omitempty"`
+}
+
+func (e *APIError) Error() string {
+	errorString := e.Title
+	if errorString == "" {
+		errorString = http.StatusText(e.StatusCode)
+	}
+	if e.Detail != "" {
+		if errorString != "" {
+			errorString = errorString + ": "
+		}
+		errorString = errorString + e.Detail
+	}
+
+	if len(e.Violations) > 0 {
+		violations := make([]string, 0, len(e.Violations))
+		for _, violation := range e.Violations {
+			violations = append(violations, violation.Field+": "+violation.Description)
+		}
+		errorString = errorString + " (" + strings.Join(violations, ", ") + ")"
+	}
+
+	if e.RequestID != "" {
+		errorString = errorString + " [request ID: " + e.RequestID + "]"
+	}
+
+	return errorString
+}
+
+// Temporary reports whether the request may succeed if retried later
+func (e *APIError) Temporary() bool {
+	switch e.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// NotFoundError is returned when the API answers 404 Not Found, it wraps the error parsed from the response body
+type NotFoundError struct {
+	Err error
+}
+
+func (e *NotFoundError) Error() string {
+	if e.Err == nil || e.Err.Error() == "" {
+		return "resource not found"
+	}
+	return e.Err.Error()
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return e.Err
+}
+
+// IsNotFound reports whether any error in err's chain is a NotFoundError
+func IsNotFound(err error) bool {
+	var notFoundError *NotFoundError
+	return errors.As(err, &notFoundError)
+}
+
+// ParseHTTPError returns nil on success status codes, an *APIError otherwise (wrapped in a *NotFoundError on 404).
+// header is the response header, it may be nil.
+func ParseHTTPError(httpResponseBody []byte, statusCode int, header http.Header) error {
 	if statusCode == http.StatusOK || statusCode == http.StatusCreated || statusCode == http.StatusAccepted || statusCode == http.StatusNoContent {
 		return nil
 	}
 
-	return HandleError(httpResponseBody)
+	apiError := NewAPIError(httpResponseBody, statusCode, header)
+	if statusCode == http.StatusNotFound {
+		return &NotFoundError{Err: apiError}
+	}
+
+	return apiError
 }
 
-func HandleError(httpResponseBody []byte) error {
-	var apiError api.Error
+// NewAPIError builds an *APIError from a response body, status code and header (which may be nil)
+func NewAPIError(httpResponseBody []byte, statusCode int, header http.Header) *APIError {
+	apiError := &APIError{StatusCode: statusCode}
+
+	for _, key := range RequestIDHeaders {
+		if requestID := header.Get(key); requestID != "" {
+			apiError.RequestID = requestID
+			break
+		}
+	}
 
-	err := json.Unmarshal(httpResponseBody, &apiError)
+	var body apiErrorBody
+	err := json.Unmarshal(httpResponseBody, &body)
 	if err != nil && string(httpResponseBody) != "" {
-		return errors.New("API Error : " + string(httpResponseBody))
+		apiError.Title = "API Error"
+		apiError.Detail = string(httpResponseBody)
+		return apiError
+	}
+
+	apiError.Type = body.Type
+	apiError.Title = body.Title
+	if body.Detail != nil {
+		apiError.Detail = *body.Detail
+	}
+	apiError.Violations = body.Violations
+
+	return apiError
+}
+
+// objectStorageErrorBody is the XML error returned by the S3-compatible object storage API
+type objectStorageErrorBody struct {
+	Code      string `xml:"Code"`
+	Message   string `xml:"Message"`
+	RequestID string `xml:"RequestId"`
+}
+
+// ParseObjectStorageError is the ParseHTTPError of the object storage API, which answers XML errors
+func ParseObjectStorageError(httpResponseBody []byte, statusCode int, header http.Header) error {
+	if statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices {
+		return nil
+	}
+
+	apiError := &APIError{StatusCode: statusCode, Title: http.StatusText(statusCode)}
+	for _, key := range RequestIDHeaders {
+		if requestID := header.Get(key); requestID != "" {
+			apiError.RequestID = requestID
+			break
+		}
+	}
+
+	var body objectStorageErrorBody
+	if err := xml.Unmarshal(httpResponseBody, &body); err == nil {
+		apiError.Title = body.Code
+		apiError.Detail = body.Message
+		if apiError.RequestID == "" {
+			apiError.RequestID = body.RequestID
+		}
+	} else if len(httpResponseBody) > 0 {
+		apiError.Detail = string(httpResponseBody)
+	}
+
+	if statusCode == http.StatusNotFound {
+		return &NotFoundError{Err: apiError}
+	}
+
+	return apiError
+}
+
+func HandleError(httpResponseBody []byte) error {
+	return NewAPIError(httpResponseBody, 0, nil)
+}
+
+// PathMatcher is implemented by tfsdk.Plan and tfsdk.State, PathMatches fails for the paths outside of their schema
+type PathMatcher interface {
+	PathMatches(ctx context.Context, pathExpr path.Expression) (path.Paths, diag.Diagnostics)
+}
+
+// violationFieldPrefixes are the prefixes of the violation fields naming the part of the request holding the field
+var violationFieldPrefixes = []string{"body.", "query.", "path."}
+
+// ErrorDiagnostics returns an error diagnostic for err, and an attribute error diagnostic for each violation of the
+// *APIError held in err's chain whose field is an attribute of the schema of data. data may be nil, the violations
+// are then only listed in the error diagnostic.
+func ErrorDiagnostics(ctx context.Context, summary string, err error, data PathMatcher) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.AddError(summary, err.Error())
+
+	var apiError *APIError
+	if data == nil || !errors.As(err, &apiError) {
+		return diags
+	}
+
+	for _, violation := range apiError.Violations {
+		attributePath := violationPath(violation.Field)
+		if _, pathDiags := data.PathMatches(ctx, attributePath.Expression()); pathDiags.HasError() {
+			continue
+		}
+		diags.AddAttributeError(attributePath, "invalid attribute value", violation.Description)
+	}
+
+	return diags
+}
+
+// violationPath returns the path of the root attribute of the field of a violation,
+// e.g. "body.nodeConfiguration.vcpu" becomes path.Root("node_configuration")
+func violationPath(field string) path.Path {
+	for _, prefix := range violationFieldPrefixes {
+		field = strings.TrimPrefix(field, prefix)
 	}
+	root, _, _ := strings.Cut(field, ".")
+	root, _, _ = strings.Cut(root, "[")
 
-	errorString := apiError.Title
-	if apiError.Detail != nil && *apiError.Detail != "" {
-		errorString = errorString + ": " + *apiError.Detail
+	var attributeName strings.Builder
+	for i, r := range root {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				attributeName.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		attributeName.WriteRune(r)
 	}
 
-	return errors.New(errorString)
+	return path.Root(attributeName.String())
 }
@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHTTPError_NotFound(t *testing.T) {
+	t.Parallel()
+
+	err := ParseHTTPError([]byte(`{"title":"Not Found","detail":"vm not found"}`), http.StatusNotFound, nil)
+
+	assert.True(t, IsNotFound(err))
+	assert.True(t, IsNotFound(fmt.Errorf("read vm: %w", err)))
+	assert.Equal(t, "Not Found: vm not found", err.Error())
+}
+
+func TestParseHTTPError_OtherStatus(t *testing.T) {
+	t.Parallel()
+
+	err := ParseHTTPError([]byte(`{"title":"Bad Request"}`), http.StatusBadRequest, nil)
+
+	assert.False(t, IsNotFound(err))
+	assert.False(t, IsNotFound(errors.New("not found")))
+	assert.NoError(t, ParseHTTPError(nil, http.StatusNoContent, nil))
+}
+
+func TestParseHTTPError_APIError(t *testing.T) {
+	t.Parallel()
+	header := http.Header{}
+	header.Set("X-Request-Id", "2f0c7a4e")
+	body := []byte(`{"type":"urn:numspot:error:validation","title":"Bad Request","detail":"invalid body","violations":[{"field":"nodeConfiguration.vcpu","description":"must be greater than 1"}]}`)
+
+	err := fmt.Errorf("create cluster: %w", ParseHTTPError(body, http.StatusBadRequest, header))
+
+	var apiError *APIError
+	require.True(t, errors.As(err, &apiError))
+	assert.Equal(t, http.StatusBadRequest, apiError.StatusCode)
+	assert.Equal(t, "2f0c7a4e", apiError.RequestID)
+	assert.Equal(t, "urn:numspot:error:validation", apiError.Type)
+	assert.Equal(t, "invalid body", apiError.Detail)
+	require.Len(t, apiError.Violations, 1)
+	assert.Equal(t, "Bad Request: invalid body (nodeConfiguration.vcpu: must be greater than 1) [request ID: 2f0c7a4e]", apiError.Error())
+}
+
+func TestParseHTTPError_NotJSON(t *testing.T) {
+	t.Parallel()
+
+	err := ParseHTTPError([]byte("bad gateway"), http.StatusBadGateway, nil)
+
+	var apiError *APIError
+	require.True(t, errors.As(err, &apiError))
+	assert.True(t, apiError.Temporary())
+	assert.Equal(t, "API Error: bad gateway", err.Error())
+}
+
+func TestParseHTTPError_EmptyBody(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "Internal Server Error", ParseHTTPError(nil, http.StatusInternalServerError, nil).Error())
+	assert.Equal(t, "Conflict: vpc in use", ParseHTTPError([]byte(`{"detail":"vpc in use"}`), http.StatusConflict, nil).Error())
+}
+
+func TestErrorDiagnostics(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	body := []byte(`{"title":"Bad Request","violations":[{"field":"body.nodeConfiguration.vcpu","description":"must be greater than 1"},{"field":"replicaCount","description":"must be lower than 3"},{"field":"body.tier","description":"must not be null"}]}`)
+	err := ParseHTTPError(body, http.StatusBadRequest, nil)
+
+	s := schema.Schema{Attributes: map[string]schema.Attribute{
+		"node_configuration": schema.StringAttribute{Optional: true},
+		"replica_count":      schema.Int64Attribute{Optional: true},
+	}}
+	plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"node_configuration": tftypes.NewValue(tftypes.String, nil),
+		"replica_count":      tftypes.NewValue(tftypes.Number, nil),
+	})}
+
+	diags := ErrorDiagnostics(ctx, "unable to create postgres cluster", err, plan)
+
+	require.Len(t, diags, 3)
+	assert.Equal(t, "unable to create postgres cluster", diags[0].Summary())
+	assert.Equal(t, err.Error(), diags[0].Detail())
+	assert.Equal(t, path.Root("node_configuration"), diags[1].(diag.DiagnosticWithPath).Path())
+	assert.Equal(t, "must be greater than 1", diags[1].Detail())
+	assert.Equal(t, path.Root("replica_count"), diags[2].(diag.DiagnosticWithPath).Path())
+
+	assert.Len(t, ErrorDiagnostics(ctx, "unable to create postgres cluster", err, nil), 1)
+}
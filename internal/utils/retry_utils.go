@@ -2,7 +2,6 @@ package utils
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -20,7 +19,9 @@ type TfRequestResp interface {
 }
 
 const (
-	TfRequestRetryTimeout      = 5 * time.Minute
+	// TfRequestRetryTimeout is the default value of the resources timeouts
+	TfRequestRetryTimeout = 5 * time.Minute
+	// TfRequestStateRetryTimeout is the default value of the timeouts of long-running resources (clusters)
 	TfRequestStateRetryTimeout = 15 * time.Minute
 	TfRequestRetryDelay        = 5 * time.Second
 )
@@ -33,6 +34,8 @@ var (
 
 	StateStopRetryOnCreate = []string{"RUNNING", "FAILED"}
 	StateRetryOnCreate     = []string{"PENDING", "CREATING", "DELETING"}
+	StateStopRetryOnUpdate = []string{"RUNNING", "FAILED"}
+	StateRetryOnUpdate     = []string{"PENDING", "UPGRADING", "REPAIRING"}
 )
 
 // ParseRetryBackoff retrieves the retry backoff duration from the
@@ -70,40 +73,69 @@ func ParseRetryBackoff() time.Duration {
 	return retryBackoff
 }
 
-func GetErrorMessage(res TfRequestResp) (string, error) {
-	errorResponse, err := getFieldFromReflectStructPtr(reflect.ValueOf(res), "Body")
+// RetryTimeout returns the time left before the deadline of ctx, set by the resource from its
+// "timeouts" block, or defaultTimeout if ctx has no deadline.
+func RetryTimeout(ctx context.Context, defaultTimeout time.Duration) time.Duration {
+	if deadline, ok := ctx.Deadline(); ok {
+		return time.Until(deadline)
+	}
+
+	return defaultTimeout
+}
+
+// GetAPIError builds an *APIError from a response of the NumSpot SDK
+func GetAPIError(res TfRequestResp) (*APIError, error) {
+	resValue := reflect.ValueOf(res)
+
+	errorResponse, err := getFieldFromReflectStructPtr(resValue, "Body")
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	concreteErrorResponse, ok := errorResponse.Interface().([]byte)
-
 	if !ok {
-		return "", fmt.Errorf("failed to parse %v to byte array", errorResponse)
+		return nil, fmt.Errorf("failed to parse %v to byte array", errorResponse)
 	}
 
-	return HandleError(concreteErrorResponse).Error(), err
+	var header http.Header
+	httpResponse, err := getFieldFromReflectStructPtr(resValue, "HTTPResponse")
+	if err == nil {
+		if concreteHTTPResponse, ok := httpResponse.Interface().(*http.Response); ok && concreteHTTPResponse != nil {
+			header = concreteHTTPResponse.Header
+		}
+	}
+
+	return NewAPIError(concreteErrorResponse, res.StatusCode(), header), nil
 }
 
-func checkRetryCondition(res TfRequestResp, err error, stopRetryCodes []int, retryCodes []int) *retry.RetryError {
+func checkRetryCondition(res TfRequestResp, err error, stopRetryCodes []int, retryCodes []int, timeout time.Duration) *retry.RetryError {
 	if err != nil {
 		return retry.NonRetryableError(err)
 	}
 
 	if slices.Contains(stopRetryCodes, res.StatusCode()) {
 		return nil
-	} else {
-		errorMessage, err := GetErrorMessage(res)
-		if err != nil {
-			return retry.NonRetryableError(fmt.Errorf("error : got http status code %v but failed to parse error message. Reason : %v", res.StatusCode(), err))
-		}
+	}
 
-		if slices.Contains(retryCodes, res.StatusCode()) {
-			time.Sleep(ParseRetryBackoff()) // Delay not handled in RetryContext. Might find a better solution later
-			return retry.RetryableError(fmt.Errorf("error : retry timeout reached (%v). Error message : %v", TfRequestRetryTimeout, errorMessage))
-		} else {
-			return retry.NonRetryableError(errors.New(errorMessage))
-		}
+	apiError, err := GetAPIError(res)
+	if err != nil {
+		return retry.NonRetryableError(fmt.Errorf("error : got http status code %v but failed to parse error message. Reason : %v", res.StatusCode(), err))
+	}
+
+	return RetryAPIError(apiError, retryCodes, timeout)
+}
+
+// RetryAPIError returns a retryable error if the status code of apiError is in retryCodes or is temporary
+func RetryAPIError(apiError *APIError, retryCodes []int, timeout time.Duration) *retry.RetryError {
+	if slices.Contains(retryCodes, apiError.StatusCode) || apiError.Temporary() {
+		time.Sleep(ParseRetryBackoff()) // Delay not handled in RetryContext. Might find a better solution later
+		return retry.RetryableError(fmt.Errorf("error : retry timeout reached (%v). Error message : %w", timeout, apiError))
+	}
+
+	if apiError.StatusCode == http.StatusNotFound {
+		return retry.NonRetryableError(&NotFoundError{Err: apiError})
 	}
+
+	return retry.NonRetryableError(apiError)
 }
 
 func RetryDeleteUntilResourceAvailable[R TfRequestResp, id string | api.ResourceIdentifier](
@@ -113,13 +145,17 @@ func RetryDeleteUntilResourceAvailable[R TfRequestResp, id string | api.Resource
 	fun func(context.Context, api.SpaceId, id, ...api.RequestEditorFn) (R, error),
 ) error {
 	var res R
-	return retry.RetryContext(ctx, TfRequestRetryTimeout, func() *retry.RetryError {
+	timeout := RetryTimeout(ctx, TfRequestRetryTimeout)
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		var err error
 		// tflog.Debug(ctx, fmt.Sprintf("Retry delete on resource: %s", id))
 		res, err = fun(ctx, spaceID, deleteId)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
 		tflog.Debug(ctx, fmt.Sprintf("Retry delete got response: %d", res.StatusCode()))
 
-		return checkRetryCondition(res, err, StatusCodeStopRetryOnCreate, StatusCodeRetryOnCreate)
+		return checkRetryCondition(res, err, StatusCodeStopRetryOnCreate, StatusCodeRetryOnCreate, timeout)
 	})
 }
 
@@ -129,11 +165,12 @@ func RetryCreateUntilResourceAvailable[R TfRequestResp](
 	fun func(context.Context, api.SpaceId, ...api.RequestEditorFn) (R, error),
 ) (R, error) {
 	var res R
-	retryError := retry.RetryContext(ctx, TfRequestRetryTimeout, func() *retry.RetryError {
+	timeout := RetryTimeout(ctx, TfRequestRetryTimeout)
+	retryError := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		var err error
 		res, err = fun(ctx, spaceID)
 
-		return checkRetryCondition(res, err, []int{http.StatusCreated}, []int{http.StatusConflict, http.StatusFailedDependency})
+		return checkRetryCondition(res, err, []int{http.StatusCreated}, []int{http.StatusConflict, http.StatusFailedDependency}, timeout)
 	})
 
 	return res, retryError
@@ -146,11 +183,12 @@ func RetryCreateUntilResourceAvailableWithBody[R TfRequestResp, BodyType any](
 	fun func(context.Context, api.SpaceId, BodyType, ...api.RequestEditorFn) (R, error),
 ) (R, error) {
 	var res R
-	retryError := retry.RetryContext(ctx, TfRequestRetryTimeout, func() *retry.RetryError {
+	timeout := RetryTimeout(ctx, TfRequestRetryTimeout)
+	retryError := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		var err error
 		res, err = fun(ctx, spaceID, body)
 
-		return checkRetryCondition(res, err, StatusCodeStopRetryOnCreate, StatusCodeRetryOnCreate)
+		return checkRetryCondition(res, err, StatusCodeStopRetryOnCreate, StatusCodeRetryOnCreate, timeout)
 	})
 
 	return res, retryError
@@ -164,11 +202,12 @@ func RetryUntilResourceAvailableWithBody[R TfRequestResp, BodyType any](
 	fun func(context.Context, api.SpaceId, string, BodyType, ...api.RequestEditorFn) (R, error),
 ) (R, error) {
 	var res R
-	retryError := retry.RetryContext(ctx, TfRequestRetryTimeout, func() *retry.RetryError {
+	timeout := RetryTimeout(ctx, TfRequestRetryTimeout)
+	retryError := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		var err error
 		res, err = fun(ctx, spaceID, resourceID, body)
 
-		return checkRetryCondition(res, err, StatusCodeStopRetryOnCreate, StatusCodeRetryOnCreate)
+		return checkRetryCondition(res, err, StatusCodeStopRetryOnCreate, StatusCodeRetryOnCreate, timeout)
 	})
 
 	return res, retryError
@@ -182,11 +221,12 @@ func RetryDeleteUntilWithBody[R TfRequestResp, BodyType any](
 	fun func(context.Context, api.SpaceId, string, BodyType, ...api.RequestEditorFn) (R, error),
 ) (R, error) {
 	var res R
-	retryError := retry.RetryContext(ctx, TfRequestRetryTimeout, func() *retry.RetryError {
+	timeout := RetryTimeout(ctx, TfRequestRetryTimeout)
+	retryError := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		var err error
 		res, err = fun(ctx, spaceID, resourceID, body)
 
-		return checkRetryCondition(res, err, StatusCodeStopRetryOnDelete, StatusCodeRetryOnDelete)
+		return checkRetryCondition(res, err, StatusCodeStopRetryOnDelete, StatusCodeRetryOnDelete, timeout)
 	})
 
 	return res, retryError
@@ -281,7 +321,7 @@ func RetryReadUntilStateValid[R TfRequestResp, ID string | api.ResourceIdentifie
 		Refresh: func() (interface{}, string, error) {
 			return ReadResourceUtils(ctx, createdId, spaceID, readFunction)
 		},
-		Timeout: TfRequestRetryTimeout,
+		Timeout: RetryTimeout(ctx, TfRequestRetryTimeout),
 		Delay:   ParseRetryBackoff(),
 	}
 
@@ -302,7 +342,7 @@ func RetryReadUntilStatusStateValid[R TfRequestResp, ID string | api.ResourceIde
 		Refresh: func() (interface{}, string, error) {
 			return ReadStatusResourceUtils(ctx, createdId, spaceID, readFunction)
 		},
-		Timeout: TfRequestStateRetryTimeout,
+		Timeout: RetryTimeout(ctx, TfRequestStateRetryTimeout),
 		Delay:   ParseRetryBackoff(),
 	}
 
@@ -369,7 +409,7 @@ func RetryReadUntilStatusStateValidWith2ID[R TfRequestResp, ID1, ID2 string | ap
 		Refresh: func() (interface{}, string, error) {
 			return ReadStatusResourceUtilsWith2ID(ctx, parentID, childID, spaceID, readFunction)
 		},
-		Timeout: TfRequestRetryTimeout,
+		Timeout: RetryTimeout(ctx, TfRequestRetryTimeout),
 		Delay:   ParseRetryBackoff(),
 	}
 
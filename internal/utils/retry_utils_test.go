@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+func (r *fakeResponse) StatusCode() int {
+	return r.HTTPResponse.StatusCode
+}
+
+func newFakeResponse(statusCode int, body string) *fakeResponse {
+	header := http.Header{}
+	header.Set("X-Request-Id", "2f0c7a4e")
+	return &fakeResponse{Body: []byte(body), HTTPResponse: &http.Response{StatusCode: statusCode, Header: header}}
+}
+
+func TestRetryTimeout_Default(t *testing.T) {
+	t.Parallel()
+
+	got := RetryTimeout(context.Background(), TfRequestRetryTimeout)
+
+	assert.Equal(t, TfRequestRetryTimeout, got)
+}
+
+func TestRetryTimeout_ContextDeadline(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 45*time.Minute)
+	defer cancel()
+
+	got := RetryTimeout(ctx, TfRequestRetryTimeout)
+
+	assert.Greater(t, got, TfRequestStateRetryTimeout)
+	assert.LessOrEqual(t, got, 45*time.Minute)
+}
+
+func TestCheckRetryCondition(t *testing.T) {
+	t.Setenv("RETRY_BACKOFF", "1ms")
+
+	assert.Nil(t, checkRetryCondition(newFakeResponse(http.StatusCreated, ""), nil, StatusCodeStopRetryOnCreate, StatusCodeRetryOnCreate, time.Minute))
+
+	retryErr := checkRetryCondition(newFakeResponse(http.StatusServiceUnavailable, ""), nil, StatusCodeStopRetryOnCreate, StatusCodeRetryOnCreate, time.Minute)
+	require.NotNil(t, retryErr)
+	assert.True(t, retryErr.Retryable)
+
+	retryErr = checkRetryCondition(newFakeResponse(http.StatusBadRequest, `{"title":"Bad Request"}`), nil, StatusCodeStopRetryOnCreate, StatusCodeRetryOnCreate, time.Minute)
+	require.NotNil(t, retryErr)
+	assert.False(t, retryErr.Retryable)
+	var apiError *APIError
+	require.True(t, errors.As(retryErr.Err, &apiError))
+	assert.Equal(t, http.StatusBadRequest, apiError.StatusCode)
+	assert.Equal(t, "2f0c7a4e", apiError.RequestID)
+}
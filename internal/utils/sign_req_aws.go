@@ -1,7 +1,9 @@
 package utils
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"net/http"
 	"time"
 
@@ -14,9 +16,23 @@ func SignRequest(service, region, accessKey, secretKey string) objectstorage.Req
 	return func(ctx context.Context, req *http.Request) error {
 		staticCreds := credentials.NewStaticCredentials(accessKey, secretKey, "")
 
-		signer := awsv4.NewSigner(staticCreds)
+		// The request path is already escaped once, as the object storage expects it for object keys
+		signer := awsv4.NewSigner(staticCreds, func(signer *awsv4.Signer) {
+			signer.DisableURIPathEscaping = true
+		})
 
-		_, err := signer.Sign(req, nil, service, region, time.Now())
+		// The payload is part of the signature, it is read once and replayed by the signer
+		var body io.ReadSeeker
+		if req.Body != nil && req.Body != http.NoBody {
+			payload, err := io.ReadAll(req.Body)
+			if err != nil {
+				return err
+			}
+			_ = req.Body.Close()
+			body = bytes.NewReader(payload)
+		}
+
+		_, err := signer.Sign(req, body, service, region, time.Now())
 		if err != nil {
 			return err
 		}